@@ -28,5 +28,5 @@ var dbMigrateCmd = &cobra.Command{
 
 func init() {
 	dbCmd.AddCommand(dbMigrateCmd)
-	dbMigrateCmd.Flags().BoolVar(&allowClearEmbedding, "allow-clear-embedding", false, "allow clearing embedding when embedding dimensions change")
+	dbMigrateCmd.Flags().BoolVar(&allowClearEmbedding, "allow-clear-embedding", false, "allow clearing embedding when the embedding model changes")
 }
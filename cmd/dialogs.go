@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var dialogsCmd = &cobra.Command{
+	Use:   "dialogs",
+	Short: "Dialog management commands",
+	Long: `Dialog management commands for TeleMikiya.
+These commands help you manage which dialogs are observed.`,
+}
+
+func init() {
+	rootCmd.AddCommand(dialogsCmd)
+}
+
+func parseDialogID(arg string) (int64, error) {
+	dialogID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dialog id: %w", err)
+	}
+	return dialogID, nil
+}
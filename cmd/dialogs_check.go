@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/telegram/user/observer"
+	"go.uber.org/fx"
+)
+
+var dialogsCheckCmd = &cobra.Command{
+	Use:   "check <dialog-id>",
+	Short: "Explain whether a dialog is observed",
+	Long: `Explain whether a dialog is observed.
+The observation rules are evaluated against the dialog and the deciding rule is printed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := parseDialogID(args[0])
+		if err != nil {
+			return err
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(e *observer.Evaluator) error {
+				decision, err := e.Check(context.Background(), dialogID)
+				if err != nil {
+					return err
+				}
+
+				status := "observed"
+				if !decision.Observed {
+					status = "not observed"
+				}
+				fmt.Printf("%s (%d) is %s: %s\n", decision.Title, dialogID, status, decision.Reason)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	dialogsCmd.AddCommand(dialogsCheckCmd)
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/observation"
+	"go.uber.org/fx"
+)
+
+var dialogsPauseCmd = &cobra.Command{
+	Use:   "pause <dialog-id>",
+	Short: "Stop recording messages of a dialog",
+	Long: `Stop recording messages of a dialog.
+Messages recorded so far are kept and stay searchable.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := parseDialogID(args[0])
+		if err != nil {
+			return err
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(o *observation.Observation) error {
+				return o.Pause(context.Background(), dialogID)
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	dialogsCmd.AddCommand(dialogsPauseCmd)
+}
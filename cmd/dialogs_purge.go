@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/observation"
+	"go.uber.org/fx"
+)
+
+var dialogsPurgeCmd = &cobra.Command{
+	Use:   "purge <dialog-id>",
+	Short: "Pause a dialog and delete all of its messages",
+	Long: `Pause a dialog and delete all of its messages along with their embeddings.
+A basic group and the supergroup it was upgraded to are purged together.
+The embedding cache keeps its entries, which are keyed by text hashes.
+If the dialog is resumed, its history has to be backfilled again.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := parseDialogID(args[0])
+		if err != nil {
+			return err
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(o *observation.Observation) error {
+				count, err := o.Purge(context.Background(), dialogID)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("deleted %d messages\n", count)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	dialogsCmd.AddCommand(dialogsPurgeCmd)
+}
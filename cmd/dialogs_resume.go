@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/observation"
+	"go.uber.org/fx"
+)
+
+var dialogsResumeCmd = &cobra.Command{
+	Use:   "resume <dialog-id>",
+	Short: "Resume recording messages of a paused dialog",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := parseDialogID(args[0])
+		if err != nil {
+			return err
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(o *observation.Observation) error {
+				return o.Resume(context.Background(), dialogID)
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	dialogsCmd.AddCommand(dialogsResumeCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/observation"
+	"go.uber.org/fx"
+)
+
+var (
+	dialogEmbeddingEnabled bool
+	dialogRetention        time.Duration
+)
+
+var dialogsSetCmd = &cobra.Command{
+	Use:   "set <dialog-id>",
+	Short: "Change the observation settings of a dialog",
+	Long: `Change the observation settings of a dialog.
+Only the given settings are changed.`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("embedding") && !cmd.Flags().Changed("retention") {
+			return fmt.Errorf("at least one setting should be given")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := parseDialogID(args[0])
+		if err != nil {
+			return err
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(o *observation.Observation) error {
+				if cmd.Flags().Changed("embedding") {
+					if err := o.SetEmbeddingEnabled(context.Background(), dialogID, dialogEmbeddingEnabled); err != nil {
+						return err
+					}
+				}
+				if cmd.Flags().Changed("retention") {
+					if err := o.SetRetention(context.Background(), dialogID, dialogRetention); err != nil {
+						return err
+					}
+				}
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	dialogsCmd.AddCommand(dialogsSetCmd)
+
+	dialogsSetCmd.Flags().BoolVar(&dialogEmbeddingEnabled, "embedding", true, "embed messages of the dialog")
+	dialogsSetCmd.Flags().DurationVar(&dialogRetention, "retention", 0, "how long messages of the dialog are kept, 0 to keep them forever")
+}
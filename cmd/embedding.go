@@ -0,0 +1,14 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var embeddingCmd = &cobra.Command{
+	Use:   "embedding",
+	Short: "Embedding management commands",
+	Long: `Embedding management commands for TeleMikiya.
+These commands help you inspect and manage the embeddings of messages.`,
+}
+
+func init() {
+	rootCmd.AddCommand(embeddingCmd)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/embedding/cache"
+	"go.uber.org/fx"
+)
+
+var embeddingStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show embedding statistics",
+	Long: `Show embedding cache statistics of the configured model.
+The hit rate is the share of texts whose embeddings were taken from the cache.
+If a next model is configured, also show how many messages are re-embedded with it,
+and how many ran out of attempts to be re-embedded.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(cfg *config.Config, db *database.Database, c *cache.Cache) error {
+				stats, err := c.Stats(context.Background())
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("entries: %d\nhits: %d\nhit rate: %.1f%%\n", stats.Entries, stats.Hits, stats.HitRate()*100)
+
+				next, ok := cfg.Embedding.NextModel()
+				if !ok {
+					return nil
+				}
+				coverage, err := db.NextEmbeddingCoverage(context.Background(), next.ModelKey())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("next model: %s\nre-embedded: %d of %d (%.1f%%)\nfailed: %d\n",
+					next.ModelKey(), coverage.Reembedded, coverage.Embedded, coverage.Ratio()*100, coverage.Failed)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	embeddingCmd.AddCommand(embeddingStatsCmd)
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"go.uber.org/fx"
+)
+
+var minEmbeddingCoverage float64
+
+var embeddingSwitchCmd = &cobra.Command{
+	Use:   "switch",
+	Short: "Switch search over to the next embedding model",
+	Long: `Switch search over to the next embedding model.
+The embeddings of the messages are replaced with the ones by the next model at once,
+if enough messages are re-embedded. Search and embedding refuse to start afterwards
+until the next model is configured as the current model, which is printed.
+The messages left are embedded again then.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(cfg *config.Config, db *database.Database) error {
+				next, ok := cfg.Embedding.NextModel()
+				if !ok {
+					return errors.New("no next embedding model is configured")
+				}
+
+				coverage, err := db.SwitchEmbedding(context.Background(), next.ModelKey(), next.Dimensions, minEmbeddingCoverage)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("switched to %s with %d of %d messages re-embedded (%.1f%%)\n",
+					next.ModelKey(), coverage.Reembedded, coverage.Embedded, coverage.Ratio()*100)
+				fmt.Println("search and embedding refuse to start until the new model is configured as the current model.")
+				fmt.Println("set these settings in [embedding], remove [embedding.next], and start TeleMikiya again:")
+				fmt.Println()
+				fmt.Println("[embedding]")
+				fmt.Printf("provider = %q\n", next.Provider)
+				if lo.IsNotEmpty(next.BaseURL) {
+					fmt.Printf("base_url = %q\n", next.BaseURL)
+				}
+				fmt.Printf("model = %q\n", next.Model)
+				fmt.Printf("dimensions = %d\n", next.Dimensions)
+				fmt.Printf("query_prefix = %q\n", next.QueryPrefix)
+				fmt.Printf("document_prefix = %q\n", next.DocumentPrefix)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	embeddingCmd.AddCommand(embeddingSwitchCmd)
+	embeddingSwitchCmd.Flags().Float64Var(&minEmbeddingCoverage, "min-coverage", 0.99, "minimum share of embedded messages re-embedded with the next model")
+}
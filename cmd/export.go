@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/exporter"
+	"go.uber.org/fx"
+)
+
+var (
+	exportFormat         string
+	exportOutput         string
+	exportDialogID       int64
+	exportFrom           string
+	exportStartTimeStr   string
+	exportEndTimeStr     string
+	exportQuery          string
+	exportCount          uint
+	exportIncludeDeleted bool
+	exportWithEmbeddings bool
+
+	exportStartTime time.Time
+	exportEndTime   time.Time
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export messages to JSONL, CSV or Markdown",
+	Long: `Export stored messages to JSONL, CSV or a readable Markdown transcript per dialog.
+Messages can be filtered by dialog, sender and time range, or be the results of a search query.`,
+	Example: `  telemikiya export --format jsonl --output messages.jsonl
+  telemikiya export --format markdown --dialog-id -1001234567890 --start-time "2024-01-01 00:00:00"
+  telemikiya export --format csv --from Alice --query "docker compose" --count 100
+  telemikiya export --format jsonl --with-embeddings --output embeddings.jsonl`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+		if !lo.Contains(exporter.Format("").Values(), exportFormat) {
+			return fmt.Errorf("invalid format: %s", exportFormat)
+		}
+		if lo.IsNotEmpty(exportStartTimeStr) {
+			if exportStartTime, err = time.Parse(time.DateTime, exportStartTimeStr); err != nil {
+				return fmt.Errorf("failed to parse start time: %w", err)
+			}
+		}
+		if lo.IsNotEmpty(exportEndTimeStr) {
+			if exportEndTime, err = time.Parse(time.DateTime, exportEndTimeStr); err != nil {
+				return fmt.Errorf("failed to parse end time: %w", err)
+			}
+		}
+
+		return
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(e *exporter.Exporter) (err error) {
+				params := exporter.ExportParams{
+					Format:         exporter.Format(exportFormat),
+					DialogID:       exportDialogID,
+					StartTime:      exportStartTime,
+					EndTime:        exportEndTime,
+					IncludeDeleted: exportIncludeDeleted,
+					WithEmbeddings: exportWithEmbeddings,
+					Query:          exportQuery,
+					Count:          exportCount,
+				}
+				if senderID, err := strconv.ParseInt(exportFrom, 10, 64); err == nil {
+					params.SenderID = senderID
+				} else {
+					params.SenderName = exportFrom
+				}
+
+				var w io.Writer = os.Stdout
+				if lo.IsNotEmpty(exportOutput) {
+					f, err := os.Create(exportOutput)
+					if err != nil {
+						return fmt.Errorf("failed to create output file: %w", err)
+					}
+					defer func() {
+						if cerr := f.Close(); cerr != nil && err == nil {
+							err = fmt.Errorf("failed to close output file: %w", cerr)
+						}
+					}()
+					w = f
+				}
+
+				count, err := e.Export(context.Background(), w, params)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "exported %d messages\n", count)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", string(exporter.FormatJSONL), "output format: jsonl, csv or markdown")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "output file, standard output if not set")
+	exportCmd.Flags().Int64Var(&exportDialogID, "dialog-id", 0, "export messages of specific dialog, together with the basic group it was upgraded from or to")
+	exportCmd.Flags().StringVar(&exportFrom, "from", "", "export messages sent by a specific sender (ID or name)")
+	exportCmd.Flags().StringVar(&exportStartTimeStr, "start-time", "", "export messages after this time (format: YYYY-MM-DD HH:mm:ss)")
+	exportCmd.Flags().StringVar(&exportEndTimeStr, "end-time", "", "export messages before this time (format: YYYY-MM-DD HH:mm:ss)")
+	exportCmd.Flags().StringVarP(&exportQuery, "query", "q", "", "export the results of searching for this instead")
+	exportCmd.Flags().UintVarP(&exportCount, "count", "c", 100, "maximum number of search results to export")
+	exportCmd.Flags().BoolVar(&exportIncludeDeleted, "include-deleted", false, "include messages deleted in telegram")
+	exportCmd.Flags().BoolVar(&exportWithEmbeddings, "with-embeddings", false, "include the embedding of each message, only supported by jsonl")
+}
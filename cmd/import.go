@@ -0,0 +1,14 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import message history from other sources",
+	Long: `Import message history from other sources.
+Imported messages are indexed like observed ones.`,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+}
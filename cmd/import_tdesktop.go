@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/importer/tdesktop"
+	"go.uber.org/fx"
+)
+
+var importTDesktopCmd = &cobra.Command{
+	Use:   "tdesktop <path>",
+	Short: "Import a Telegram Desktop chat history export",
+	Long: `Import a Telegram Desktop chat history export.
+The path is the result.json of a JSON export or the directory containing it.
+Both single-chat and full-account exports are supported. Messages that have
+been imported before are updated instead of being duplicated.`,
+	Example: `  telemikiya import tdesktop ~/Downloads/Telegram\ Desktop/ChatExport_2024-01-01`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(i *tdesktop.Importer) error {
+				result, err := i.Import(context.Background(), args[0])
+				if err != nil {
+					return err
+				}
+				fmt.Printf("imported %d dialogs: %d messages saved, %d unchanged, %d skipped\n",
+					result.Dialogs, result.Saved, result.Unchanged, result.Skipped)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	importCmd.AddCommand(importTDesktopCmd)
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/xyenon/telemikiya/config"
 	"github.com/xyenon/telemikiya/database"
 	"github.com/xyenon/telemikiya/embedding"
+	"github.com/xyenon/telemikiya/embedding/cache"
 	"github.com/xyenon/telemikiya/embedding/provider"
+	"github.com/xyenon/telemikiya/exporter"
+	"github.com/xyenon/telemikiya/importer/tdesktop"
 	"github.com/xyenon/telemikiya/libs"
+	"github.com/xyenon/telemikiya/observation"
 	"github.com/xyenon/telemikiya/searcher"
 	"github.com/xyenon/telemikiya/telegram"
 	tgbotsearcher "github.com/xyenon/telemikiya/telegram/bot/searcher"
@@ -52,11 +56,17 @@ func fxOptions() fx.Option {
 			logger.Debug("config loaded", zap.Reflect("config", cfg))
 		}),
 		fx.Provide(database.New),
+		fx.Provide(observation.New),
+		fx.Provide(tdesktop.New),
+		fx.Provide(exporter.New),
 		fx.Provide(provider.New),
+		fx.Provide(fx.Annotate(provider.NewNext, fx.ResultTags(`name:"nextEmbeddingProvider"`))),
+		fx.Provide(cache.New),
 		fx.Provide(searcher.New),
 		fx.Provide(embedding.New),
 		fx.Provide(
 			observer.New,
+			observer.NewEvaluator,
 			tgbotsearcher.New,
 			fx.Annotate(telegram.NewUser, fx.ResultTags(`name:"tgUser"`)),
 			fx.Annotate(telegram.NewBot, fx.ResultTags(`name:"tgBot"`)),
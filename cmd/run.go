@@ -24,7 +24,7 @@ var runCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		opts := []fx.Option{fxOptions()}
 		if enableObserver {
-			opts = append(opts, fx.Invoke(func(*observer.Observer) {}))
+			opts = append(opts, fx.Invoke(observer.Observe))
 		}
 		if enableEmbedding {
 			opts = append(opts, fx.Invoke(func(*embedding.Embedding) {}))
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,10 +15,17 @@ import (
 )
 
 var (
-	count        uint
-	startTimeStr string
-	endTimeStr   string
-	dialogID     int64
+	count            uint
+	startTimeStr     string
+	endTimeStr       string
+	dialogID         int64
+	includeDeleted   bool
+	mediaType        string
+	filename         string
+	from             string
+	topicID          int
+	showThread       bool
+	collapseForwards bool
 
 	startTime time.Time
 	endTime   time.Time
@@ -30,7 +38,10 @@ var searchCmd = &cobra.Command{
 The results are ranked based on both semantic relevance and text matching scores.`,
 	Example: `  telemikiya search how is the weather today
   telemikiya search --count 20 --dialog-id 123456789 recommend a movie
-  telemikiya search --start-time "2024-01-01 00:00:00" happy new year`,
+  telemikiya search --start-time "2024-01-01 00:00:00" happy new year
+  telemikiya search --media-type application/pdf --filename invoice payment
+  telemikiya search --from Alice docker compose
+  telemikiya search --thread --dialog-id -1001234567890 --topic-id 42 release date`,
 	ValidArgs: []string{"keywords"},
 	Args:      cobra.MinimumNArgs(1),
 	PreRunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -52,11 +63,21 @@ The results are ranked based on both semantic relevance and text matching scores
 			fxOptions(),
 			fx.Invoke(func(s *searcher.Searcher) error {
 				params := searcher.SearchParams{
-					Input:     strings.Join(args, " "),
-					Count:     count,
-					StartTime: startTime,
-					EndTime:   endTime,
-					DialogID:  dialogID,
+					Input:            strings.Join(args, " "),
+					Count:            count,
+					StartTime:        startTime,
+					EndTime:          endTime,
+					DialogID:         dialogID,
+					IncludeDeleted:   includeDeleted,
+					MediaType:        mediaType,
+					Filename:         filename,
+					TopicID:          topicID,
+					CollapseForwards: collapseForwards,
+				}
+				if senderID, err := strconv.ParseInt(from, 10, 64); err == nil {
+					params.SenderID = senderID
+				} else {
+					params.SenderName = from
 				}
 
 				messages, err := s.Search(context.Background(), params)
@@ -65,8 +86,23 @@ The results are ranked based on both semantic relevance and text matching scores
 				}
 
 				for i, message := range messages {
-					fmt.Printf("%d. %s\n", i+1, libs.DeepLink(message))
-					fmt.Println(libs.Indent(message.Text, 4))
+					if sender := message.Edges.Sender; sender != nil {
+						fmt.Printf("%d. %s (%s)\n", i+1, libs.DeepLink(message), sender.Name)
+					} else {
+						fmt.Printf("%d. %s\n", i+1, libs.DeepLink(message))
+					}
+					if origin, ok := libs.ForwardOrigin(message); ok {
+						fmt.Println(libs.Indent("forwarded from "+origin, 4))
+					}
+					if showThread {
+						thread, err := s.Thread(context.Background(), message.DialogID, message.MsgID)
+						if err != nil {
+							return err
+						}
+						printThread(thread, 4)
+					} else {
+						fmt.Println(libs.Indent(libs.MatchedText(message), 4))
+					}
 					if i < len(messages)-1 {
 						fmt.Println()
 					}
@@ -86,5 +122,12 @@ func init() {
 	searchCmd.Flags().UintVarP(&count, "count", "c", 10, "maximum number of messages to return")
 	searchCmd.Flags().StringVar(&startTimeStr, "start-time", "", "search messages after this time (format: YYYY-MM-DD HH:mm:ss)")
 	searchCmd.Flags().StringVar(&endTimeStr, "end-time", "", "search messages before this time (format: YYYY-MM-DD HH:mm:ss)")
-	searchCmd.Flags().Int64Var(&dialogID, "dialog-id", 0, "search in specific dialog")
+	searchCmd.Flags().Int64Var(&dialogID, "dialog-id", 0, "search in specific dialog, together with the basic group it was upgraded from or to")
+	searchCmd.Flags().StringVar(&mediaType, "media-type", "", "search messages with specific media type, document type or MIME type")
+	searchCmd.Flags().StringVar(&filename, "filename", "", "search messages with documents whose file name contains this")
+	searchCmd.Flags().StringVar(&from, "from", "", "search messages sent by a specific sender (ID or name)")
+	searchCmd.Flags().IntVar(&topicID, "topic-id", 0, "search in specific forum topic")
+	searchCmd.Flags().BoolVar(&showThread, "thread", false, "show the discussion around each message")
+	searchCmd.Flags().BoolVar(&collapseForwards, "collapse-forwards", false, "show forwarded copies of a message only once")
+	searchCmd.Flags().BoolVar(&includeDeleted, "include-deleted", false, "include messages deleted in telegram")
 }
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/telegram/user/observer"
+	"go.uber.org/fx"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Backfill message history of observed dialogs",
+	Long: `Backfill message history of observed dialogs.
+Messages sent before the observer was started are fetched page by page and indexed.
+Progress is recorded per dialog, so an interrupted sync resumes where it stopped.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(o *observer.Observer) error {
+				return o.Backfill(context.Background())
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+}
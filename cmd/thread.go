@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/xyenon/telemikiya/database/ent"
+	"github.com/xyenon/telemikiya/libs"
+	"github.com/xyenon/telemikiya/searcher"
+	"go.uber.org/fx"
+)
+
+var threadCmd = &cobra.Command{
+	Use:   "thread <dialog-id> <msg-id>",
+	Short: "Show the discussion around a message",
+	Long: `Show the discussion around a message by walking its reply chain.
+The messages it replies to are listed above it and its direct replies below it.`,
+	Example: `  telemikiya thread -1001234567890 42`,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dialogID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse dialog id: %w", err)
+		}
+		msgID, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to parse message id: %w", err)
+		}
+
+		app := fx.New(
+			fxOptions(),
+			fx.Invoke(func(s *searcher.Searcher) error {
+				thread, err := s.Thread(context.Background(), dialogID, msgID)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(libs.DeepLink(thread.Message))
+				printThread(thread, 0)
+				return nil
+			}),
+		)
+
+		return app.Start(context.Background())
+	},
+}
+
+// printThread prints the ancestors, the message itself marked with ">" and
+// the replies indented one level deeper.
+func printThread(thread *searcher.Thread, spaces int) {
+	for _, message := range thread.Ancestors {
+		fmt.Println(libs.Indent("  "+formatThreadMessage(message), spaces))
+	}
+	fmt.Println(libs.Indent("> "+formatThreadMessage(thread.Message), spaces))
+	for _, message := range thread.Replies {
+		fmt.Println(libs.Indent("    "+formatThreadMessage(message), spaces))
+	}
+}
+
+func formatThreadMessage(message *ent.Message) string {
+	name := "unknown"
+	if sender := message.Edges.Sender; sender != nil {
+		name = sender.Name
+	}
+	return fmt.Sprintf("#%d %s: %s", message.MsgID, name, libs.DisplayText(message))
+}
+
+func init() {
+	rootCmd.AddCommand(threadCmd)
+}
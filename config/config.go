@@ -27,9 +27,33 @@ type Telegram struct {
 	PhoneNumber          string        `mapstructure:"phone_number"`
 	ObservedDialogIDs    []int64       `mapstructure:"observed_dialog_ids"`
 	DialogUpdateInterval time.Duration `mapstructure:"dialog_update_interval"`
+	BackfillOnStart      bool          `mapstructure:"backfill_on_start"`
+	BackfillBatchSize    int           `mapstructure:"backfill_batch_size"`
+
+	// ObservationRules are evaluated in order, and the first matching rule
+	// decides whether a dialog is observed.
+	ObservationRules []ObservationRule `mapstructure:"observation_rules"`
+
+	DeletedMessagePolicy    types.DeletedMessagePolicy `mapstructure:"deleted_message_policy"`
+	DeletedMessageRetention time.Duration              `mapstructure:"deleted_message_retention"`
 
 	BotToken          string  `mapstructure:"bot_token"`
 	BotAllowedUserIDs []int64 `mapstructure:"bot_allowed_user_ids"`
+	// BotAdminUserIDs are the users allowed to change which dialogs are observed through the bot.
+	BotAdminUserIDs []int64 `mapstructure:"bot_admin_user_ids"`
+}
+
+// ObservationRule includes or excludes the dialogs matching all of its criteria.
+// A criterion that is not set matches any dialog.
+type ObservationRule struct {
+	Action     types.ObservationAction `mapstructure:"action"`
+	DialogIDs  []int64                 `mapstructure:"dialog_ids"`
+	Types      []types.DialogType      `mapstructure:"types"`
+	TitleRegex string                  `mapstructure:"title_regex"`
+	Usernames  []string                `mapstructure:"usernames"`
+	Bot        *bool                   `mapstructure:"bot"`
+	// Folders matches dialogs in any of the Telegram chat folders with these titles.
+	Folders []string `mapstructure:"folders"`
 }
 
 // Database represents the database configuration.
@@ -54,6 +78,172 @@ type Embedding struct {
 	Ollama     Ollama             `mapstructure:"ollama"`
 	OpenAI     OpenAI             `mapstructure:"openai"`
 	Google     Google             `mapstructure:"google"`
+
+	// MaxAttempts is the number of times a message is tried before it is
+	// marked as failed and skipped.
+	MaxAttempts uint          `mapstructure:"max_attempts"`
+	MinBackoff  time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+
+	// QueryPrefix and DocumentPrefix are prepended to search queries and to
+	// the texts of messages, as asymmetric models like nomic-embed-text and
+	// e5 expect. Google models are told the task type instead.
+	QueryPrefix    string `mapstructure:"query_prefix"`
+	DocumentPrefix string `mapstructure:"document_prefix"`
+	// CharsPerToken overrides the provider's estimate of the average number
+	// of characters of a token within a word, by which tokens are counted.
+	CharsPerToken float64 `mapstructure:"chars_per_token"`
+
+	Batching    Batching    `mapstructure:"batching"`
+	Eligibility Eligibility `mapstructure:"eligibility"`
+	Chunking    Chunking    `mapstructure:"chunking"`
+	Context     Context     `mapstructure:"context"`
+
+	RateLimit RateLimit `mapstructure:"rate_limit"`
+	Failover  Failover  `mapstructure:"failover"`
+
+	// Next is the model the messages are re-embedded with in the background,
+	// while search keeps using the current model until switching over.
+	Next NextModel `mapstructure:"next"`
+}
+
+// ModelKey identifies the provider, model and dimensions of the embeddings.
+func (e *Embedding) ModelKey() string {
+	return fmt.Sprintf("%s:%s:%d", e.Provider, e.Model, e.Dimensions)
+}
+
+// validateFailover returns an error if a failover provider is set to another
+// model than the configured one, whose embeddings could not be compared.
+func (e *Embedding) validateFailover() error {
+	for _, p := range e.Failover.Providers {
+		if lo.IsNotEmpty(p.Model) && p.Model != e.Model {
+			return fmt.Errorf("failover provider %s uses model %s instead of the configured model %s", p.Name, p.Model, e.Model)
+		}
+	}
+	return nil
+}
+
+// NextModel returns the embedding configuration of the next model, if one is
+// set. The settings not set for the next model are taken from the current one.
+func (e *Embedding) NextModel() (*Embedding, bool) {
+	if lo.IsEmpty(e.Next.Model) {
+		return nil, false
+	}
+	next := *e
+	next.Next = NextModel{}
+	next.Failover = Failover{}
+	if lo.IsNotEmpty(e.Next.Provider) && e.Next.Provider != e.Provider {
+		next.Provider, next.BaseURL = e.Next.Provider, ""
+	}
+	next.BaseURL = lo.CoalesceOrEmpty(e.Next.BaseURL, next.BaseURL)
+	next.Model = e.Next.Model
+	next.Dimensions = lo.CoalesceOrEmpty(e.Next.Dimensions, e.Dimensions)
+	next.QueryPrefix, next.DocumentPrefix = e.Next.QueryPrefix, e.Next.DocumentPrefix
+	next.CharsPerToken = 0
+	return &next, true
+}
+
+// Batching bounds the requests to the provider, which are split into as many
+// requests as needed. Limits of 0 are disabled.
+type Batching struct {
+	// MaxInputs is the maximum number of texts embedded in a request.
+	MaxInputs uint `mapstructure:"max_inputs"`
+	// MaxTokens is the maximum estimated number of tokens of a request.
+	MaxTokens uint `mapstructure:"max_tokens"`
+	// MaxInputTokens is the context length of the model. Longer texts are
+	// truncated to it before they are embedded.
+	MaxInputTokens uint `mapstructure:"max_input_tokens"`
+}
+
+// Eligibility decides which messages are worth embedding. The others are
+// only searchable through full-text search.
+type Eligibility struct {
+	// MinLength is the minimum number of characters of the text, not
+	// counting surrounding whitespace.
+	MinLength     uint `mapstructure:"min_length"`
+	SkipEmojiOnly bool `mapstructure:"skip_emoji_only"`
+	// StopPhrases are skipped when they are the whole text, ignoring case,
+	// whitespace and surrounding punctuation.
+	StopPhrases []string `mapstructure:"stop_phrases"`
+}
+
+// Chunking splits messages too long to be embedded at once into chunks,
+// which are embedded separately.
+type Chunking struct {
+	// MaxTokens is the estimated number of tokens above which messages are
+	// split, and the maximum size of their chunks. 0 disables chunking.
+	MaxTokens uint `mapstructure:"max_tokens"`
+	// Overlap is the estimated number of tokens shared by consecutive chunks.
+	Overlap uint `mapstructure:"overlap"`
+}
+
+// Context adds the messages around a message to the text embedded for it,
+// so that short chat messages can be found by what they are about.
+type Context struct {
+	// Strategies are the embedding strategies by dialog type. Messages are
+	// embedded on their own in dialogs of other types.
+	Strategies map[types.DialogType]types.EmbeddingStrategy `mapstructure:"strategies"`
+	// Messages is the number of preceding messages added by the preceding strategy.
+	Messages uint `mapstructure:"messages"`
+}
+
+// RateLimit limits the use of a provider. Limits of 0 are disabled.
+type RateLimit struct {
+	RequestsPerMinute uint `mapstructure:"requests_per_minute"`
+	// TokensPerMinute limits the estimated number of tokens sent per minute.
+	TokensPerMinute uint `mapstructure:"tokens_per_minute"`
+	// DailySpendCap is the most spent per day (UTC) on the estimated tokens,
+	// priced by PricePerMillionTokens. Once it is reached, indexing pauses
+	// until the next day, while search queries are still embedded. The
+	// spending is counted per provider type, model and base URL in the
+	// database.
+	DailySpendCap         float64 `mapstructure:"daily_spend_cap"`
+	PricePerMillionTokens float64 `mapstructure:"price_per_million_tokens"`
+}
+
+// Failover falls back to other providers when a provider fails. The
+// configured provider is named "primary".
+type Failover struct {
+	// Providers are tried in order after the primary provider. They must
+	// serve the same model, as their embeddings are stored and cached as the
+	// ones of the configured model.
+	Providers []FailoverProvider `mapstructure:"providers"`
+	// Cooldown is how long a failed provider is only tried as a last resort.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+	// Query and Document are the names of the providers used for search
+	// queries and for indexing messages, in the order they are tried.
+	// All providers are used in order if not set.
+	Query    []string `mapstructure:"query"`
+	Document []string `mapstructure:"document"`
+}
+
+// FailoverProvider is a provider to fall back to. The settings not set here
+// are taken from the primary provider, except for the base URL of another
+// provider type.
+type FailoverProvider struct {
+	Name     string             `mapstructure:"name"`
+	Provider types.ProviderType `mapstructure:"provider"`
+	BaseURL  string             `mapstructure:"base_url"`
+	// Model must be the configured model, if set.
+	Model   string        `mapstructure:"model"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// APIKey is the API key of OpenAI or Google.
+	APIKey string `mapstructure:"api_key"`
+	// RateLimit is not taken from the primary provider, as it usually
+	// belongs to another service.
+	RateLimit RateLimit `mapstructure:"rate_limit"`
+}
+
+// NextModel is the embedding model to switch to. It is disabled unless Model
+// is set. The base URL of the current model is only kept for the same provider,
+// and the prefixes are never kept, as they are specific to the model.
+type NextModel struct {
+	Provider       types.ProviderType `mapstructure:"provider"`
+	BaseURL        string             `mapstructure:"base_url"`
+	Model          string             `mapstructure:"model"`
+	Dimensions     uint               `mapstructure:"dimensions"`
+	QueryPrefix    string             `mapstructure:"query_prefix"`
+	DocumentPrefix string             `mapstructure:"document_prefix"`
 }
 
 type Ollama struct {
@@ -108,7 +298,10 @@ func New(cfgFile string) (cfg *Config, err error) {
 	v.AutomaticEnv()
 
 	if err = v.Unmarshal(&cfg); err != nil {
-		err = fmt.Errorf("failed to unmarshal config: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if err = cfg.Embedding.validateFailover(); err != nil {
+		return nil, err
 	}
 
 	return
@@ -15,6 +15,7 @@ import (
 	"github.com/xyenon/telemikiya/config"
 	"github.com/xyenon/telemikiya/database/ent"
 	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entmessagechunk "github.com/xyenon/telemikiya/database/ent/messagechunk"
 	"github.com/xyenon/telemikiya/database/ent/migrate"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -33,6 +34,7 @@ type Params struct {
 }
 
 type Database struct {
+	embeddingModel      string
 	embeddingDimensions uint
 	allowClearEmbedding bool
 	logger              *zap.Logger
@@ -72,6 +74,7 @@ func New(params Params) (*Database, error) {
 	}
 
 	db := &Database{
+		embeddingModel:      params.Config.Embedding.ModelKey(),
 		embeddingDimensions: params.Config.Embedding.Dimensions,
 		allowClearEmbedding: params.AllowClearEmbedding,
 		logger:              params.Logger,
@@ -100,7 +103,8 @@ func (d *Database) Close() error {
 }
 
 func (d *Database) Migrate(ctx context.Context) error {
-	return d.Schema.Create(
+	var embeddingCleared bool
+	err := d.Schema.Create(
 		ctx,
 		migrate.WithDropIndex(true),
 		migrate.WithDropColumn(true),
@@ -120,14 +124,24 @@ func (d *Database) Migrate(ctx context.Context) error {
 		}),
 		schema.WithApplyHook(func(next schema.Applier) schema.Applier {
 			return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *atlasmigrate.Plan) error {
-				if d.isEmbeddingDimensionsChanged(plan) {
+				changedTables := d.embeddingDimensionsChangedTables(plan)
+				if len(changedTables) > 0 {
 					if !d.allowClearEmbedding {
 						return ErrNotAllowedToClearEmbedding
 					}
 					d.logger.Info("embedding dimensions changed, need to clear text embedding")
-					err := d.Message.Update().ClearTextEmbedding().Exec(ctx)
-					if err != nil {
-						return fmt.Errorf("failed to clear text embedding: %w", err)
+				}
+				for _, table := range changedTables {
+					switch table {
+					case entmessage.Table:
+						if err := d.Message.Update().ClearTextEmbedding().Exec(ctx); err != nil {
+							return fmt.Errorf("failed to clear text embedding: %w", err)
+						}
+						embeddingCleared = true
+					case entmessagechunk.Table:
+						if _, err := d.MessageChunk.Delete().Exec(ctx); err != nil {
+							return fmt.Errorf("failed to delete message chunks: %w", err)
+						}
 					}
 				}
 
@@ -135,75 +149,133 @@ func (d *Database) Migrate(ctx context.Context) error {
 			})
 		}),
 	)
+	if err != nil {
+		return err
+	}
+
+	model, ok, err := d.EmbeddingModel(ctx)
+	if err != nil {
+		return err
+	}
+	// the columns keep their dimensions when changing to a model with the
+	// same dimensions, so the embeddings are cleared here instead
+	if ok && model != d.embeddingModel && !embeddingCleared {
+		if !d.allowClearEmbedding {
+			return fmt.Errorf("%w: messages are embedded with %s, but %s is configured",
+				ErrEmbeddingModelMismatch, model, d.embeddingModel)
+		}
+		d.logger.Info("embedding model changed, need to clear text embedding",
+			zap.String("from", model), zap.String("to", d.embeddingModel))
+		if _, err = d.MessageChunk.Delete().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete message chunks: %w", err)
+		}
+		embeddingCleared = true
+	}
+
+	if embeddingCleared {
+		// messages that failed to be embedded get another chance with the new
+		// model, which can only be recorded once the columns are migrated
+		update := d.Message.Update()
+		ResetEmbedding(update.Mutation())
+		if err = update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to reset failed embeddings: %w", err)
+		}
+	}
+
+	// embeddings saved before their model was recorded are assumed to be
+	// produced by the configured model
+	err = d.Message.Update().
+		Where(entmessage.TextEmbeddingNotNil(), entmessage.EmbeddingModelIsNil()).
+		SetEmbeddingModel(d.embeddingModel).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record embedding model: %w", err)
+	}
+	return setEmbeddingModel(ctx, d.Setting, d.embeddingModel)
+}
+
+// embeddingTables are the tables with a text embedding column, whose dimensions
+// follow the configuration, along with the vector index on the column.
+var embeddingTables = map[string]string{
+	entmessage.Table:      "message_text_embedding",
+	entmessagechunk.Table: "messagechunk_text_embedding",
 }
 
 func (d *Database) diffEmbeddingDimensions(current, desired *atlasschema.Schema) (changes []atlasschema.Change) {
+	for table, index := range embeddingTables {
+		if change, ok := d.diffTableEmbeddingDimensions(current, desired, table, index); ok {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+func (d *Database) diffTableEmbeddingDimensions(current, desired *atlasschema.Schema, table, index string) (atlasschema.Change, bool) {
 	currentTable, ok := lo.Find(current.Tables,
-		func(t *atlasschema.Table) bool { return t.Name == entmessage.Table },
+		func(t *atlasschema.Table) bool { return t.Name == table },
 	)
 	if !ok {
-		return
+		return nil, false
 	}
 	currentCol, ok := lo.Find(currentTable.Columns,
 		func(c *atlasschema.Column) bool { return c.Name == entmessage.FieldTextEmbedding },
 	)
 	if !ok {
-		return
+		return nil, false
 	}
 	desiredTable, ok := lo.Find(desired.Tables,
-		func(t *atlasschema.Table) bool { return t.Name == entmessage.Table },
+		func(t *atlasschema.Table) bool { return t.Name == table },
 	)
 	if !ok {
-		return
+		return nil, false
 	}
 	desiredCol, ok := lo.Find(desiredTable.Columns,
 		func(c *atlasschema.Column) bool { return c.Name == entmessage.FieldTextEmbedding },
 	)
 	if !ok {
-		return
+		return nil, false
 	}
 
 	currentColType := currentCol.Type.Type.(*postgres.UserDefinedType)
 	desiredColType := desiredCol.Type.Type.(*postgres.UserDefinedType)
 	realDesiredColType := fmt.Sprintf(desiredColType.T, d.embeddingDimensions)
-	if currentColType.T != realDesiredColType {
-		d.logger.Info("embedding dimensions changed", zap.String("from", currentColType.T), zap.String("to", realDesiredColType))
+	if currentColType.T == realDesiredColType {
+		return nil, false
+	}
+	d.logger.Info("embedding dimensions changed",
+		zap.String("table", table), zap.String("from", currentColType.T), zap.String("to", realDesiredColType))
 
-		tableChanges := []atlasschema.Change{
-			&atlasschema.ModifyColumn{
-				From:   currentCol,
-				To:     desiredCol,
-				Change: atlasschema.ChangeType,
-			},
-		}
-		currentIndex, currentIndexOk := lo.Find(currentTable.Indexes,
-			func(i *atlasschema.Index) bool { return i.Name == "message_text_embedding" },
-		)
-		desiredIndex, desiredIndexOk := lo.Find(desiredTable.Indexes,
-			func(i *atlasschema.Index) bool { return i.Name == "message_text_embedding" },
+	tableChanges := []atlasschema.Change{
+		&atlasschema.ModifyColumn{
+			From:   currentCol,
+			To:     desiredCol,
+			Change: atlasschema.ChangeType,
+		},
+	}
+	currentIndex, currentIndexOk := lo.Find(currentTable.Indexes,
+		func(i *atlasschema.Index) bool { return i.Name == index },
+	)
+	desiredIndex, desiredIndexOk := lo.Find(desiredTable.Indexes,
+		func(i *atlasschema.Index) bool { return i.Name == index },
+	)
+	if currentIndexOk && desiredIndexOk {
+		tableChanges = append(tableChanges,
+			&atlasschema.DropIndex{I: currentIndex},
+			&atlasschema.AddIndex{I: desiredIndex},
 		)
-		if currentIndexOk && desiredIndexOk {
-			tableChanges = append(tableChanges,
-				&atlasschema.DropIndex{I: currentIndex},
-				&atlasschema.AddIndex{I: desiredIndex},
-			)
-		}
-
-		changes = []atlasschema.Change{
-			&atlasschema.ModifyTable{
-				T:       currentTable,
-				Changes: tableChanges,
-			},
-		}
 	}
-	return
+
+	return &atlasschema.ModifyTable{
+		T:       currentTable,
+		Changes: tableChanges,
+	}, true
 }
 
 func (d *Database) modifyEmbeddingDimensions(changes []atlasschema.Change) {
 	for _, c := range changes {
 		switch c := c.(type) {
 		case *atlasschema.AddTable:
-			if c.T.Name != entmessage.Table {
+			if _, ok := embeddingTables[c.T.Name]; !ok {
 				continue
 			}
 			for _, col := range c.T.Columns {
@@ -214,7 +286,7 @@ func (d *Database) modifyEmbeddingDimensions(changes []atlasschema.Change) {
 				t.T = fmt.Sprintf(t.T, d.embeddingDimensions)
 			}
 		case *atlasschema.ModifyTable:
-			if c.T.Name != entmessage.Table {
+			if _, ok := embeddingTables[c.T.Name]; !ok {
 				continue
 			}
 			for _, cc := range c.Changes {
@@ -239,10 +311,15 @@ func (d *Database) modifyEmbeddingDimensions(changes []atlasschema.Change) {
 	}
 }
 
-func (d *Database) isEmbeddingDimensionsChanged(plan *atlasmigrate.Plan) bool {
+// embeddingDimensionsChangedTables returns the tables whose embedding
+// dimensions are changed by the plan.
+func (d *Database) embeddingDimensionsChangedTables(plan *atlasmigrate.Plan) (tables []string) {
 	for _, c := range plan.Changes {
 		cc, ok := c.Source.(*atlasschema.ModifyTable)
-		if !ok || cc.T.Name != entmessage.Table {
+		if !ok {
+			continue
+		}
+		if _, ok := embeddingTables[cc.T.Name]; !ok {
 			continue
 		}
 		for _, ccc := range cc.Changes {
@@ -251,9 +328,10 @@ func (d *Database) isEmbeddingDimensionsChanged(plan *atlasmigrate.Plan) bool {
 				cccc.To.Name == entmessage.FieldTextEmbedding &&
 				cccc.Change == atlasschema.ChangeType &&
 				cccc.From.Type.Type.(*postgres.UserDefinedType).T != cccc.To.Type.Type.(*postgres.UserDefinedType).T {
-				return true
+				tables = append(tables, cc.T.Name)
+				break
 			}
 		}
 	}
-	return false
+	return tables
 }
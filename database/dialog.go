@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+)
+
+// AdvanceLastMsgID moves the high-water mark of the dialog forward to msgID,
+// after which messages are caught up when the observer starts.
+func AdvanceLastMsgID(ctx context.Context, client *ent.DialogClient, dialogID int64, msgID int) error {
+	err := client.Update().
+		Where(
+			entdialog.ID(dialogID),
+			entdialog.Or(entdialog.LastMsgIDIsNil(), entdialog.LastMsgIDLT(msgID)),
+		).
+		SetLastMsgID(msgID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save last message id: %w", err)
+	}
+	return nil
+}
+
+// ConversationDialogIDs returns the IDs of the dialogs making up the same
+// conversation as the given dialog, which are a basic group and the supergroup
+// it was upgraded to.
+func ConversationDialogIDs(ctx context.Context, client *ent.DialogClient, dialogID int64) ([]int64, error) {
+	dialogs, err := client.Query().
+		Where(entdialog.Or(entdialog.ID(dialogID), entdialog.MigratedToID(dialogID))).
+		Select(entdialog.FieldID, entdialog.FieldMigratedToID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dialogs: %w", err)
+	}
+
+	dialogIDs := []int64{dialogID}
+	for _, dialog := range dialogs {
+		dialogIDs = append(dialogIDs, dialog.ID)
+		if dialog.MigratedToID != nil {
+			dialogIDs = append(dialogIDs, *dialog.MigratedToID)
+		}
+	}
+	return lo.Uniq(dialogIDs), nil
+}
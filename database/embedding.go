@@ -0,0 +1,253 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xyenon/telemikiya/database/ent"
+	entembeddingspend "github.com/xyenon/telemikiya/database/ent/embeddingspend"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entmessagechunk "github.com/xyenon/telemikiya/database/ent/messagechunk"
+	entnextembedding "github.com/xyenon/telemikiya/database/ent/nextembedding"
+	entnextembeddingfailure "github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	entsetting "github.com/xyenon/telemikiya/database/ent/setting"
+)
+
+// embeddingModelSetting is the setting of the model the embeddings of the
+// messages are produced by, which search and embedding must be configured with.
+const embeddingModelSetting = "embedding_model"
+
+// EmbeddingModel returns the model the embeddings of the messages are produced
+// by, unless the database was not migrated since it is recorded.
+func (d *Database) EmbeddingModel(ctx context.Context) (string, bool, error) {
+	setting, err := d.Setting.Get(ctx, embeddingModelSetting)
+	switch {
+	case ent.IsNotFound(err):
+		return "", false, nil
+	case err != nil:
+		return "", false, fmt.Errorf("failed to get embedding model: %w", err)
+	}
+	return setting.Value, true, nil
+}
+
+func setEmbeddingModel(ctx context.Context, client *ent.SettingClient, model string) error {
+	err := client.Create().
+		SetID(embeddingModelSetting).
+		SetValue(model).
+		OnConflictColumns(entsetting.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save embedding model: %w", err)
+	}
+	return nil
+}
+
+// CheckEmbeddingModel returns an error unless the configured model is the one
+// the messages are embedded with, as search would compare embeddings of
+// different models otherwise. They differ after switching to the next model,
+// until it is configured as the current model.
+func (d *Database) CheckEmbeddingModel(ctx context.Context) error {
+	model, ok, err := d.EmbeddingModel(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w, run db migrate first", ErrEmbeddingModelUnknown)
+	}
+	if model != d.embeddingModel {
+		return fmt.Errorf("%w: messages are embedded with %s, but %s is configured; "+
+			"configure [embedding] for %s, or run db migrate --allow-clear-embedding to embed all messages again with %s",
+			ErrEmbeddingModelMismatch, model, d.embeddingModel, model, d.embeddingModel)
+	}
+	return nil
+}
+
+// EmbeddingCoverage tells how many of the embedded messages are re-embedded
+// with the next model.
+type EmbeddingCoverage struct {
+	// Embedded is the number of messages embedded with either model.
+	Embedded int
+	// Reembedded is the number of messages embedded with the next model.
+	Reembedded int
+	// Failed is the number of messages that ran out of attempts to be
+	// re-embedded with the next model.
+	Failed int
+}
+
+// Ratio is the share of the embedded messages re-embedded with the next model.
+func (c EmbeddingCoverage) Ratio() float64 {
+	if c.Embedded == 0 {
+		return 0
+	}
+	return float64(c.Reembedded) / float64(c.Embedded)
+}
+
+// NextEmbeddingCoverage tells how many of the embedded messages are
+// re-embedded with the next model.
+func (d *Database) NextEmbeddingCoverage(ctx context.Context, model string) (EmbeddingCoverage, error) {
+	return nextEmbeddingCoverage(ctx, d.Client, model)
+}
+
+func nextEmbeddingCoverage(ctx context.Context, client *ent.Client, model string) (coverage EmbeddingCoverage, err error) {
+	coverage.Embedded, err = client.Message.Query().
+		Where(entmessage.Or(entmessage.TextEmbeddingNotNil(), entmessage.NextEmbeddingModel(model))).
+		Count(ctx)
+	if err != nil {
+		return coverage, fmt.Errorf("failed to count embedded messages: %w", err)
+	}
+	coverage.Reembedded, err = client.Message.Query().
+		Where(entmessage.NextEmbeddingModel(model)).
+		Count(ctx)
+	if err != nil {
+		return coverage, fmt.Errorf("failed to count re-embedded messages: %w", err)
+	}
+	coverage.Failed, err = client.NextEmbeddingFailure.Query().
+		Where(entnextembeddingfailure.Model(model), entnextembeddingfailure.FailedAtNotNil()).
+		Count(ctx)
+	if err != nil {
+		return coverage, fmt.Errorf("failed to count messages failed to be re-embedded: %w", err)
+	}
+	return coverage, nil
+}
+
+// SwitchEmbedding replaces the embeddings of the messages with their
+// embeddings by the next model, once at least minCoverage of them are
+// re-embedded. The embedding columns are changed to the dimensions of the next
+// model, and the messages not re-embedded yet are left to be embedded again.
+// The next model is recorded as the model of the embeddings, so that search
+// and embedding refuse to start until it is configured as the current model.
+// It is done in a single transaction, so that search never mixes the models.
+func (d *Database) SwitchEmbedding(ctx context.Context, model string, dimensions uint, minCoverage float64) (coverage EmbeddingCoverage, err error) {
+	tx, err := d.Tx(ctx)
+	if err != nil {
+		return coverage, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// block writes, so that the coverage holds until the switch is committed
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("LOCK TABLE %s, %s, %s IN SHARE ROW EXCLUSIVE MODE",
+		entmessage.Table, entmessagechunk.Table, entnextembedding.Table))
+	if err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to lock tables: %w", err))
+	}
+	if coverage, err = nextEmbeddingCoverage(ctx, tx.Client(), model); err != nil {
+		return coverage, rollback(tx, err)
+	}
+	if coverage.Ratio() < minCoverage {
+		return coverage, rollback(tx, fmt.Errorf("%w: %d of %d messages", ErrInsufficientEmbeddingCoverage, coverage.Reembedded, coverage.Embedded))
+	}
+
+	for table, index := range embeddingTables {
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf("DROP INDEX IF EXISTS %s", index)); err != nil {
+			return coverage, rollback(tx, fmt.Errorf("failed to drop index %s: %w", index, err))
+		}
+		if table == entmessagechunk.Table {
+			if _, err = tx.MessageChunk.Delete().Exec(ctx); err != nil {
+				return coverage, rollback(tx, fmt.Errorf("failed to delete message chunks: %w", err))
+			}
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE vector(%d) USING NULL",
+			table, entmessage.FieldTextEmbedding, dimensions))
+		if err != nil {
+			return coverage, rollback(tx, fmt.Errorf("failed to change embedding dimensions of %s: %w", table, err))
+		}
+	}
+
+	// chunked messages have an embedding for each chunk
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %[1]s (id, message_id, "index", text, text_embedding)
+SELECT n.id, n.message_id, n."index", n.text, n.embedding
+FROM %[2]s n JOIN %[3]s m ON m.id = n.message_id
+WHERE n.model = $1 AND m.next_embedding_model = $1
+AND EXISTS (SELECT 1 FROM %[2]s o WHERE o.message_id = n.message_id AND o."index" > 0)`,
+		entmessagechunk.Table, entnextembedding.Table, entmessage.Table), model)
+	if err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to save message chunks: %w", err))
+	}
+
+	update := tx.Message.Update().Where(
+		entmessage.EmbeddingSkipped(false),
+		entmessage.Or(entmessage.NextEmbeddingModelIsNil(), entmessage.NextEmbeddingModelNEQ(model)),
+	)
+	ResetEmbedding(update.Mutation())
+	if err = update.Exec(ctx); err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to reset embeddings: %w", err))
+	}
+
+	// a chunked message gets the mean of the embeddings of its chunks, like
+	// when it is embedded with the current model
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`UPDATE %[1]s m SET
+text_embedding = n.embedding, embedding_strategy = n.strategy, embedding_model = $1, next_embedding_model = NULL,
+embedding_attempts = 0, embedding_error = NULL, embedding_retry_at = NULL, embedding_failed_at = NULL
+FROM (SELECT message_id, avg(embedding) AS embedding, min(strategy) AS strategy FROM %[2]s WHERE model = $1 GROUP BY message_id) n
+WHERE m.id = n.message_id AND m.next_embedding_model = $1`,
+		entmessage.Table, entnextembedding.Table), model)
+	if err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to save embeddings: %w", err))
+	}
+
+	if _, err = tx.NextEmbedding.Delete().Exec(ctx); err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to delete next embeddings: %w", err))
+	}
+	if _, err = tx.NextEmbeddingFailure.Delete().Exec(ctx); err != nil {
+		return coverage, rollback(tx, fmt.Errorf("failed to delete next embedding failures: %w", err))
+	}
+
+	if err = setEmbeddingModel(ctx, tx.Setting, model); err != nil {
+		return coverage, rollback(tx, err)
+	}
+
+	for table, index := range embeddingTables {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("CREATE INDEX %s ON %s USING vchordrq (%s vector_cosine_ops)",
+			index, table, entmessage.FieldTextEmbedding))
+		if err != nil {
+			return coverage, rollback(tx, fmt.Errorf("failed to create index %s: %w", index, err))
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return coverage, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return coverage, nil
+}
+
+// EmbeddingSpend returns the estimated spending on the provider during the
+// UTC day.
+func (d *Database) EmbeddingSpend(ctx context.Context, day time.Time, provider string) (float64, error) {
+	spend, err := d.Client.EmbeddingSpend.Query().
+		Where(entembeddingspend.Day(day), entembeddingspend.Provider(provider)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("failed to get embedding spend: %w", err)
+	}
+	return spend.Spent, nil
+}
+
+// AddEmbeddingSpend counts the amount towards the spending on the provider
+// during the UTC day.
+func (d *Database) AddEmbeddingSpend(ctx context.Context, day time.Time, provider string, amount float64) error {
+	err := d.Client.EmbeddingSpend.Create().
+		SetDay(day).
+		SetProvider(provider).
+		SetSpent(amount).
+		OnConflictColumns(entembeddingspend.FieldDay, entembeddingspend.FieldProvider).
+		Update(func(u *ent.EmbeddingSpendUpsert) {
+			u.AddSpent(amount)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save embedding spend: %w", err)
+	}
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %w", err, rerr)
+	}
+	return err
+}
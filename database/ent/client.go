@@ -17,7 +17,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/database/ent/setting"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,8 +38,26 @@ type Client struct {
 	Schema *migrate.Schema
 	// Dialog is the client for interacting with the Dialog builders.
 	Dialog *DialogClient
+	// EmbeddingCache is the client for interacting with the EmbeddingCache builders.
+	EmbeddingCache *EmbeddingCacheClient
+	// EmbeddingSpend is the client for interacting with the EmbeddingSpend builders.
+	EmbeddingSpend *EmbeddingSpendClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageChunk is the client for interacting with the MessageChunk builders.
+	MessageChunk *MessageChunkClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// NextEmbedding is the client for interacting with the NextEmbedding builders.
+	NextEmbedding *NextEmbeddingClient
+	// NextEmbeddingFailure is the client for interacting with the NextEmbeddingFailure builders.
+	NextEmbeddingFailure *NextEmbeddingFailureClient
+	// ObservationState is the client for interacting with the ObservationState builders.
+	ObservationState *ObservationStateClient
+	// Sender is the client for interacting with the Sender builders.
+	Sender *SenderClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -41,7 +70,16 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Dialog = NewDialogClient(c.config)
+	c.EmbeddingCache = NewEmbeddingCacheClient(c.config)
+	c.EmbeddingSpend = NewEmbeddingSpendClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessageChunk = NewMessageChunkClient(c.config)
+	c.MessageRevision = NewMessageRevisionClient(c.config)
+	c.NextEmbedding = NewNextEmbeddingClient(c.config)
+	c.NextEmbeddingFailure = NewNextEmbeddingFailureClient(c.config)
+	c.ObservationState = NewObservationStateClient(c.config)
+	c.Sender = NewSenderClient(c.config)
+	c.Setting = NewSettingClient(c.config)
 }
 
 type (
@@ -132,10 +170,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Dialog:  NewDialogClient(cfg),
-		Message: NewMessageClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Dialog:               NewDialogClient(cfg),
+		EmbeddingCache:       NewEmbeddingCacheClient(cfg),
+		EmbeddingSpend:       NewEmbeddingSpendClient(cfg),
+		Message:              NewMessageClient(cfg),
+		MessageChunk:         NewMessageChunkClient(cfg),
+		MessageRevision:      NewMessageRevisionClient(cfg),
+		NextEmbedding:        NewNextEmbeddingClient(cfg),
+		NextEmbeddingFailure: NewNextEmbeddingFailureClient(cfg),
+		ObservationState:     NewObservationStateClient(cfg),
+		Sender:               NewSenderClient(cfg),
+		Setting:              NewSettingClient(cfg),
 	}, nil
 }
 
@@ -153,10 +200,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Dialog:  NewDialogClient(cfg),
-		Message: NewMessageClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Dialog:               NewDialogClient(cfg),
+		EmbeddingCache:       NewEmbeddingCacheClient(cfg),
+		EmbeddingSpend:       NewEmbeddingSpendClient(cfg),
+		Message:              NewMessageClient(cfg),
+		MessageChunk:         NewMessageChunkClient(cfg),
+		MessageRevision:      NewMessageRevisionClient(cfg),
+		NextEmbedding:        NewNextEmbeddingClient(cfg),
+		NextEmbeddingFailure: NewNextEmbeddingFailureClient(cfg),
+		ObservationState:     NewObservationStateClient(cfg),
+		Sender:               NewSenderClient(cfg),
+		Setting:              NewSettingClient(cfg),
 	}, nil
 }
 
@@ -185,15 +241,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Dialog.Use(hooks...)
-	c.Message.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Dialog, c.EmbeddingCache, c.EmbeddingSpend, c.Message, c.MessageChunk,
+		c.MessageRevision, c.NextEmbedding, c.NextEmbeddingFailure, c.ObservationState,
+		c.Sender, c.Setting,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Dialog.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Dialog, c.EmbeddingCache, c.EmbeddingSpend, c.Message, c.MessageChunk,
+		c.MessageRevision, c.NextEmbedding, c.NextEmbeddingFailure, c.ObservationState,
+		c.Sender, c.Setting,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -201,8 +267,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *DialogMutation:
 		return c.Dialog.mutate(ctx, m)
+	case *EmbeddingCacheMutation:
+		return c.EmbeddingCache.mutate(ctx, m)
+	case *EmbeddingSpendMutation:
+		return c.EmbeddingSpend.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessageChunkMutation:
+		return c.MessageChunk.mutate(ctx, m)
+	case *MessageRevisionMutation:
+		return c.MessageRevision.mutate(ctx, m)
+	case *NextEmbeddingMutation:
+		return c.NextEmbedding.mutate(ctx, m)
+	case *NextEmbeddingFailureMutation:
+		return c.NextEmbeddingFailure.mutate(ctx, m)
+	case *ObservationStateMutation:
+		return c.ObservationState.mutate(ctx, m)
+	case *SenderMutation:
+		return c.Sender.mutate(ctx, m)
+	case *SettingMutation:
+		return c.Setting.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -357,6 +441,272 @@ func (c *DialogClient) mutate(ctx context.Context, m *DialogMutation) (Value, er
 	}
 }
 
+// EmbeddingCacheClient is a client for the EmbeddingCache schema.
+type EmbeddingCacheClient struct {
+	config
+}
+
+// NewEmbeddingCacheClient returns a client for the EmbeddingCache from the given config.
+func NewEmbeddingCacheClient(c config) *EmbeddingCacheClient {
+	return &EmbeddingCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingcache.Hooks(f(g(h())))`.
+func (c *EmbeddingCacheClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingCache = append(c.hooks.EmbeddingCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingcache.Intercept(f(g(h())))`.
+func (c *EmbeddingCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingCache = append(c.inters.EmbeddingCache, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingCache entity.
+func (c *EmbeddingCacheClient) Create() *EmbeddingCacheCreate {
+	mutation := newEmbeddingCacheMutation(c.config, OpCreate)
+	return &EmbeddingCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingCache entities.
+func (c *EmbeddingCacheClient) CreateBulk(builders ...*EmbeddingCacheCreate) *EmbeddingCacheCreateBulk {
+	return &EmbeddingCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingCacheClient) MapCreateBulk(slice any, setFunc func(*EmbeddingCacheCreate, int)) *EmbeddingCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingCacheCreateBulk{err: fmt.Errorf("calling to EmbeddingCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingCache.
+func (c *EmbeddingCacheClient) Update() *EmbeddingCacheUpdate {
+	mutation := newEmbeddingCacheMutation(c.config, OpUpdate)
+	return &EmbeddingCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingCacheClient) UpdateOne(ec *EmbeddingCache) *EmbeddingCacheUpdateOne {
+	mutation := newEmbeddingCacheMutation(c.config, OpUpdateOne, withEmbeddingCache(ec))
+	return &EmbeddingCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingCacheClient) UpdateOneID(id uuid.UUID) *EmbeddingCacheUpdateOne {
+	mutation := newEmbeddingCacheMutation(c.config, OpUpdateOne, withEmbeddingCacheID(id))
+	return &EmbeddingCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingCache.
+func (c *EmbeddingCacheClient) Delete() *EmbeddingCacheDelete {
+	mutation := newEmbeddingCacheMutation(c.config, OpDelete)
+	return &EmbeddingCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingCacheClient) DeleteOne(ec *EmbeddingCache) *EmbeddingCacheDeleteOne {
+	return c.DeleteOneID(ec.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingCacheClient) DeleteOneID(id uuid.UUID) *EmbeddingCacheDeleteOne {
+	builder := c.Delete().Where(embeddingcache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingCache.
+func (c *EmbeddingCacheClient) Query() *EmbeddingCacheQuery {
+	return &EmbeddingCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingCache entity by its id.
+func (c *EmbeddingCacheClient) Get(ctx context.Context, id uuid.UUID) (*EmbeddingCache, error) {
+	return c.Query().Where(embeddingcache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingCacheClient) GetX(ctx context.Context, id uuid.UUID) *EmbeddingCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingCacheClient) Hooks() []Hook {
+	return c.hooks.EmbeddingCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingCacheClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingCache
+}
+
+func (c *EmbeddingCacheClient) mutate(ctx context.Context, m *EmbeddingCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingCache mutation op: %q", m.Op())
+	}
+}
+
+// EmbeddingSpendClient is a client for the EmbeddingSpend schema.
+type EmbeddingSpendClient struct {
+	config
+}
+
+// NewEmbeddingSpendClient returns a client for the EmbeddingSpend from the given config.
+func NewEmbeddingSpendClient(c config) *EmbeddingSpendClient {
+	return &EmbeddingSpendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingspend.Hooks(f(g(h())))`.
+func (c *EmbeddingSpendClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingSpend = append(c.hooks.EmbeddingSpend, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingspend.Intercept(f(g(h())))`.
+func (c *EmbeddingSpendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingSpend = append(c.inters.EmbeddingSpend, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingSpend entity.
+func (c *EmbeddingSpendClient) Create() *EmbeddingSpendCreate {
+	mutation := newEmbeddingSpendMutation(c.config, OpCreate)
+	return &EmbeddingSpendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingSpend entities.
+func (c *EmbeddingSpendClient) CreateBulk(builders ...*EmbeddingSpendCreate) *EmbeddingSpendCreateBulk {
+	return &EmbeddingSpendCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingSpendClient) MapCreateBulk(slice any, setFunc func(*EmbeddingSpendCreate, int)) *EmbeddingSpendCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingSpendCreateBulk{err: fmt.Errorf("calling to EmbeddingSpendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingSpendCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingSpendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingSpend.
+func (c *EmbeddingSpendClient) Update() *EmbeddingSpendUpdate {
+	mutation := newEmbeddingSpendMutation(c.config, OpUpdate)
+	return &EmbeddingSpendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingSpendClient) UpdateOne(es *EmbeddingSpend) *EmbeddingSpendUpdateOne {
+	mutation := newEmbeddingSpendMutation(c.config, OpUpdateOne, withEmbeddingSpend(es))
+	return &EmbeddingSpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingSpendClient) UpdateOneID(id uuid.UUID) *EmbeddingSpendUpdateOne {
+	mutation := newEmbeddingSpendMutation(c.config, OpUpdateOne, withEmbeddingSpendID(id))
+	return &EmbeddingSpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingSpend.
+func (c *EmbeddingSpendClient) Delete() *EmbeddingSpendDelete {
+	mutation := newEmbeddingSpendMutation(c.config, OpDelete)
+	return &EmbeddingSpendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingSpendClient) DeleteOne(es *EmbeddingSpend) *EmbeddingSpendDeleteOne {
+	return c.DeleteOneID(es.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingSpendClient) DeleteOneID(id uuid.UUID) *EmbeddingSpendDeleteOne {
+	builder := c.Delete().Where(embeddingspend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingSpendDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingSpend.
+func (c *EmbeddingSpendClient) Query() *EmbeddingSpendQuery {
+	return &EmbeddingSpendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingSpend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingSpend entity by its id.
+func (c *EmbeddingSpendClient) Get(ctx context.Context, id uuid.UUID) (*EmbeddingSpend, error) {
+	return c.Query().Where(embeddingspend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingSpendClient) GetX(ctx context.Context, id uuid.UUID) *EmbeddingSpend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingSpendClient) Hooks() []Hook {
+	return c.hooks.EmbeddingSpend
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingSpendClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingSpend
+}
+
+func (c *EmbeddingSpendClient) mutate(ctx context.Context, m *EmbeddingSpendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingSpendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingSpendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingSpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingSpendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingSpend mutation op: %q", m.Op())
+	}
+}
+
 // MessageClient is a client for the Message schema.
 type MessageClient struct {
 	config
@@ -481,6 +831,86 @@ func (c *MessageClient) QueryDialog(m *Message) *DialogQuery {
 	return query
 }
 
+// QuerySender queries the sender edge of a Message.
+func (c *MessageClient) QuerySender(m *Message) *SenderQuery {
+	query := (&SenderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(sender.Table, sender.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.SenderTable, message.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Message.
+func (c *MessageClient) QueryRevisions(m *Message) *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChunks queries the chunks edge of a Message.
+func (c *MessageClient) QueryChunks(m *Message) *MessageChunkQuery {
+	query := (&MessageChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagechunk.Table, messagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ChunksTable, message.ChunksColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNextEmbeddings queries the next_embeddings edge of a Message.
+func (c *MessageClient) QueryNextEmbeddings(m *Message) *NextEmbeddingQuery {
+	query := (&NextEmbeddingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(nextembedding.Table, nextembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.NextEmbeddingsTable, message.NextEmbeddingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNextEmbeddingFailures queries the next_embedding_failures edge of a Message.
+func (c *MessageClient) QueryNextEmbeddingFailures(m *Message) *NextEmbeddingFailureQuery {
+	query := (&NextEmbeddingFailureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(nextembeddingfailure.Table, nextembeddingfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.NextEmbeddingFailuresTable, message.NextEmbeddingFailuresColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *MessageClient) Hooks() []Hook {
 	return c.hooks.Message
@@ -506,12 +936,1051 @@ func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value,
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Dialog, Message []ent.Hook
+// MessageChunkClient is a client for the MessageChunk schema.
+type MessageChunkClient struct {
+	config
+}
+
+// NewMessageChunkClient returns a client for the MessageChunk from the given config.
+func NewMessageChunkClient(c config) *MessageChunkClient {
+	return &MessageChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagechunk.Hooks(f(g(h())))`.
+func (c *MessageChunkClient) Use(hooks ...Hook) {
+	c.hooks.MessageChunk = append(c.hooks.MessageChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagechunk.Intercept(f(g(h())))`.
+func (c *MessageChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageChunk = append(c.inters.MessageChunk, interceptors...)
+}
+
+// Create returns a builder for creating a MessageChunk entity.
+func (c *MessageChunkClient) Create() *MessageChunkCreate {
+	mutation := newMessageChunkMutation(c.config, OpCreate)
+	return &MessageChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageChunk entities.
+func (c *MessageChunkClient) CreateBulk(builders ...*MessageChunkCreate) *MessageChunkCreateBulk {
+	return &MessageChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageChunkClient) MapCreateBulk(slice any, setFunc func(*MessageChunkCreate, int)) *MessageChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageChunkCreateBulk{err: fmt.Errorf("calling to MessageChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Dialog, Message []ent.Interceptor
+	builders := make([]*MessageChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &MessageChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageChunk.
+func (c *MessageChunkClient) Update() *MessageChunkUpdate {
+	mutation := newMessageChunkMutation(c.config, OpUpdate)
+	return &MessageChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageChunkClient) UpdateOne(mc *MessageChunk) *MessageChunkUpdateOne {
+	mutation := newMessageChunkMutation(c.config, OpUpdateOne, withMessageChunk(mc))
+	return &MessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageChunkClient) UpdateOneID(id uuid.UUID) *MessageChunkUpdateOne {
+	mutation := newMessageChunkMutation(c.config, OpUpdateOne, withMessageChunkID(id))
+	return &MessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageChunk.
+func (c *MessageChunkClient) Delete() *MessageChunkDelete {
+	mutation := newMessageChunkMutation(c.config, OpDelete)
+	return &MessageChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageChunkClient) DeleteOne(mc *MessageChunk) *MessageChunkDeleteOne {
+	return c.DeleteOneID(mc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageChunkClient) DeleteOneID(id uuid.UUID) *MessageChunkDeleteOne {
+	builder := c.Delete().Where(messagechunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageChunk.
+func (c *MessageChunkClient) Query() *MessageChunkQuery {
+	return &MessageChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageChunk entity by its id.
+func (c *MessageChunkClient) Get(ctx context.Context, id uuid.UUID) (*MessageChunk, error) {
+	return c.Query().Where(messagechunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageChunkClient) GetX(ctx context.Context, id uuid.UUID) *MessageChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageChunk.
+func (c *MessageChunkClient) QueryMessage(mc *MessageChunk) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagechunk.Table, messagechunk.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagechunk.MessageTable, messagechunk.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageChunkClient) Hooks() []Hook {
+	return c.hooks.MessageChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageChunkClient) Interceptors() []Interceptor {
+	return c.inters.MessageChunk
+}
+
+func (c *MessageChunkClient) mutate(ctx context.Context, m *MessageChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageChunk mutation op: %q", m.Op())
+	}
+}
+
+// MessageRevisionClient is a client for the MessageRevision schema.
+type MessageRevisionClient struct {
+	config
+}
+
+// NewMessageRevisionClient returns a client for the MessageRevision from the given config.
+func NewMessageRevisionClient(c config) *MessageRevisionClient {
+	return &MessageRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagerevision.Hooks(f(g(h())))`.
+func (c *MessageRevisionClient) Use(hooks ...Hook) {
+	c.hooks.MessageRevision = append(c.hooks.MessageRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagerevision.Intercept(f(g(h())))`.
+func (c *MessageRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageRevision = append(c.inters.MessageRevision, interceptors...)
+}
+
+// Create returns a builder for creating a MessageRevision entity.
+func (c *MessageRevisionClient) Create() *MessageRevisionCreate {
+	mutation := newMessageRevisionMutation(c.config, OpCreate)
+	return &MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageRevision entities.
+func (c *MessageRevisionClient) CreateBulk(builders ...*MessageRevisionCreate) *MessageRevisionCreateBulk {
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageRevisionClient) MapCreateBulk(slice any, setFunc func(*MessageRevisionCreate, int)) *MessageRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageRevisionCreateBulk{err: fmt.Errorf("calling to MessageRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageRevision.
+func (c *MessageRevisionClient) Update() *MessageRevisionUpdate {
+	mutation := newMessageRevisionMutation(c.config, OpUpdate)
+	return &MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageRevisionClient) UpdateOne(mr *MessageRevision) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevision(mr))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageRevisionClient) UpdateOneID(id uuid.UUID) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevisionID(id))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageRevision.
+func (c *MessageRevisionClient) Delete() *MessageRevisionDelete {
+	mutation := newMessageRevisionMutation(c.config, OpDelete)
+	return &MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageRevisionClient) DeleteOne(mr *MessageRevision) *MessageRevisionDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageRevisionClient) DeleteOneID(id uuid.UUID) *MessageRevisionDeleteOne {
+	builder := c.Delete().Where(messagerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageRevision.
+func (c *MessageRevisionClient) Query() *MessageRevisionQuery {
+	return &MessageRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageRevision entity by its id.
+func (c *MessageRevisionClient) Get(ctx context.Context, id uuid.UUID) (*MessageRevision, error) {
+	return c.Query().Where(messagerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageRevisionClient) GetX(ctx context.Context, id uuid.UUID) *MessageRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageRevision.
+func (c *MessageRevisionClient) QueryMessage(mr *MessageRevision) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagerevision.Table, messagerevision.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagerevision.MessageTable, messagerevision.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageRevisionClient) Hooks() []Hook {
+	return c.hooks.MessageRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageRevisionClient) Interceptors() []Interceptor {
+	return c.inters.MessageRevision
+}
+
+func (c *MessageRevisionClient) mutate(ctx context.Context, m *MessageRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageRevision mutation op: %q", m.Op())
+	}
+}
+
+// NextEmbeddingClient is a client for the NextEmbedding schema.
+type NextEmbeddingClient struct {
+	config
+}
+
+// NewNextEmbeddingClient returns a client for the NextEmbedding from the given config.
+func NewNextEmbeddingClient(c config) *NextEmbeddingClient {
+	return &NextEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nextembedding.Hooks(f(g(h())))`.
+func (c *NextEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.NextEmbedding = append(c.hooks.NextEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nextembedding.Intercept(f(g(h())))`.
+func (c *NextEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NextEmbedding = append(c.inters.NextEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a NextEmbedding entity.
+func (c *NextEmbeddingClient) Create() *NextEmbeddingCreate {
+	mutation := newNextEmbeddingMutation(c.config, OpCreate)
+	return &NextEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NextEmbedding entities.
+func (c *NextEmbeddingClient) CreateBulk(builders ...*NextEmbeddingCreate) *NextEmbeddingCreateBulk {
+	return &NextEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NextEmbeddingClient) MapCreateBulk(slice any, setFunc func(*NextEmbeddingCreate, int)) *NextEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NextEmbeddingCreateBulk{err: fmt.Errorf("calling to NextEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NextEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NextEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NextEmbedding.
+func (c *NextEmbeddingClient) Update() *NextEmbeddingUpdate {
+	mutation := newNextEmbeddingMutation(c.config, OpUpdate)
+	return &NextEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NextEmbeddingClient) UpdateOne(ne *NextEmbedding) *NextEmbeddingUpdateOne {
+	mutation := newNextEmbeddingMutation(c.config, OpUpdateOne, withNextEmbedding(ne))
+	return &NextEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NextEmbeddingClient) UpdateOneID(id uuid.UUID) *NextEmbeddingUpdateOne {
+	mutation := newNextEmbeddingMutation(c.config, OpUpdateOne, withNextEmbeddingID(id))
+	return &NextEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NextEmbedding.
+func (c *NextEmbeddingClient) Delete() *NextEmbeddingDelete {
+	mutation := newNextEmbeddingMutation(c.config, OpDelete)
+	return &NextEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NextEmbeddingClient) DeleteOne(ne *NextEmbedding) *NextEmbeddingDeleteOne {
+	return c.DeleteOneID(ne.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NextEmbeddingClient) DeleteOneID(id uuid.UUID) *NextEmbeddingDeleteOne {
+	builder := c.Delete().Where(nextembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NextEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for NextEmbedding.
+func (c *NextEmbeddingClient) Query() *NextEmbeddingQuery {
+	return &NextEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNextEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NextEmbedding entity by its id.
+func (c *NextEmbeddingClient) Get(ctx context.Context, id uuid.UUID) (*NextEmbedding, error) {
+	return c.Query().Where(nextembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NextEmbeddingClient) GetX(ctx context.Context, id uuid.UUID) *NextEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a NextEmbedding.
+func (c *NextEmbeddingClient) QueryMessage(ne *NextEmbedding) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ne.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nextembedding.Table, nextembedding.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nextembedding.MessageTable, nextembedding.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(ne.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NextEmbeddingClient) Hooks() []Hook {
+	return c.hooks.NextEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *NextEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.NextEmbedding
+}
+
+func (c *NextEmbeddingClient) mutate(ctx context.Context, m *NextEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NextEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NextEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NextEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NextEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NextEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// NextEmbeddingFailureClient is a client for the NextEmbeddingFailure schema.
+type NextEmbeddingFailureClient struct {
+	config
+}
+
+// NewNextEmbeddingFailureClient returns a client for the NextEmbeddingFailure from the given config.
+func NewNextEmbeddingFailureClient(c config) *NextEmbeddingFailureClient {
+	return &NextEmbeddingFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nextembeddingfailure.Hooks(f(g(h())))`.
+func (c *NextEmbeddingFailureClient) Use(hooks ...Hook) {
+	c.hooks.NextEmbeddingFailure = append(c.hooks.NextEmbeddingFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nextembeddingfailure.Intercept(f(g(h())))`.
+func (c *NextEmbeddingFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NextEmbeddingFailure = append(c.inters.NextEmbeddingFailure, interceptors...)
+}
+
+// Create returns a builder for creating a NextEmbeddingFailure entity.
+func (c *NextEmbeddingFailureClient) Create() *NextEmbeddingFailureCreate {
+	mutation := newNextEmbeddingFailureMutation(c.config, OpCreate)
+	return &NextEmbeddingFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NextEmbeddingFailure entities.
+func (c *NextEmbeddingFailureClient) CreateBulk(builders ...*NextEmbeddingFailureCreate) *NextEmbeddingFailureCreateBulk {
+	return &NextEmbeddingFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NextEmbeddingFailureClient) MapCreateBulk(slice any, setFunc func(*NextEmbeddingFailureCreate, int)) *NextEmbeddingFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NextEmbeddingFailureCreateBulk{err: fmt.Errorf("calling to NextEmbeddingFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NextEmbeddingFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NextEmbeddingFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NextEmbeddingFailure.
+func (c *NextEmbeddingFailureClient) Update() *NextEmbeddingFailureUpdate {
+	mutation := newNextEmbeddingFailureMutation(c.config, OpUpdate)
+	return &NextEmbeddingFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NextEmbeddingFailureClient) UpdateOne(nef *NextEmbeddingFailure) *NextEmbeddingFailureUpdateOne {
+	mutation := newNextEmbeddingFailureMutation(c.config, OpUpdateOne, withNextEmbeddingFailure(nef))
+	return &NextEmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NextEmbeddingFailureClient) UpdateOneID(id uuid.UUID) *NextEmbeddingFailureUpdateOne {
+	mutation := newNextEmbeddingFailureMutation(c.config, OpUpdateOne, withNextEmbeddingFailureID(id))
+	return &NextEmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NextEmbeddingFailure.
+func (c *NextEmbeddingFailureClient) Delete() *NextEmbeddingFailureDelete {
+	mutation := newNextEmbeddingFailureMutation(c.config, OpDelete)
+	return &NextEmbeddingFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NextEmbeddingFailureClient) DeleteOne(nef *NextEmbeddingFailure) *NextEmbeddingFailureDeleteOne {
+	return c.DeleteOneID(nef.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NextEmbeddingFailureClient) DeleteOneID(id uuid.UUID) *NextEmbeddingFailureDeleteOne {
+	builder := c.Delete().Where(nextembeddingfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NextEmbeddingFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for NextEmbeddingFailure.
+func (c *NextEmbeddingFailureClient) Query() *NextEmbeddingFailureQuery {
+	return &NextEmbeddingFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNextEmbeddingFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NextEmbeddingFailure entity by its id.
+func (c *NextEmbeddingFailureClient) Get(ctx context.Context, id uuid.UUID) (*NextEmbeddingFailure, error) {
+	return c.Query().Where(nextembeddingfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NextEmbeddingFailureClient) GetX(ctx context.Context, id uuid.UUID) *NextEmbeddingFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a NextEmbeddingFailure.
+func (c *NextEmbeddingFailureClient) QueryMessage(nef *NextEmbeddingFailure) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nef.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nextembeddingfailure.Table, nextembeddingfailure.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nextembeddingfailure.MessageTable, nextembeddingfailure.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(nef.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NextEmbeddingFailureClient) Hooks() []Hook {
+	return c.hooks.NextEmbeddingFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *NextEmbeddingFailureClient) Interceptors() []Interceptor {
+	return c.inters.NextEmbeddingFailure
+}
+
+func (c *NextEmbeddingFailureClient) mutate(ctx context.Context, m *NextEmbeddingFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NextEmbeddingFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NextEmbeddingFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NextEmbeddingFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NextEmbeddingFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NextEmbeddingFailure mutation op: %q", m.Op())
+	}
+}
+
+// ObservationStateClient is a client for the ObservationState schema.
+type ObservationStateClient struct {
+	config
+}
+
+// NewObservationStateClient returns a client for the ObservationState from the given config.
+func NewObservationStateClient(c config) *ObservationStateClient {
+	return &ObservationStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `observationstate.Hooks(f(g(h())))`.
+func (c *ObservationStateClient) Use(hooks ...Hook) {
+	c.hooks.ObservationState = append(c.hooks.ObservationState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `observationstate.Intercept(f(g(h())))`.
+func (c *ObservationStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ObservationState = append(c.inters.ObservationState, interceptors...)
+}
+
+// Create returns a builder for creating a ObservationState entity.
+func (c *ObservationStateClient) Create() *ObservationStateCreate {
+	mutation := newObservationStateMutation(c.config, OpCreate)
+	return &ObservationStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ObservationState entities.
+func (c *ObservationStateClient) CreateBulk(builders ...*ObservationStateCreate) *ObservationStateCreateBulk {
+	return &ObservationStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ObservationStateClient) MapCreateBulk(slice any, setFunc func(*ObservationStateCreate, int)) *ObservationStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ObservationStateCreateBulk{err: fmt.Errorf("calling to ObservationStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ObservationStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ObservationStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ObservationState.
+func (c *ObservationStateClient) Update() *ObservationStateUpdate {
+	mutation := newObservationStateMutation(c.config, OpUpdate)
+	return &ObservationStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ObservationStateClient) UpdateOne(os *ObservationState) *ObservationStateUpdateOne {
+	mutation := newObservationStateMutation(c.config, OpUpdateOne, withObservationState(os))
+	return &ObservationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ObservationStateClient) UpdateOneID(id int64) *ObservationStateUpdateOne {
+	mutation := newObservationStateMutation(c.config, OpUpdateOne, withObservationStateID(id))
+	return &ObservationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ObservationState.
+func (c *ObservationStateClient) Delete() *ObservationStateDelete {
+	mutation := newObservationStateMutation(c.config, OpDelete)
+	return &ObservationStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ObservationStateClient) DeleteOne(os *ObservationState) *ObservationStateDeleteOne {
+	return c.DeleteOneID(os.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ObservationStateClient) DeleteOneID(id int64) *ObservationStateDeleteOne {
+	builder := c.Delete().Where(observationstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ObservationStateDeleteOne{builder}
+}
+
+// Query returns a query builder for ObservationState.
+func (c *ObservationStateClient) Query() *ObservationStateQuery {
+	return &ObservationStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeObservationState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ObservationState entity by its id.
+func (c *ObservationStateClient) Get(ctx context.Context, id int64) (*ObservationState, error) {
+	return c.Query().Where(observationstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ObservationStateClient) GetX(ctx context.Context, id int64) *ObservationState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ObservationStateClient) Hooks() []Hook {
+	return c.hooks.ObservationState
+}
+
+// Interceptors returns the client interceptors.
+func (c *ObservationStateClient) Interceptors() []Interceptor {
+	return c.inters.ObservationState
+}
+
+func (c *ObservationStateClient) mutate(ctx context.Context, m *ObservationStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ObservationStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ObservationStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ObservationStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ObservationStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ObservationState mutation op: %q", m.Op())
+	}
+}
+
+// SenderClient is a client for the Sender schema.
+type SenderClient struct {
+	config
+}
+
+// NewSenderClient returns a client for the Sender from the given config.
+func NewSenderClient(c config) *SenderClient {
+	return &SenderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sender.Hooks(f(g(h())))`.
+func (c *SenderClient) Use(hooks ...Hook) {
+	c.hooks.Sender = append(c.hooks.Sender, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sender.Intercept(f(g(h())))`.
+func (c *SenderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sender = append(c.inters.Sender, interceptors...)
+}
+
+// Create returns a builder for creating a Sender entity.
+func (c *SenderClient) Create() *SenderCreate {
+	mutation := newSenderMutation(c.config, OpCreate)
+	return &SenderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sender entities.
+func (c *SenderClient) CreateBulk(builders ...*SenderCreate) *SenderCreateBulk {
+	return &SenderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SenderClient) MapCreateBulk(slice any, setFunc func(*SenderCreate, int)) *SenderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SenderCreateBulk{err: fmt.Errorf("calling to SenderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SenderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SenderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sender.
+func (c *SenderClient) Update() *SenderUpdate {
+	mutation := newSenderMutation(c.config, OpUpdate)
+	return &SenderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SenderClient) UpdateOne(s *Sender) *SenderUpdateOne {
+	mutation := newSenderMutation(c.config, OpUpdateOne, withSender(s))
+	return &SenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SenderClient) UpdateOneID(id int64) *SenderUpdateOne {
+	mutation := newSenderMutation(c.config, OpUpdateOne, withSenderID(id))
+	return &SenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sender.
+func (c *SenderClient) Delete() *SenderDelete {
+	mutation := newSenderMutation(c.config, OpDelete)
+	return &SenderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SenderClient) DeleteOne(s *Sender) *SenderDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SenderClient) DeleteOneID(id int64) *SenderDeleteOne {
+	builder := c.Delete().Where(sender.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SenderDeleteOne{builder}
+}
+
+// Query returns a query builder for Sender.
+func (c *SenderClient) Query() *SenderQuery {
+	return &SenderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSender},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sender entity by its id.
+func (c *SenderClient) Get(ctx context.Context, id int64) (*Sender, error) {
+	return c.Query().Where(sender.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SenderClient) GetX(ctx context.Context, id int64) *Sender {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessages queries the messages edge of a Sender.
+func (c *SenderClient) QueryMessages(s *Sender) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sender.Table, sender.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sender.MessagesTable, sender.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SenderClient) Hooks() []Hook {
+	return c.hooks.Sender
+}
+
+// Interceptors returns the client interceptors.
+func (c *SenderClient) Interceptors() []Interceptor {
+	return c.inters.Sender
+}
+
+func (c *SenderClient) mutate(ctx context.Context, m *SenderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SenderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SenderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SenderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Sender mutation op: %q", m.Op())
+	}
+}
+
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
+	config
+}
+
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
+}
+
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingClient) UpdateOne(s *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(s))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingClient) UpdateOneID(id string) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingClient) DeleteOne(s *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingClient) DeleteOneID(id string) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingDeleteOne{builder}
+}
+
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id string) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingClient) GetX(ctx context.Context, id string) *Setting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
+}
+
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Dialog, EmbeddingCache, EmbeddingSpend, Message, MessageChunk, MessageRevision,
+		NextEmbedding, NextEmbeddingFailure, ObservationState, Sender,
+		Setting []ent.Hook
+	}
+	inters struct {
+		Dialog, EmbeddingCache, EmbeddingSpend, Message, MessageChunk, MessageRevision,
+		NextEmbedding, NextEmbeddingFailure, ObservationState, Sender,
+		Setting []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
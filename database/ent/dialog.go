@@ -24,6 +24,14 @@ type Dialog struct {
 	Type types.DialogType `json:"type,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// BackfillOffsetID holds the value of the "backfill_offset_id" field.
+	BackfillOffsetID *int `json:"backfill_offset_id,omitempty"`
+	// BackfillCompletedAt holds the value of the "backfill_completed_at" field.
+	BackfillCompletedAt *time.Time `json:"backfill_completed_at,omitempty"`
+	// LastMsgID holds the value of the "last_msg_id" field.
+	LastMsgID *int `json:"last_msg_id,omitempty"`
+	// MigratedToID holds the value of the "migrated_to_id" field.
+	MigratedToID *int64 `json:"migrated_to_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DialogQuery when eager-loading is set.
 	Edges        DialogEdges `json:"edges"`
@@ -53,11 +61,11 @@ func (*Dialog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dialog.FieldID:
+		case dialog.FieldID, dialog.FieldBackfillOffsetID, dialog.FieldLastMsgID, dialog.FieldMigratedToID:
 			values[i] = new(sql.NullInt64)
 		case dialog.FieldTitle, dialog.FieldType:
 			values[i] = new(sql.NullString)
-		case dialog.FieldUpdatedAt:
+		case dialog.FieldUpdatedAt, dialog.FieldBackfillCompletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -98,6 +106,34 @@ func (d *Dialog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.UpdatedAt = value.Time
 			}
+		case dialog.FieldBackfillOffsetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field backfill_offset_id", values[i])
+			} else if value.Valid {
+				d.BackfillOffsetID = new(int)
+				*d.BackfillOffsetID = int(value.Int64)
+			}
+		case dialog.FieldBackfillCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field backfill_completed_at", values[i])
+			} else if value.Valid {
+				d.BackfillCompletedAt = new(time.Time)
+				*d.BackfillCompletedAt = value.Time
+			}
+		case dialog.FieldLastMsgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_msg_id", values[i])
+			} else if value.Valid {
+				d.LastMsgID = new(int)
+				*d.LastMsgID = int(value.Int64)
+			}
+		case dialog.FieldMigratedToID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field migrated_to_id", values[i])
+			} else if value.Valid {
+				d.MigratedToID = new(int64)
+				*d.MigratedToID = value.Int64
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -147,6 +183,26 @@ func (d *Dialog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := d.BackfillOffsetID; v != nil {
+		builder.WriteString("backfill_offset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.BackfillCompletedAt; v != nil {
+		builder.WriteString("backfill_completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.LastMsgID; v != nil {
+		builder.WriteString("last_msg_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.MigratedToID; v != nil {
+		builder.WriteString("migrated_to_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,14 @@ const (
 	FieldType = "type"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldBackfillOffsetID holds the string denoting the backfill_offset_id field in the database.
+	FieldBackfillOffsetID = "backfill_offset_id"
+	// FieldBackfillCompletedAt holds the string denoting the backfill_completed_at field in the database.
+	FieldBackfillCompletedAt = "backfill_completed_at"
+	// FieldLastMsgID holds the string denoting the last_msg_id field in the database.
+	FieldLastMsgID = "last_msg_id"
+	// FieldMigratedToID holds the string denoting the migrated_to_id field in the database.
+	FieldMigratedToID = "migrated_to_id"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
 	// Table holds the table name of the dialog in the database.
@@ -41,6 +49,10 @@ var Columns = []string{
 	FieldTitle,
 	FieldType,
 	FieldUpdatedAt,
+	FieldBackfillOffsetID,
+	FieldBackfillCompletedAt,
+	FieldLastMsgID,
+	FieldMigratedToID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -91,6 +103,26 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByBackfillOffsetID orders the results by the backfill_offset_id field.
+func ByBackfillOffsetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackfillOffsetID, opts...).ToFunc()
+}
+
+// ByBackfillCompletedAt orders the results by the backfill_completed_at field.
+func ByBackfillCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackfillCompletedAt, opts...).ToFunc()
+}
+
+// ByLastMsgID orders the results by the last_msg_id field.
+func ByLastMsgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMsgID, opts...).ToFunc()
+}
+
+// ByMigratedToID orders the results by the migrated_to_id field.
+func ByMigratedToID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMigratedToID, opts...).ToFunc()
+}
+
 // ByMessagesCount orders the results by messages count.
 func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
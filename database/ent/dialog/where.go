@@ -66,6 +66,26 @@ func UpdatedAt(v time.Time) predicate.Dialog {
 	return predicate.Dialog(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// BackfillOffsetID applies equality check predicate on the "backfill_offset_id" field. It's identical to BackfillOffsetIDEQ.
+func BackfillOffsetID(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldBackfillOffsetID, v))
+}
+
+// BackfillCompletedAt applies equality check predicate on the "backfill_completed_at" field. It's identical to BackfillCompletedAtEQ.
+func BackfillCompletedAt(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldBackfillCompletedAt, v))
+}
+
+// LastMsgID applies equality check predicate on the "last_msg_id" field. It's identical to LastMsgIDEQ.
+func LastMsgID(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldLastMsgID, v))
+}
+
+// MigratedToID applies equality check predicate on the "migrated_to_id" field. It's identical to MigratedToIDEQ.
+func MigratedToID(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldMigratedToID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Dialog {
 	return predicate.Dialog(sql.FieldEQ(FieldTitle, v))
@@ -201,6 +221,206 @@ func UpdatedAtLTE(v time.Time) predicate.Dialog {
 	return predicate.Dialog(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// BackfillOffsetIDEQ applies the EQ predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDEQ(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDNEQ applies the NEQ predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDNEQ(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNEQ(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDIn applies the In predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDIn(vs ...int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldIn(FieldBackfillOffsetID, vs...))
+}
+
+// BackfillOffsetIDNotIn applies the NotIn predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDNotIn(vs ...int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotIn(FieldBackfillOffsetID, vs...))
+}
+
+// BackfillOffsetIDGT applies the GT predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDGT(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGT(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDGTE applies the GTE predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDGTE(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGTE(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDLT applies the LT predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDLT(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLT(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDLTE applies the LTE predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDLTE(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLTE(FieldBackfillOffsetID, v))
+}
+
+// BackfillOffsetIDIsNil applies the IsNil predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDIsNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldIsNull(FieldBackfillOffsetID))
+}
+
+// BackfillOffsetIDNotNil applies the NotNil predicate on the "backfill_offset_id" field.
+func BackfillOffsetIDNotNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotNull(FieldBackfillOffsetID))
+}
+
+// BackfillCompletedAtEQ applies the EQ predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtEQ(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtNEQ applies the NEQ predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtNEQ(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNEQ(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtIn applies the In predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtIn(vs ...time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldIn(FieldBackfillCompletedAt, vs...))
+}
+
+// BackfillCompletedAtNotIn applies the NotIn predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtNotIn(vs ...time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotIn(FieldBackfillCompletedAt, vs...))
+}
+
+// BackfillCompletedAtGT applies the GT predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtGT(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGT(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtGTE applies the GTE predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtGTE(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGTE(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtLT applies the LT predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtLT(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLT(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtLTE applies the LTE predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtLTE(v time.Time) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLTE(FieldBackfillCompletedAt, v))
+}
+
+// BackfillCompletedAtIsNil applies the IsNil predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtIsNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldIsNull(FieldBackfillCompletedAt))
+}
+
+// BackfillCompletedAtNotNil applies the NotNil predicate on the "backfill_completed_at" field.
+func BackfillCompletedAtNotNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotNull(FieldBackfillCompletedAt))
+}
+
+// LastMsgIDEQ applies the EQ predicate on the "last_msg_id" field.
+func LastMsgIDEQ(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldLastMsgID, v))
+}
+
+// LastMsgIDNEQ applies the NEQ predicate on the "last_msg_id" field.
+func LastMsgIDNEQ(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNEQ(FieldLastMsgID, v))
+}
+
+// LastMsgIDIn applies the In predicate on the "last_msg_id" field.
+func LastMsgIDIn(vs ...int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldIn(FieldLastMsgID, vs...))
+}
+
+// LastMsgIDNotIn applies the NotIn predicate on the "last_msg_id" field.
+func LastMsgIDNotIn(vs ...int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotIn(FieldLastMsgID, vs...))
+}
+
+// LastMsgIDGT applies the GT predicate on the "last_msg_id" field.
+func LastMsgIDGT(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGT(FieldLastMsgID, v))
+}
+
+// LastMsgIDGTE applies the GTE predicate on the "last_msg_id" field.
+func LastMsgIDGTE(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGTE(FieldLastMsgID, v))
+}
+
+// LastMsgIDLT applies the LT predicate on the "last_msg_id" field.
+func LastMsgIDLT(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLT(FieldLastMsgID, v))
+}
+
+// LastMsgIDLTE applies the LTE predicate on the "last_msg_id" field.
+func LastMsgIDLTE(v int) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLTE(FieldLastMsgID, v))
+}
+
+// LastMsgIDIsNil applies the IsNil predicate on the "last_msg_id" field.
+func LastMsgIDIsNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldIsNull(FieldLastMsgID))
+}
+
+// LastMsgIDNotNil applies the NotNil predicate on the "last_msg_id" field.
+func LastMsgIDNotNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotNull(FieldLastMsgID))
+}
+
+// MigratedToIDEQ applies the EQ predicate on the "migrated_to_id" field.
+func MigratedToIDEQ(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldEQ(FieldMigratedToID, v))
+}
+
+// MigratedToIDNEQ applies the NEQ predicate on the "migrated_to_id" field.
+func MigratedToIDNEQ(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNEQ(FieldMigratedToID, v))
+}
+
+// MigratedToIDIn applies the In predicate on the "migrated_to_id" field.
+func MigratedToIDIn(vs ...int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldIn(FieldMigratedToID, vs...))
+}
+
+// MigratedToIDNotIn applies the NotIn predicate on the "migrated_to_id" field.
+func MigratedToIDNotIn(vs ...int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotIn(FieldMigratedToID, vs...))
+}
+
+// MigratedToIDGT applies the GT predicate on the "migrated_to_id" field.
+func MigratedToIDGT(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGT(FieldMigratedToID, v))
+}
+
+// MigratedToIDGTE applies the GTE predicate on the "migrated_to_id" field.
+func MigratedToIDGTE(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldGTE(FieldMigratedToID, v))
+}
+
+// MigratedToIDLT applies the LT predicate on the "migrated_to_id" field.
+func MigratedToIDLT(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLT(FieldMigratedToID, v))
+}
+
+// MigratedToIDLTE applies the LTE predicate on the "migrated_to_id" field.
+func MigratedToIDLTE(v int64) predicate.Dialog {
+	return predicate.Dialog(sql.FieldLTE(FieldMigratedToID, v))
+}
+
+// MigratedToIDIsNil applies the IsNil predicate on the "migrated_to_id" field.
+func MigratedToIDIsNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldIsNull(FieldMigratedToID))
+}
+
+// MigratedToIDNotNil applies the NotNil predicate on the "migrated_to_id" field.
+func MigratedToIDNotNil() predicate.Dialog {
+	return predicate.Dialog(sql.FieldNotNull(FieldMigratedToID))
+}
+
 // HasMessages applies the HasEdge predicate on the "messages" edge.
 func HasMessages() predicate.Dialog {
 	return predicate.Dialog(func(s *sql.Selector) {
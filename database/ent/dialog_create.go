@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +22,7 @@ type DialogCreate struct {
 	config
 	mutation *DialogMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTitle sets the "title" field.
@@ -49,6 +51,62 @@ func (dc *DialogCreate) SetNillableUpdatedAt(t *time.Time) *DialogCreate {
 	return dc
 }
 
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (dc *DialogCreate) SetBackfillOffsetID(i int) *DialogCreate {
+	dc.mutation.SetBackfillOffsetID(i)
+	return dc
+}
+
+// SetNillableBackfillOffsetID sets the "backfill_offset_id" field if the given value is not nil.
+func (dc *DialogCreate) SetNillableBackfillOffsetID(i *int) *DialogCreate {
+	if i != nil {
+		dc.SetBackfillOffsetID(*i)
+	}
+	return dc
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (dc *DialogCreate) SetBackfillCompletedAt(t time.Time) *DialogCreate {
+	dc.mutation.SetBackfillCompletedAt(t)
+	return dc
+}
+
+// SetNillableBackfillCompletedAt sets the "backfill_completed_at" field if the given value is not nil.
+func (dc *DialogCreate) SetNillableBackfillCompletedAt(t *time.Time) *DialogCreate {
+	if t != nil {
+		dc.SetBackfillCompletedAt(*t)
+	}
+	return dc
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (dc *DialogCreate) SetLastMsgID(i int) *DialogCreate {
+	dc.mutation.SetLastMsgID(i)
+	return dc
+}
+
+// SetNillableLastMsgID sets the "last_msg_id" field if the given value is not nil.
+func (dc *DialogCreate) SetNillableLastMsgID(i *int) *DialogCreate {
+	if i != nil {
+		dc.SetLastMsgID(*i)
+	}
+	return dc
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (dc *DialogCreate) SetMigratedToID(i int64) *DialogCreate {
+	dc.mutation.SetMigratedToID(i)
+	return dc
+}
+
+// SetNillableMigratedToID sets the "migrated_to_id" field if the given value is not nil.
+func (dc *DialogCreate) SetNillableMigratedToID(i *int64) *DialogCreate {
+	if i != nil {
+		dc.SetMigratedToID(*i)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DialogCreate) SetID(i int64) *DialogCreate {
 	dc.mutation.SetID(i)
@@ -155,6 +213,7 @@ func (dc *DialogCreate) createSpec() (*Dialog, *sqlgraph.CreateSpec) {
 		_node = &Dialog{config: dc.config}
 		_spec = sqlgraph.NewCreateSpec(dialog.Table, sqlgraph.NewFieldSpec(dialog.FieldID, field.TypeInt64))
 	)
+	_spec.OnConflict = dc.conflict
 	if id, ok := dc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -171,6 +230,22 @@ func (dc *DialogCreate) createSpec() (*Dialog, *sqlgraph.CreateSpec) {
 		_spec.SetField(dialog.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := dc.mutation.BackfillOffsetID(); ok {
+		_spec.SetField(dialog.FieldBackfillOffsetID, field.TypeInt, value)
+		_node.BackfillOffsetID = &value
+	}
+	if value, ok := dc.mutation.BackfillCompletedAt(); ok {
+		_spec.SetField(dialog.FieldBackfillCompletedAt, field.TypeTime, value)
+		_node.BackfillCompletedAt = &value
+	}
+	if value, ok := dc.mutation.LastMsgID(); ok {
+		_spec.SetField(dialog.FieldLastMsgID, field.TypeInt, value)
+		_node.LastMsgID = &value
+	}
+	if value, ok := dc.mutation.MigratedToID(); ok {
+		_spec.SetField(dialog.FieldMigratedToID, field.TypeInt64, value)
+		_node.MigratedToID = &value
+	}
 	if nodes := dc.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -190,11 +265,415 @@ func (dc *DialogCreate) createSpec() (*Dialog, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dialog.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DialogUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DialogCreate) OnConflict(opts ...sql.ConflictOption) *DialogUpsertOne {
+	dc.conflict = opts
+	return &DialogUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DialogCreate) OnConflictColumns(columns ...string) *DialogUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DialogUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DialogUpsertOne is the builder for "upsert"-ing
+	//  one Dialog node.
+	DialogUpsertOne struct {
+		create *DialogCreate
+	}
+
+	// DialogUpsert is the "OnConflict" setter.
+	DialogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *DialogUpsert) SetTitle(v string) *DialogUpsert {
+	u.Set(dialog.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateTitle() *DialogUpsert {
+	u.SetExcluded(dialog.FieldTitle)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *DialogUpsert) SetType(v types.DialogType) *DialogUpsert {
+	u.Set(dialog.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateType() *DialogUpsert {
+	u.SetExcluded(dialog.FieldType)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DialogUpsert) SetUpdatedAt(v time.Time) *DialogUpsert {
+	u.Set(dialog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateUpdatedAt() *DialogUpsert {
+	u.SetExcluded(dialog.FieldUpdatedAt)
+	return u
+}
+
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (u *DialogUpsert) SetBackfillOffsetID(v int) *DialogUpsert {
+	u.Set(dialog.FieldBackfillOffsetID, v)
+	return u
+}
+
+// UpdateBackfillOffsetID sets the "backfill_offset_id" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateBackfillOffsetID() *DialogUpsert {
+	u.SetExcluded(dialog.FieldBackfillOffsetID)
+	return u
+}
+
+// AddBackfillOffsetID adds v to the "backfill_offset_id" field.
+func (u *DialogUpsert) AddBackfillOffsetID(v int) *DialogUpsert {
+	u.Add(dialog.FieldBackfillOffsetID, v)
+	return u
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (u *DialogUpsert) ClearBackfillOffsetID() *DialogUpsert {
+	u.SetNull(dialog.FieldBackfillOffsetID)
+	return u
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (u *DialogUpsert) SetBackfillCompletedAt(v time.Time) *DialogUpsert {
+	u.Set(dialog.FieldBackfillCompletedAt, v)
+	return u
+}
+
+// UpdateBackfillCompletedAt sets the "backfill_completed_at" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateBackfillCompletedAt() *DialogUpsert {
+	u.SetExcluded(dialog.FieldBackfillCompletedAt)
+	return u
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (u *DialogUpsert) ClearBackfillCompletedAt() *DialogUpsert {
+	u.SetNull(dialog.FieldBackfillCompletedAt)
+	return u
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (u *DialogUpsert) SetLastMsgID(v int) *DialogUpsert {
+	u.Set(dialog.FieldLastMsgID, v)
+	return u
+}
+
+// UpdateLastMsgID sets the "last_msg_id" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateLastMsgID() *DialogUpsert {
+	u.SetExcluded(dialog.FieldLastMsgID)
+	return u
+}
+
+// AddLastMsgID adds v to the "last_msg_id" field.
+func (u *DialogUpsert) AddLastMsgID(v int) *DialogUpsert {
+	u.Add(dialog.FieldLastMsgID, v)
+	return u
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (u *DialogUpsert) ClearLastMsgID() *DialogUpsert {
+	u.SetNull(dialog.FieldLastMsgID)
+	return u
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (u *DialogUpsert) SetMigratedToID(v int64) *DialogUpsert {
+	u.Set(dialog.FieldMigratedToID, v)
+	return u
+}
+
+// UpdateMigratedToID sets the "migrated_to_id" field to the value that was provided on create.
+func (u *DialogUpsert) UpdateMigratedToID() *DialogUpsert {
+	u.SetExcluded(dialog.FieldMigratedToID)
+	return u
+}
+
+// AddMigratedToID adds v to the "migrated_to_id" field.
+func (u *DialogUpsert) AddMigratedToID(v int64) *DialogUpsert {
+	u.Add(dialog.FieldMigratedToID, v)
+	return u
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (u *DialogUpsert) ClearMigratedToID() *DialogUpsert {
+	u.SetNull(dialog.FieldMigratedToID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dialog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DialogUpsertOne) UpdateNewValues() *DialogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dialog.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DialogUpsertOne) Ignore() *DialogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DialogUpsertOne) DoNothing() *DialogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DialogCreate.OnConflict
+// documentation for more info.
+func (u *DialogUpsertOne) Update(set func(*DialogUpsert)) *DialogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DialogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *DialogUpsertOne) SetTitle(v string) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateTitle() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DialogUpsertOne) SetType(v types.DialogType) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateType() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DialogUpsertOne) SetUpdatedAt(v time.Time) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateUpdatedAt() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (u *DialogUpsertOne) SetBackfillOffsetID(v int) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetBackfillOffsetID(v)
+	})
+}
+
+// AddBackfillOffsetID adds v to the "backfill_offset_id" field.
+func (u *DialogUpsertOne) AddBackfillOffsetID(v int) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddBackfillOffsetID(v)
+	})
+}
+
+// UpdateBackfillOffsetID sets the "backfill_offset_id" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateBackfillOffsetID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateBackfillOffsetID()
+	})
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (u *DialogUpsertOne) ClearBackfillOffsetID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearBackfillOffsetID()
+	})
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (u *DialogUpsertOne) SetBackfillCompletedAt(v time.Time) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetBackfillCompletedAt(v)
+	})
+}
+
+// UpdateBackfillCompletedAt sets the "backfill_completed_at" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateBackfillCompletedAt() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateBackfillCompletedAt()
+	})
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (u *DialogUpsertOne) ClearBackfillCompletedAt() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearBackfillCompletedAt()
+	})
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (u *DialogUpsertOne) SetLastMsgID(v int) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetLastMsgID(v)
+	})
+}
+
+// AddLastMsgID adds v to the "last_msg_id" field.
+func (u *DialogUpsertOne) AddLastMsgID(v int) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddLastMsgID(v)
+	})
+}
+
+// UpdateLastMsgID sets the "last_msg_id" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateLastMsgID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateLastMsgID()
+	})
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (u *DialogUpsertOne) ClearLastMsgID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearLastMsgID()
+	})
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (u *DialogUpsertOne) SetMigratedToID(v int64) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetMigratedToID(v)
+	})
+}
+
+// AddMigratedToID adds v to the "migrated_to_id" field.
+func (u *DialogUpsertOne) AddMigratedToID(v int64) *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddMigratedToID(v)
+	})
+}
+
+// UpdateMigratedToID sets the "migrated_to_id" field to the value that was provided on create.
+func (u *DialogUpsertOne) UpdateMigratedToID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateMigratedToID()
+	})
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (u *DialogUpsertOne) ClearMigratedToID() *DialogUpsertOne {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearMigratedToID()
+	})
+}
+
+// Exec executes the query.
+func (u *DialogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DialogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DialogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DialogUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DialogUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // DialogCreateBulk is the builder for creating many Dialog entities in bulk.
 type DialogCreateBulk struct {
 	config
 	err      error
 	builders []*DialogCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Dialog entities in the database.
@@ -224,6 +703,7 @@ func (dcb *DialogCreateBulk) Save(ctx context.Context) ([]*Dialog, error) {
 					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -277,3 +757,264 @@ func (dcb *DialogCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dialog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DialogUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DialogCreateBulk) OnConflict(opts ...sql.ConflictOption) *DialogUpsertBulk {
+	dcb.conflict = opts
+	return &DialogUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DialogCreateBulk) OnConflictColumns(columns ...string) *DialogUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DialogUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DialogUpsertBulk is the builder for "upsert"-ing
+// a bulk of Dialog nodes.
+type DialogUpsertBulk struct {
+	create *DialogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dialog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DialogUpsertBulk) UpdateNewValues() *DialogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dialog.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dialog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DialogUpsertBulk) Ignore() *DialogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DialogUpsertBulk) DoNothing() *DialogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DialogCreateBulk.OnConflict
+// documentation for more info.
+func (u *DialogUpsertBulk) Update(set func(*DialogUpsert)) *DialogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DialogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *DialogUpsertBulk) SetTitle(v string) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateTitle() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DialogUpsertBulk) SetType(v types.DialogType) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateType() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DialogUpsertBulk) SetUpdatedAt(v time.Time) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateUpdatedAt() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (u *DialogUpsertBulk) SetBackfillOffsetID(v int) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetBackfillOffsetID(v)
+	})
+}
+
+// AddBackfillOffsetID adds v to the "backfill_offset_id" field.
+func (u *DialogUpsertBulk) AddBackfillOffsetID(v int) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddBackfillOffsetID(v)
+	})
+}
+
+// UpdateBackfillOffsetID sets the "backfill_offset_id" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateBackfillOffsetID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateBackfillOffsetID()
+	})
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (u *DialogUpsertBulk) ClearBackfillOffsetID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearBackfillOffsetID()
+	})
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (u *DialogUpsertBulk) SetBackfillCompletedAt(v time.Time) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetBackfillCompletedAt(v)
+	})
+}
+
+// UpdateBackfillCompletedAt sets the "backfill_completed_at" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateBackfillCompletedAt() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateBackfillCompletedAt()
+	})
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (u *DialogUpsertBulk) ClearBackfillCompletedAt() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearBackfillCompletedAt()
+	})
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (u *DialogUpsertBulk) SetLastMsgID(v int) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetLastMsgID(v)
+	})
+}
+
+// AddLastMsgID adds v to the "last_msg_id" field.
+func (u *DialogUpsertBulk) AddLastMsgID(v int) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddLastMsgID(v)
+	})
+}
+
+// UpdateLastMsgID sets the "last_msg_id" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateLastMsgID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateLastMsgID()
+	})
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (u *DialogUpsertBulk) ClearLastMsgID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearLastMsgID()
+	})
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (u *DialogUpsertBulk) SetMigratedToID(v int64) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.SetMigratedToID(v)
+	})
+}
+
+// AddMigratedToID adds v to the "migrated_to_id" field.
+func (u *DialogUpsertBulk) AddMigratedToID(v int64) *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.AddMigratedToID(v)
+	})
+}
+
+// UpdateMigratedToID sets the "migrated_to_id" field to the value that was provided on create.
+func (u *DialogUpsertBulk) UpdateMigratedToID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.UpdateMigratedToID()
+	})
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (u *DialogUpsertBulk) ClearMigratedToID() *DialogUpsertBulk {
+	return u.Update(func(s *DialogUpsert) {
+		s.ClearMigratedToID()
+	})
+}
+
+// Exec executes the query.
+func (u *DialogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DialogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DialogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DialogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -74,6 +74,107 @@ func (du *DialogUpdate) SetNillableUpdatedAt(t *time.Time) *DialogUpdate {
 	return du
 }
 
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (du *DialogUpdate) SetBackfillOffsetID(i int) *DialogUpdate {
+	du.mutation.ResetBackfillOffsetID()
+	du.mutation.SetBackfillOffsetID(i)
+	return du
+}
+
+// SetNillableBackfillOffsetID sets the "backfill_offset_id" field if the given value is not nil.
+func (du *DialogUpdate) SetNillableBackfillOffsetID(i *int) *DialogUpdate {
+	if i != nil {
+		du.SetBackfillOffsetID(*i)
+	}
+	return du
+}
+
+// AddBackfillOffsetID adds i to the "backfill_offset_id" field.
+func (du *DialogUpdate) AddBackfillOffsetID(i int) *DialogUpdate {
+	du.mutation.AddBackfillOffsetID(i)
+	return du
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (du *DialogUpdate) ClearBackfillOffsetID() *DialogUpdate {
+	du.mutation.ClearBackfillOffsetID()
+	return du
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (du *DialogUpdate) SetBackfillCompletedAt(t time.Time) *DialogUpdate {
+	du.mutation.SetBackfillCompletedAt(t)
+	return du
+}
+
+// SetNillableBackfillCompletedAt sets the "backfill_completed_at" field if the given value is not nil.
+func (du *DialogUpdate) SetNillableBackfillCompletedAt(t *time.Time) *DialogUpdate {
+	if t != nil {
+		du.SetBackfillCompletedAt(*t)
+	}
+	return du
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (du *DialogUpdate) ClearBackfillCompletedAt() *DialogUpdate {
+	du.mutation.ClearBackfillCompletedAt()
+	return du
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (du *DialogUpdate) SetLastMsgID(i int) *DialogUpdate {
+	du.mutation.ResetLastMsgID()
+	du.mutation.SetLastMsgID(i)
+	return du
+}
+
+// SetNillableLastMsgID sets the "last_msg_id" field if the given value is not nil.
+func (du *DialogUpdate) SetNillableLastMsgID(i *int) *DialogUpdate {
+	if i != nil {
+		du.SetLastMsgID(*i)
+	}
+	return du
+}
+
+// AddLastMsgID adds i to the "last_msg_id" field.
+func (du *DialogUpdate) AddLastMsgID(i int) *DialogUpdate {
+	du.mutation.AddLastMsgID(i)
+	return du
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (du *DialogUpdate) ClearLastMsgID() *DialogUpdate {
+	du.mutation.ClearLastMsgID()
+	return du
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (du *DialogUpdate) SetMigratedToID(i int64) *DialogUpdate {
+	du.mutation.ResetMigratedToID()
+	du.mutation.SetMigratedToID(i)
+	return du
+}
+
+// SetNillableMigratedToID sets the "migrated_to_id" field if the given value is not nil.
+func (du *DialogUpdate) SetNillableMigratedToID(i *int64) *DialogUpdate {
+	if i != nil {
+		du.SetMigratedToID(*i)
+	}
+	return du
+}
+
+// AddMigratedToID adds i to the "migrated_to_id" field.
+func (du *DialogUpdate) AddMigratedToID(i int64) *DialogUpdate {
+	du.mutation.AddMigratedToID(i)
+	return du
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (du *DialogUpdate) ClearMigratedToID() *DialogUpdate {
+	du.mutation.ClearMigratedToID()
+	return du
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (du *DialogUpdate) AddMessageIDs(ids ...uuid.UUID) *DialogUpdate {
 	du.mutation.AddMessageIDs(ids...)
@@ -179,6 +280,39 @@ func (du *DialogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.UpdatedAt(); ok {
 		_spec.SetField(dialog.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := du.mutation.BackfillOffsetID(); ok {
+		_spec.SetField(dialog.FieldBackfillOffsetID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedBackfillOffsetID(); ok {
+		_spec.AddField(dialog.FieldBackfillOffsetID, field.TypeInt, value)
+	}
+	if du.mutation.BackfillOffsetIDCleared() {
+		_spec.ClearField(dialog.FieldBackfillOffsetID, field.TypeInt)
+	}
+	if value, ok := du.mutation.BackfillCompletedAt(); ok {
+		_spec.SetField(dialog.FieldBackfillCompletedAt, field.TypeTime, value)
+	}
+	if du.mutation.BackfillCompletedAtCleared() {
+		_spec.ClearField(dialog.FieldBackfillCompletedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.LastMsgID(); ok {
+		_spec.SetField(dialog.FieldLastMsgID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedLastMsgID(); ok {
+		_spec.AddField(dialog.FieldLastMsgID, field.TypeInt, value)
+	}
+	if du.mutation.LastMsgIDCleared() {
+		_spec.ClearField(dialog.FieldLastMsgID, field.TypeInt)
+	}
+	if value, ok := du.mutation.MigratedToID(); ok {
+		_spec.SetField(dialog.FieldMigratedToID, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedMigratedToID(); ok {
+		_spec.AddField(dialog.FieldMigratedToID, field.TypeInt64, value)
+	}
+	if du.mutation.MigratedToIDCleared() {
+		_spec.ClearField(dialog.FieldMigratedToID, field.TypeInt64)
+	}
 	if du.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -288,6 +422,107 @@ func (duo *DialogUpdateOne) SetNillableUpdatedAt(t *time.Time) *DialogUpdateOne
 	return duo
 }
 
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (duo *DialogUpdateOne) SetBackfillOffsetID(i int) *DialogUpdateOne {
+	duo.mutation.ResetBackfillOffsetID()
+	duo.mutation.SetBackfillOffsetID(i)
+	return duo
+}
+
+// SetNillableBackfillOffsetID sets the "backfill_offset_id" field if the given value is not nil.
+func (duo *DialogUpdateOne) SetNillableBackfillOffsetID(i *int) *DialogUpdateOne {
+	if i != nil {
+		duo.SetBackfillOffsetID(*i)
+	}
+	return duo
+}
+
+// AddBackfillOffsetID adds i to the "backfill_offset_id" field.
+func (duo *DialogUpdateOne) AddBackfillOffsetID(i int) *DialogUpdateOne {
+	duo.mutation.AddBackfillOffsetID(i)
+	return duo
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (duo *DialogUpdateOne) ClearBackfillOffsetID() *DialogUpdateOne {
+	duo.mutation.ClearBackfillOffsetID()
+	return duo
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (duo *DialogUpdateOne) SetBackfillCompletedAt(t time.Time) *DialogUpdateOne {
+	duo.mutation.SetBackfillCompletedAt(t)
+	return duo
+}
+
+// SetNillableBackfillCompletedAt sets the "backfill_completed_at" field if the given value is not nil.
+func (duo *DialogUpdateOne) SetNillableBackfillCompletedAt(t *time.Time) *DialogUpdateOne {
+	if t != nil {
+		duo.SetBackfillCompletedAt(*t)
+	}
+	return duo
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (duo *DialogUpdateOne) ClearBackfillCompletedAt() *DialogUpdateOne {
+	duo.mutation.ClearBackfillCompletedAt()
+	return duo
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (duo *DialogUpdateOne) SetLastMsgID(i int) *DialogUpdateOne {
+	duo.mutation.ResetLastMsgID()
+	duo.mutation.SetLastMsgID(i)
+	return duo
+}
+
+// SetNillableLastMsgID sets the "last_msg_id" field if the given value is not nil.
+func (duo *DialogUpdateOne) SetNillableLastMsgID(i *int) *DialogUpdateOne {
+	if i != nil {
+		duo.SetLastMsgID(*i)
+	}
+	return duo
+}
+
+// AddLastMsgID adds i to the "last_msg_id" field.
+func (duo *DialogUpdateOne) AddLastMsgID(i int) *DialogUpdateOne {
+	duo.mutation.AddLastMsgID(i)
+	return duo
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (duo *DialogUpdateOne) ClearLastMsgID() *DialogUpdateOne {
+	duo.mutation.ClearLastMsgID()
+	return duo
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (duo *DialogUpdateOne) SetMigratedToID(i int64) *DialogUpdateOne {
+	duo.mutation.ResetMigratedToID()
+	duo.mutation.SetMigratedToID(i)
+	return duo
+}
+
+// SetNillableMigratedToID sets the "migrated_to_id" field if the given value is not nil.
+func (duo *DialogUpdateOne) SetNillableMigratedToID(i *int64) *DialogUpdateOne {
+	if i != nil {
+		duo.SetMigratedToID(*i)
+	}
+	return duo
+}
+
+// AddMigratedToID adds i to the "migrated_to_id" field.
+func (duo *DialogUpdateOne) AddMigratedToID(i int64) *DialogUpdateOne {
+	duo.mutation.AddMigratedToID(i)
+	return duo
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (duo *DialogUpdateOne) ClearMigratedToID() *DialogUpdateOne {
+	duo.mutation.ClearMigratedToID()
+	return duo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (duo *DialogUpdateOne) AddMessageIDs(ids ...uuid.UUID) *DialogUpdateOne {
 	duo.mutation.AddMessageIDs(ids...)
@@ -423,6 +658,39 @@ func (duo *DialogUpdateOne) sqlSave(ctx context.Context) (_node *Dialog, err err
 	if value, ok := duo.mutation.UpdatedAt(); ok {
 		_spec.SetField(dialog.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := duo.mutation.BackfillOffsetID(); ok {
+		_spec.SetField(dialog.FieldBackfillOffsetID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedBackfillOffsetID(); ok {
+		_spec.AddField(dialog.FieldBackfillOffsetID, field.TypeInt, value)
+	}
+	if duo.mutation.BackfillOffsetIDCleared() {
+		_spec.ClearField(dialog.FieldBackfillOffsetID, field.TypeInt)
+	}
+	if value, ok := duo.mutation.BackfillCompletedAt(); ok {
+		_spec.SetField(dialog.FieldBackfillCompletedAt, field.TypeTime, value)
+	}
+	if duo.mutation.BackfillCompletedAtCleared() {
+		_spec.ClearField(dialog.FieldBackfillCompletedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.LastMsgID(); ok {
+		_spec.SetField(dialog.FieldLastMsgID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedLastMsgID(); ok {
+		_spec.AddField(dialog.FieldLastMsgID, field.TypeInt, value)
+	}
+	if duo.mutation.LastMsgIDCleared() {
+		_spec.ClearField(dialog.FieldLastMsgID, field.TypeInt)
+	}
+	if value, ok := duo.mutation.MigratedToID(); ok {
+		_spec.SetField(dialog.FieldMigratedToID, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedMigratedToID(); ok {
+		_spec.AddField(dialog.FieldMigratedToID, field.TypeInt64, value)
+	}
+	if duo.mutation.MigratedToIDCleared() {
+		_spec.ClearField(dialog.FieldMigratedToID, field.TypeInt64)
+	}
 	if duo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+)
+
+// EmbeddingCache is the model entity for the EmbeddingCache schema.
+type EmbeddingCache struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash []byte `json:"hash,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// Hits holds the value of the "hits" field.
+	Hits int `json:"hits,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmbeddingCache) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case embeddingcache.FieldHash:
+			values[i] = new([]byte)
+		case embeddingcache.FieldEmbedding:
+			values[i] = new(pgvector.Vector)
+		case embeddingcache.FieldHits:
+			values[i] = new(sql.NullInt64)
+		case embeddingcache.FieldModel:
+			values[i] = new(sql.NullString)
+		case embeddingcache.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case embeddingcache.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmbeddingCache fields.
+func (ec *EmbeddingCache) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case embeddingcache.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ec.ID = *value
+			}
+		case embeddingcache.FieldHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value != nil {
+				ec.Hash = *value
+			}
+		case embeddingcache.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				ec.Model = value.String
+			}
+		case embeddingcache.FieldEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil {
+				ec.Embedding = *value
+			}
+		case embeddingcache.FieldHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hits", values[i])
+			} else if value.Valid {
+				ec.Hits = int(value.Int64)
+			}
+		case embeddingcache.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ec.CreatedAt = value.Time
+			}
+		default:
+			ec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmbeddingCache.
+// This includes values selected through modifiers, order, etc.
+func (ec *EmbeddingCache) Value(name string) (ent.Value, error) {
+	return ec.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmbeddingCache.
+// Note that you need to call EmbeddingCache.Unwrap() before calling this method if this EmbeddingCache
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ec *EmbeddingCache) Update() *EmbeddingCacheUpdateOne {
+	return NewEmbeddingCacheClient(ec.config).UpdateOne(ec)
+}
+
+// Unwrap unwraps the EmbeddingCache entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ec *EmbeddingCache) Unwrap() *EmbeddingCache {
+	_tx, ok := ec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmbeddingCache is not a transactional entity")
+	}
+	ec.config.driver = _tx.drv
+	return ec
+}
+
+// String implements the fmt.Stringer.
+func (ec *EmbeddingCache) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmbeddingCache(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ec.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Hash))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(ec.Model)
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("hits=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Hits))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ec.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmbeddingCaches is a parsable slice of EmbeddingCache.
+type EmbeddingCaches []*EmbeddingCache
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package embeddingcache
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldHash, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldModel, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// Hits applies equality check predicate on the "hits" field. It's identical to HitsEQ.
+func Hits(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldHits, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...[]byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...[]byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v []byte) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldHash, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldContainsFold(FieldModel, v))
+}
+
+// EmbeddingEQ applies the EQ predicate on the "embedding" field.
+func EmbeddingEQ(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
+func EmbeddingNEQ(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldEmbedding, v))
+}
+
+// EmbeddingIn applies the In predicate on the "embedding" field.
+func EmbeddingIn(vs ...pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingGT applies the GT predicate on the "embedding" field.
+func EmbeddingGT(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldEmbedding, v))
+}
+
+// EmbeddingGTE applies the GTE predicate on the "embedding" field.
+func EmbeddingGTE(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldEmbedding, v))
+}
+
+// EmbeddingLT applies the LT predicate on the "embedding" field.
+func EmbeddingLT(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldEmbedding, v))
+}
+
+// EmbeddingLTE applies the LTE predicate on the "embedding" field.
+func EmbeddingLTE(v pgvector.Vector) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldEmbedding, v))
+}
+
+// HitsEQ applies the EQ predicate on the "hits" field.
+func HitsEQ(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldHits, v))
+}
+
+// HitsNEQ applies the NEQ predicate on the "hits" field.
+func HitsNEQ(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldHits, v))
+}
+
+// HitsIn applies the In predicate on the "hits" field.
+func HitsIn(vs ...int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldHits, vs...))
+}
+
+// HitsNotIn applies the NotIn predicate on the "hits" field.
+func HitsNotIn(vs ...int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldHits, vs...))
+}
+
+// HitsGT applies the GT predicate on the "hits" field.
+func HitsGT(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldHits, v))
+}
+
+// HitsGTE applies the GTE predicate on the "hits" field.
+func HitsGTE(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldHits, v))
+}
+
+// HitsLT applies the LT predicate on the "hits" field.
+func HitsLT(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldHits, v))
+}
+
+// HitsLTE applies the LTE predicate on the "hits" field.
+func HitsLTE(v int) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldHits, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmbeddingCache) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmbeddingCache) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmbeddingCache) predicate.EmbeddingCache {
+	return predicate.EmbeddingCache(sql.NotPredicates(p))
+}
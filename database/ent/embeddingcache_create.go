@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+)
+
+// EmbeddingCacheCreate is the builder for creating a EmbeddingCache entity.
+type EmbeddingCacheCreate struct {
+	config
+	mutation *EmbeddingCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHash sets the "hash" field.
+func (ecc *EmbeddingCacheCreate) SetHash(b []byte) *EmbeddingCacheCreate {
+	ecc.mutation.SetHash(b)
+	return ecc
+}
+
+// SetModel sets the "model" field.
+func (ecc *EmbeddingCacheCreate) SetModel(s string) *EmbeddingCacheCreate {
+	ecc.mutation.SetModel(s)
+	return ecc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (ecc *EmbeddingCacheCreate) SetEmbedding(pg pgvector.Vector) *EmbeddingCacheCreate {
+	ecc.mutation.SetEmbedding(pg)
+	return ecc
+}
+
+// SetHits sets the "hits" field.
+func (ecc *EmbeddingCacheCreate) SetHits(i int) *EmbeddingCacheCreate {
+	ecc.mutation.SetHits(i)
+	return ecc
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (ecc *EmbeddingCacheCreate) SetNillableHits(i *int) *EmbeddingCacheCreate {
+	if i != nil {
+		ecc.SetHits(*i)
+	}
+	return ecc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecc *EmbeddingCacheCreate) SetCreatedAt(t time.Time) *EmbeddingCacheCreate {
+	ecc.mutation.SetCreatedAt(t)
+	return ecc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecc *EmbeddingCacheCreate) SetNillableCreatedAt(t *time.Time) *EmbeddingCacheCreate {
+	if t != nil {
+		ecc.SetCreatedAt(*t)
+	}
+	return ecc
+}
+
+// SetID sets the "id" field.
+func (ecc *EmbeddingCacheCreate) SetID(u uuid.UUID) *EmbeddingCacheCreate {
+	ecc.mutation.SetID(u)
+	return ecc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ecc *EmbeddingCacheCreate) SetNillableID(u *uuid.UUID) *EmbeddingCacheCreate {
+	if u != nil {
+		ecc.SetID(*u)
+	}
+	return ecc
+}
+
+// Mutation returns the EmbeddingCacheMutation object of the builder.
+func (ecc *EmbeddingCacheCreate) Mutation() *EmbeddingCacheMutation {
+	return ecc.mutation
+}
+
+// Save creates the EmbeddingCache in the database.
+func (ecc *EmbeddingCacheCreate) Save(ctx context.Context) (*EmbeddingCache, error) {
+	ecc.defaults()
+	return withHooks(ctx, ecc.sqlSave, ecc.mutation, ecc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EmbeddingCacheCreate) SaveX(ctx context.Context) *EmbeddingCache {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecc *EmbeddingCacheCreate) Exec(ctx context.Context) error {
+	_, err := ecc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecc *EmbeddingCacheCreate) ExecX(ctx context.Context) {
+	if err := ecc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EmbeddingCacheCreate) defaults() {
+	if _, ok := ecc.mutation.Hits(); !ok {
+		v := embeddingcache.DefaultHits
+		ecc.mutation.SetHits(v)
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		v := embeddingcache.DefaultCreatedAt()
+		ecc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ecc.mutation.ID(); !ok {
+		v := embeddingcache.DefaultID()
+		ecc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EmbeddingCacheCreate) check() error {
+	if _, ok := ecc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "EmbeddingCache.hash"`)}
+	}
+	if _, ok := ecc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "EmbeddingCache.model"`)}
+	}
+	if _, ok := ecc.mutation.Embedding(); !ok {
+		return &ValidationError{Name: "embedding", err: errors.New(`ent: missing required field "EmbeddingCache.embedding"`)}
+	}
+	if _, ok := ecc.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`ent: missing required field "EmbeddingCache.hits"`)}
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmbeddingCache.created_at"`)}
+	}
+	return nil
+}
+
+func (ecc *EmbeddingCacheCreate) sqlSave(ctx context.Context) (*EmbeddingCache, error) {
+	if err := ecc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ecc.mutation.id = &_node.ID
+	ecc.mutation.done = true
+	return _node, nil
+}
+
+func (ecc *EmbeddingCacheCreate) createSpec() (*EmbeddingCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmbeddingCache{config: ecc.config}
+		_spec = sqlgraph.NewCreateSpec(embeddingcache.Table, sqlgraph.NewFieldSpec(embeddingcache.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ecc.conflict
+	if id, ok := ecc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ecc.mutation.Hash(); ok {
+		_spec.SetField(embeddingcache.FieldHash, field.TypeBytes, value)
+		_node.Hash = value
+	}
+	if value, ok := ecc.mutation.Model(); ok {
+		_spec.SetField(embeddingcache.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := ecc.mutation.Embedding(); ok {
+		_spec.SetField(embeddingcache.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = value
+	}
+	if value, ok := ecc.mutation.Hits(); ok {
+		_spec.SetField(embeddingcache.FieldHits, field.TypeInt, value)
+		_node.Hits = value
+	}
+	if value, ok := ecc.mutation.CreatedAt(); ok {
+		_spec.SetField(embeddingcache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingCache.Create().
+//		SetHash(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingCacheUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (ecc *EmbeddingCacheCreate) OnConflict(opts ...sql.ConflictOption) *EmbeddingCacheUpsertOne {
+	ecc.conflict = opts
+	return &EmbeddingCacheUpsertOne{
+		create: ecc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ecc *EmbeddingCacheCreate) OnConflictColumns(columns ...string) *EmbeddingCacheUpsertOne {
+	ecc.conflict = append(ecc.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingCacheUpsertOne{
+		create: ecc,
+	}
+}
+
+type (
+	// EmbeddingCacheUpsertOne is the builder for "upsert"-ing
+	//  one EmbeddingCache node.
+	EmbeddingCacheUpsertOne struct {
+		create *EmbeddingCacheCreate
+	}
+
+	// EmbeddingCacheUpsert is the "OnConflict" setter.
+	EmbeddingCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHash sets the "hash" field.
+func (u *EmbeddingCacheUpsert) SetHash(v []byte) *EmbeddingCacheUpsert {
+	u.Set(embeddingcache.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsert) UpdateHash() *EmbeddingCacheUpsert {
+	u.SetExcluded(embeddingcache.FieldHash)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *EmbeddingCacheUpsert) SetModel(v string) *EmbeddingCacheUpsert {
+	u.Set(embeddingcache.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsert) UpdateModel() *EmbeddingCacheUpsert {
+	u.SetExcluded(embeddingcache.FieldModel)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *EmbeddingCacheUpsert) SetEmbedding(v pgvector.Vector) *EmbeddingCacheUpsert {
+	u.Set(embeddingcache.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsert) UpdateEmbedding() *EmbeddingCacheUpsert {
+	u.SetExcluded(embeddingcache.FieldEmbedding)
+	return u
+}
+
+// SetHits sets the "hits" field.
+func (u *EmbeddingCacheUpsert) SetHits(v int) *EmbeddingCacheUpsert {
+	u.Set(embeddingcache.FieldHits, v)
+	return u
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsert) UpdateHits() *EmbeddingCacheUpsert {
+	u.SetExcluded(embeddingcache.FieldHits)
+	return u
+}
+
+// AddHits adds v to the "hits" field.
+func (u *EmbeddingCacheUpsert) AddHits(v int) *EmbeddingCacheUpsert {
+	u.Add(embeddingcache.FieldHits, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EmbeddingCacheUpsert) SetCreatedAt(v time.Time) *EmbeddingCacheUpsert {
+	u.Set(embeddingcache.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsert) UpdateCreatedAt() *EmbeddingCacheUpsert {
+	u.SetExcluded(embeddingcache.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingcache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingCacheUpsertOne) UpdateNewValues() *EmbeddingCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(embeddingcache.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmbeddingCacheUpsertOne) Ignore() *EmbeddingCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingCacheUpsertOne) DoNothing() *EmbeddingCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingCacheCreate.OnConflict
+// documentation for more info.
+func (u *EmbeddingCacheUpsertOne) Update(set func(*EmbeddingCacheUpsert)) *EmbeddingCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *EmbeddingCacheUpsertOne) SetHash(v []byte) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertOne) UpdateHash() *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *EmbeddingCacheUpsertOne) SetModel(v string) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertOne) UpdateModel() *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *EmbeddingCacheUpsertOne) SetEmbedding(v pgvector.Vector) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertOne) UpdateEmbedding() *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *EmbeddingCacheUpsertOne) SetHits(v int) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *EmbeddingCacheUpsertOne) AddHits(v int) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertOne) UpdateHits() *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EmbeddingCacheUpsertOne) SetCreatedAt(v time.Time) *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertOne) UpdateCreatedAt() *EmbeddingCacheUpsertOne {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmbeddingCacheUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmbeddingCacheUpsertOne.ID is not supported by MySQL driver. Use EmbeddingCacheUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmbeddingCacheUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmbeddingCacheCreateBulk is the builder for creating many EmbeddingCache entities in bulk.
+type EmbeddingCacheCreateBulk struct {
+	config
+	err      error
+	builders []*EmbeddingCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmbeddingCache entities in the database.
+func (eccb *EmbeddingCacheCreateBulk) Save(ctx context.Context) ([]*EmbeddingCache, error) {
+	if eccb.err != nil {
+		return nil, eccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EmbeddingCache, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmbeddingCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EmbeddingCacheCreateBulk) SaveX(ctx context.Context) []*EmbeddingCache {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccb *EmbeddingCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := eccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccb *EmbeddingCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := eccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingCacheUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (eccb *EmbeddingCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmbeddingCacheUpsertBulk {
+	eccb.conflict = opts
+	return &EmbeddingCacheUpsertBulk{
+		create: eccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eccb *EmbeddingCacheCreateBulk) OnConflictColumns(columns ...string) *EmbeddingCacheUpsertBulk {
+	eccb.conflict = append(eccb.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingCacheUpsertBulk{
+		create: eccb,
+	}
+}
+
+// EmbeddingCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmbeddingCache nodes.
+type EmbeddingCacheUpsertBulk struct {
+	create *EmbeddingCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingcache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingCacheUpsertBulk) UpdateNewValues() *EmbeddingCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(embeddingcache.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmbeddingCacheUpsertBulk) Ignore() *EmbeddingCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingCacheUpsertBulk) DoNothing() *EmbeddingCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmbeddingCacheUpsertBulk) Update(set func(*EmbeddingCacheUpsert)) *EmbeddingCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *EmbeddingCacheUpsertBulk) SetHash(v []byte) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertBulk) UpdateHash() *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *EmbeddingCacheUpsertBulk) SetModel(v string) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertBulk) UpdateModel() *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *EmbeddingCacheUpsertBulk) SetEmbedding(v pgvector.Vector) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertBulk) UpdateEmbedding() *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *EmbeddingCacheUpsertBulk) SetHits(v int) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *EmbeddingCacheUpsertBulk) AddHits(v int) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertBulk) UpdateHits() *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *EmbeddingCacheUpsertBulk) SetCreatedAt(v time.Time) *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *EmbeddingCacheUpsertBulk) UpdateCreatedAt() *EmbeddingCacheUpsertBulk {
+	return u.Update(func(s *EmbeddingCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmbeddingCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
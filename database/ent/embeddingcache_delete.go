@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// EmbeddingCacheDelete is the builder for deleting a EmbeddingCache entity.
+type EmbeddingCacheDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingCacheMutation
+}
+
+// Where appends a list predicates to the EmbeddingCacheDelete builder.
+func (ecd *EmbeddingCacheDelete) Where(ps ...predicate.EmbeddingCache) *EmbeddingCacheDelete {
+	ecd.mutation.Where(ps...)
+	return ecd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ecd *EmbeddingCacheDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecd.sqlExec, ecd.mutation, ecd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecd *EmbeddingCacheDelete) ExecX(ctx context.Context) int {
+	n, err := ecd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ecd *EmbeddingCacheDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(embeddingcache.Table, sqlgraph.NewFieldSpec(embeddingcache.FieldID, field.TypeUUID))
+	if ps := ecd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ecd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ecd.mutation.done = true
+	return affected, err
+}
+
+// EmbeddingCacheDeleteOne is the builder for deleting a single EmbeddingCache entity.
+type EmbeddingCacheDeleteOne struct {
+	ecd *EmbeddingCacheDelete
+}
+
+// Where appends a list predicates to the EmbeddingCacheDelete builder.
+func (ecdo *EmbeddingCacheDeleteOne) Where(ps ...predicate.EmbeddingCache) *EmbeddingCacheDeleteOne {
+	ecdo.ecd.mutation.Where(ps...)
+	return ecdo
+}
+
+// Exec executes the deletion query.
+func (ecdo *EmbeddingCacheDeleteOne) Exec(ctx context.Context) error {
+	n, err := ecdo.ecd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{embeddingcache.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecdo *EmbeddingCacheDeleteOne) ExecX(ctx context.Context) {
+	if err := ecdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
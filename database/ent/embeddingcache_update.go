@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// EmbeddingCacheUpdate is the builder for updating EmbeddingCache entities.
+type EmbeddingCacheUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmbeddingCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmbeddingCacheUpdate builder.
+func (ecu *EmbeddingCacheUpdate) Where(ps ...predicate.EmbeddingCache) *EmbeddingCacheUpdate {
+	ecu.mutation.Where(ps...)
+	return ecu
+}
+
+// SetHash sets the "hash" field.
+func (ecu *EmbeddingCacheUpdate) SetHash(b []byte) *EmbeddingCacheUpdate {
+	ecu.mutation.SetHash(b)
+	return ecu
+}
+
+// SetModel sets the "model" field.
+func (ecu *EmbeddingCacheUpdate) SetModel(s string) *EmbeddingCacheUpdate {
+	ecu.mutation.SetModel(s)
+	return ecu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (ecu *EmbeddingCacheUpdate) SetNillableModel(s *string) *EmbeddingCacheUpdate {
+	if s != nil {
+		ecu.SetModel(*s)
+	}
+	return ecu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (ecu *EmbeddingCacheUpdate) SetEmbedding(pg pgvector.Vector) *EmbeddingCacheUpdate {
+	ecu.mutation.SetEmbedding(pg)
+	return ecu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (ecu *EmbeddingCacheUpdate) SetNillableEmbedding(pg *pgvector.Vector) *EmbeddingCacheUpdate {
+	if pg != nil {
+		ecu.SetEmbedding(*pg)
+	}
+	return ecu
+}
+
+// SetHits sets the "hits" field.
+func (ecu *EmbeddingCacheUpdate) SetHits(i int) *EmbeddingCacheUpdate {
+	ecu.mutation.ResetHits()
+	ecu.mutation.SetHits(i)
+	return ecu
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (ecu *EmbeddingCacheUpdate) SetNillableHits(i *int) *EmbeddingCacheUpdate {
+	if i != nil {
+		ecu.SetHits(*i)
+	}
+	return ecu
+}
+
+// AddHits adds i to the "hits" field.
+func (ecu *EmbeddingCacheUpdate) AddHits(i int) *EmbeddingCacheUpdate {
+	ecu.mutation.AddHits(i)
+	return ecu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecu *EmbeddingCacheUpdate) SetCreatedAt(t time.Time) *EmbeddingCacheUpdate {
+	ecu.mutation.SetCreatedAt(t)
+	return ecu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecu *EmbeddingCacheUpdate) SetNillableCreatedAt(t *time.Time) *EmbeddingCacheUpdate {
+	if t != nil {
+		ecu.SetCreatedAt(*t)
+	}
+	return ecu
+}
+
+// Mutation returns the EmbeddingCacheMutation object of the builder.
+func (ecu *EmbeddingCacheUpdate) Mutation() *EmbeddingCacheMutation {
+	return ecu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EmbeddingCacheUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecu.sqlSave, ecu.mutation, ecu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EmbeddingCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EmbeddingCacheUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EmbeddingCacheUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ecu *EmbeddingCacheUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmbeddingCacheUpdate {
+	ecu.modifiers = append(ecu.modifiers, modifiers...)
+	return ecu
+}
+
+func (ecu *EmbeddingCacheUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingcache.Table, embeddingcache.Columns, sqlgraph.NewFieldSpec(embeddingcache.FieldID, field.TypeUUID))
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.Hash(); ok {
+		_spec.SetField(embeddingcache.FieldHash, field.TypeBytes, value)
+	}
+	if value, ok := ecu.mutation.Model(); ok {
+		_spec.SetField(embeddingcache.FieldModel, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Embedding(); ok {
+		_spec.SetField(embeddingcache.FieldEmbedding, field.TypeOther, value)
+	}
+	if value, ok := ecu.mutation.Hits(); ok {
+		_spec.SetField(embeddingcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := ecu.mutation.AddedHits(); ok {
+		_spec.AddField(embeddingcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := ecu.mutation.CreatedAt(); ok {
+		_spec.SetField(embeddingcache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ecu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingcache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ecu.mutation.done = true
+	return n, nil
+}
+
+// EmbeddingCacheUpdateOne is the builder for updating a single EmbeddingCache entity.
+type EmbeddingCacheUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmbeddingCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetHash sets the "hash" field.
+func (ecuo *EmbeddingCacheUpdateOne) SetHash(b []byte) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.SetHash(b)
+	return ecuo
+}
+
+// SetModel sets the "model" field.
+func (ecuo *EmbeddingCacheUpdateOne) SetModel(s string) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.SetModel(s)
+	return ecuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (ecuo *EmbeddingCacheUpdateOne) SetNillableModel(s *string) *EmbeddingCacheUpdateOne {
+	if s != nil {
+		ecuo.SetModel(*s)
+	}
+	return ecuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (ecuo *EmbeddingCacheUpdateOne) SetEmbedding(pg pgvector.Vector) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.SetEmbedding(pg)
+	return ecuo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (ecuo *EmbeddingCacheUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *EmbeddingCacheUpdateOne {
+	if pg != nil {
+		ecuo.SetEmbedding(*pg)
+	}
+	return ecuo
+}
+
+// SetHits sets the "hits" field.
+func (ecuo *EmbeddingCacheUpdateOne) SetHits(i int) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.ResetHits()
+	ecuo.mutation.SetHits(i)
+	return ecuo
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (ecuo *EmbeddingCacheUpdateOne) SetNillableHits(i *int) *EmbeddingCacheUpdateOne {
+	if i != nil {
+		ecuo.SetHits(*i)
+	}
+	return ecuo
+}
+
+// AddHits adds i to the "hits" field.
+func (ecuo *EmbeddingCacheUpdateOne) AddHits(i int) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.AddHits(i)
+	return ecuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecuo *EmbeddingCacheUpdateOne) SetCreatedAt(t time.Time) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.SetCreatedAt(t)
+	return ecuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecuo *EmbeddingCacheUpdateOne) SetNillableCreatedAt(t *time.Time) *EmbeddingCacheUpdateOne {
+	if t != nil {
+		ecuo.SetCreatedAt(*t)
+	}
+	return ecuo
+}
+
+// Mutation returns the EmbeddingCacheMutation object of the builder.
+func (ecuo *EmbeddingCacheUpdateOne) Mutation() *EmbeddingCacheMutation {
+	return ecuo.mutation
+}
+
+// Where appends a list predicates to the EmbeddingCacheUpdate builder.
+func (ecuo *EmbeddingCacheUpdateOne) Where(ps ...predicate.EmbeddingCache) *EmbeddingCacheUpdateOne {
+	ecuo.mutation.Where(ps...)
+	return ecuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EmbeddingCacheUpdateOne) Select(field string, fields ...string) *EmbeddingCacheUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EmbeddingCache entity.
+func (ecuo *EmbeddingCacheUpdateOne) Save(ctx context.Context) (*EmbeddingCache, error) {
+	return withHooks(ctx, ecuo.sqlSave, ecuo.mutation, ecuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EmbeddingCacheUpdateOne) SaveX(ctx context.Context) *EmbeddingCache {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EmbeddingCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EmbeddingCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ecuo *EmbeddingCacheUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmbeddingCacheUpdateOne {
+	ecuo.modifiers = append(ecuo.modifiers, modifiers...)
+	return ecuo
+}
+
+func (ecuo *EmbeddingCacheUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingcache.Table, embeddingcache.Columns, sqlgraph.NewFieldSpec(embeddingcache.FieldID, field.TypeUUID))
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingcache.FieldID)
+		for _, f := range fields {
+			if !embeddingcache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingcache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.Hash(); ok {
+		_spec.SetField(embeddingcache.FieldHash, field.TypeBytes, value)
+	}
+	if value, ok := ecuo.mutation.Model(); ok {
+		_spec.SetField(embeddingcache.FieldModel, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Embedding(); ok {
+		_spec.SetField(embeddingcache.FieldEmbedding, field.TypeOther, value)
+	}
+	if value, ok := ecuo.mutation.Hits(); ok {
+		_spec.SetField(embeddingcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := ecuo.mutation.AddedHits(); ok {
+		_spec.AddField(embeddingcache.FieldHits, field.TypeInt, value)
+	}
+	if value, ok := ecuo.mutation.CreatedAt(); ok {
+		_spec.SetField(embeddingcache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ecuo.modifiers...)
+	_node = &EmbeddingCache{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingcache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ecuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
+)
+
+// EmbeddingSpend is the model entity for the EmbeddingSpend schema.
+type EmbeddingSpend struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Spent holds the value of the "spent" field.
+	Spent float64 `json:"spent,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmbeddingSpend) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case embeddingspend.FieldSpent:
+			values[i] = new(sql.NullFloat64)
+		case embeddingspend.FieldProvider:
+			values[i] = new(sql.NullString)
+		case embeddingspend.FieldDay, embeddingspend.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case embeddingspend.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmbeddingSpend fields.
+func (es *EmbeddingSpend) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case embeddingspend.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				es.ID = *value
+			}
+		case embeddingspend.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				es.Day = value.Time
+			}
+		case embeddingspend.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				es.Provider = value.String
+			}
+		case embeddingspend.FieldSpent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spent", values[i])
+			} else if value.Valid {
+				es.Spent = value.Float64
+			}
+		case embeddingspend.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				es.UpdatedAt = value.Time
+			}
+		default:
+			es.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmbeddingSpend.
+// This includes values selected through modifiers, order, etc.
+func (es *EmbeddingSpend) Value(name string) (ent.Value, error) {
+	return es.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmbeddingSpend.
+// Note that you need to call EmbeddingSpend.Unwrap() before calling this method if this EmbeddingSpend
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (es *EmbeddingSpend) Update() *EmbeddingSpendUpdateOne {
+	return NewEmbeddingSpendClient(es.config).UpdateOne(es)
+}
+
+// Unwrap unwraps the EmbeddingSpend entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (es *EmbeddingSpend) Unwrap() *EmbeddingSpend {
+	_tx, ok := es.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmbeddingSpend is not a transactional entity")
+	}
+	es.config.driver = _tx.drv
+	return es
+}
+
+// String implements the fmt.Stringer.
+func (es *EmbeddingSpend) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmbeddingSpend(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", es.ID))
+	builder.WriteString("day=")
+	builder.WriteString(es.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(es.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("spent=")
+	builder.WriteString(fmt.Sprintf("%v", es.Spent))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(es.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmbeddingSpends is a parsable slice of EmbeddingSpend.
+type EmbeddingSpends []*EmbeddingSpend
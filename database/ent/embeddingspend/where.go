@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package embeddingspend
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLTE(FieldID, id))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldDay, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldProvider, v))
+}
+
+// Spent applies equality check predicate on the "spent" field. It's identical to SpentEQ.
+func Spent(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldSpent, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLTE(FieldDay, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// SpentEQ applies the EQ predicate on the "spent" field.
+func SpentEQ(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldSpent, v))
+}
+
+// SpentNEQ applies the NEQ predicate on the "spent" field.
+func SpentNEQ(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNEQ(FieldSpent, v))
+}
+
+// SpentIn applies the In predicate on the "spent" field.
+func SpentIn(vs ...float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldIn(FieldSpent, vs...))
+}
+
+// SpentNotIn applies the NotIn predicate on the "spent" field.
+func SpentNotIn(vs ...float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNotIn(FieldSpent, vs...))
+}
+
+// SpentGT applies the GT predicate on the "spent" field.
+func SpentGT(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGT(FieldSpent, v))
+}
+
+// SpentGTE applies the GTE predicate on the "spent" field.
+func SpentGTE(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGTE(FieldSpent, v))
+}
+
+// SpentLT applies the LT predicate on the "spent" field.
+func SpentLT(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLT(FieldSpent, v))
+}
+
+// SpentLTE applies the LTE predicate on the "spent" field.
+func SpentLTE(v float64) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLTE(FieldSpent, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmbeddingSpend) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmbeddingSpend) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmbeddingSpend) predicate.EmbeddingSpend {
+	return predicate.EmbeddingSpend(sql.NotPredicates(p))
+}
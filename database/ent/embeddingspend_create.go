@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
+)
+
+// EmbeddingSpendCreate is the builder for creating a EmbeddingSpend entity.
+type EmbeddingSpendCreate struct {
+	config
+	mutation *EmbeddingSpendMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDay sets the "day" field.
+func (esc *EmbeddingSpendCreate) SetDay(t time.Time) *EmbeddingSpendCreate {
+	esc.mutation.SetDay(t)
+	return esc
+}
+
+// SetProvider sets the "provider" field.
+func (esc *EmbeddingSpendCreate) SetProvider(s string) *EmbeddingSpendCreate {
+	esc.mutation.SetProvider(s)
+	return esc
+}
+
+// SetSpent sets the "spent" field.
+func (esc *EmbeddingSpendCreate) SetSpent(f float64) *EmbeddingSpendCreate {
+	esc.mutation.SetSpent(f)
+	return esc
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (esc *EmbeddingSpendCreate) SetNillableSpent(f *float64) *EmbeddingSpendCreate {
+	if f != nil {
+		esc.SetSpent(*f)
+	}
+	return esc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esc *EmbeddingSpendCreate) SetUpdatedAt(t time.Time) *EmbeddingSpendCreate {
+	esc.mutation.SetUpdatedAt(t)
+	return esc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (esc *EmbeddingSpendCreate) SetNillableUpdatedAt(t *time.Time) *EmbeddingSpendCreate {
+	if t != nil {
+		esc.SetUpdatedAt(*t)
+	}
+	return esc
+}
+
+// SetID sets the "id" field.
+func (esc *EmbeddingSpendCreate) SetID(u uuid.UUID) *EmbeddingSpendCreate {
+	esc.mutation.SetID(u)
+	return esc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (esc *EmbeddingSpendCreate) SetNillableID(u *uuid.UUID) *EmbeddingSpendCreate {
+	if u != nil {
+		esc.SetID(*u)
+	}
+	return esc
+}
+
+// Mutation returns the EmbeddingSpendMutation object of the builder.
+func (esc *EmbeddingSpendCreate) Mutation() *EmbeddingSpendMutation {
+	return esc.mutation
+}
+
+// Save creates the EmbeddingSpend in the database.
+func (esc *EmbeddingSpendCreate) Save(ctx context.Context) (*EmbeddingSpend, error) {
+	esc.defaults()
+	return withHooks(ctx, esc.sqlSave, esc.mutation, esc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (esc *EmbeddingSpendCreate) SaveX(ctx context.Context) *EmbeddingSpend {
+	v, err := esc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (esc *EmbeddingSpendCreate) Exec(ctx context.Context) error {
+	_, err := esc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esc *EmbeddingSpendCreate) ExecX(ctx context.Context) {
+	if err := esc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esc *EmbeddingSpendCreate) defaults() {
+	if _, ok := esc.mutation.Spent(); !ok {
+		v := embeddingspend.DefaultSpent
+		esc.mutation.SetSpent(v)
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		v := embeddingspend.DefaultUpdatedAt()
+		esc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := esc.mutation.ID(); !ok {
+		v := embeddingspend.DefaultID()
+		esc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esc *EmbeddingSpendCreate) check() error {
+	if _, ok := esc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "EmbeddingSpend.day"`)}
+	}
+	if _, ok := esc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "EmbeddingSpend.provider"`)}
+	}
+	if _, ok := esc.mutation.Spent(); !ok {
+		return &ValidationError{Name: "spent", err: errors.New(`ent: missing required field "EmbeddingSpend.spent"`)}
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmbeddingSpend.updated_at"`)}
+	}
+	return nil
+}
+
+func (esc *EmbeddingSpendCreate) sqlSave(ctx context.Context) (*EmbeddingSpend, error) {
+	if err := esc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := esc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, esc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	esc.mutation.id = &_node.ID
+	esc.mutation.done = true
+	return _node, nil
+}
+
+func (esc *EmbeddingSpendCreate) createSpec() (*EmbeddingSpend, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmbeddingSpend{config: esc.config}
+		_spec = sqlgraph.NewCreateSpec(embeddingspend.Table, sqlgraph.NewFieldSpec(embeddingspend.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = esc.conflict
+	if id, ok := esc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := esc.mutation.Day(); ok {
+		_spec.SetField(embeddingspend.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := esc.mutation.Provider(); ok {
+		_spec.SetField(embeddingspend.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := esc.mutation.Spent(); ok {
+		_spec.SetField(embeddingspend.FieldSpent, field.TypeFloat64, value)
+		_node.Spent = value
+	}
+	if value, ok := esc.mutation.UpdatedAt(); ok {
+		_spec.SetField(embeddingspend.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingSpend.Create().
+//		SetDay(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingSpendUpsert) {
+//			SetDay(v+v).
+//		}).
+//		Exec(ctx)
+func (esc *EmbeddingSpendCreate) OnConflict(opts ...sql.ConflictOption) *EmbeddingSpendUpsertOne {
+	esc.conflict = opts
+	return &EmbeddingSpendUpsertOne{
+		create: esc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (esc *EmbeddingSpendCreate) OnConflictColumns(columns ...string) *EmbeddingSpendUpsertOne {
+	esc.conflict = append(esc.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingSpendUpsertOne{
+		create: esc,
+	}
+}
+
+type (
+	// EmbeddingSpendUpsertOne is the builder for "upsert"-ing
+	//  one EmbeddingSpend node.
+	EmbeddingSpendUpsertOne struct {
+		create *EmbeddingSpendCreate
+	}
+
+	// EmbeddingSpendUpsert is the "OnConflict" setter.
+	EmbeddingSpendUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDay sets the "day" field.
+func (u *EmbeddingSpendUpsert) SetDay(v time.Time) *EmbeddingSpendUpsert {
+	u.Set(embeddingspend.FieldDay, v)
+	return u
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsert) UpdateDay() *EmbeddingSpendUpsert {
+	u.SetExcluded(embeddingspend.FieldDay)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmbeddingSpendUpsert) SetProvider(v string) *EmbeddingSpendUpsert {
+	u.Set(embeddingspend.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsert) UpdateProvider() *EmbeddingSpendUpsert {
+	u.SetExcluded(embeddingspend.FieldProvider)
+	return u
+}
+
+// SetSpent sets the "spent" field.
+func (u *EmbeddingSpendUpsert) SetSpent(v float64) *EmbeddingSpendUpsert {
+	u.Set(embeddingspend.FieldSpent, v)
+	return u
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsert) UpdateSpent() *EmbeddingSpendUpsert {
+	u.SetExcluded(embeddingspend.FieldSpent)
+	return u
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *EmbeddingSpendUpsert) AddSpent(v float64) *EmbeddingSpendUpsert {
+	u.Add(embeddingspend.FieldSpent, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmbeddingSpendUpsert) SetUpdatedAt(v time.Time) *EmbeddingSpendUpsert {
+	u.Set(embeddingspend.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsert) UpdateUpdatedAt() *EmbeddingSpendUpsert {
+	u.SetExcluded(embeddingspend.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingspend.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingSpendUpsertOne) UpdateNewValues() *EmbeddingSpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(embeddingspend.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmbeddingSpendUpsertOne) Ignore() *EmbeddingSpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingSpendUpsertOne) DoNothing() *EmbeddingSpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingSpendCreate.OnConflict
+// documentation for more info.
+func (u *EmbeddingSpendUpsertOne) Update(set func(*EmbeddingSpendUpsert)) *EmbeddingSpendUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingSpendUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *EmbeddingSpendUpsertOne) SetDay(v time.Time) *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertOne) UpdateDay() *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmbeddingSpendUpsertOne) SetProvider(v string) *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertOne) UpdateProvider() *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *EmbeddingSpendUpsertOne) SetSpent(v float64) *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *EmbeddingSpendUpsertOne) AddSpent(v float64) *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.AddSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertOne) UpdateSpent() *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateSpent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmbeddingSpendUpsertOne) SetUpdatedAt(v time.Time) *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertOne) UpdateUpdatedAt() *EmbeddingSpendUpsertOne {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingSpendUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingSpendCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingSpendUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmbeddingSpendUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmbeddingSpendUpsertOne.ID is not supported by MySQL driver. Use EmbeddingSpendUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmbeddingSpendUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmbeddingSpendCreateBulk is the builder for creating many EmbeddingSpend entities in bulk.
+type EmbeddingSpendCreateBulk struct {
+	config
+	err      error
+	builders []*EmbeddingSpendCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmbeddingSpend entities in the database.
+func (escb *EmbeddingSpendCreateBulk) Save(ctx context.Context) ([]*EmbeddingSpend, error) {
+	if escb.err != nil {
+		return nil, escb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(escb.builders))
+	nodes := make([]*EmbeddingSpend, len(escb.builders))
+	mutators := make([]Mutator, len(escb.builders))
+	for i := range escb.builders {
+		func(i int, root context.Context) {
+			builder := escb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmbeddingSpendMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, escb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = escb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, escb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, escb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (escb *EmbeddingSpendCreateBulk) SaveX(ctx context.Context) []*EmbeddingSpend {
+	v, err := escb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (escb *EmbeddingSpendCreateBulk) Exec(ctx context.Context) error {
+	_, err := escb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (escb *EmbeddingSpendCreateBulk) ExecX(ctx context.Context) {
+	if err := escb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingSpend.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingSpendUpsert) {
+//			SetDay(v+v).
+//		}).
+//		Exec(ctx)
+func (escb *EmbeddingSpendCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmbeddingSpendUpsertBulk {
+	escb.conflict = opts
+	return &EmbeddingSpendUpsertBulk{
+		create: escb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (escb *EmbeddingSpendCreateBulk) OnConflictColumns(columns ...string) *EmbeddingSpendUpsertBulk {
+	escb.conflict = append(escb.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingSpendUpsertBulk{
+		create: escb,
+	}
+}
+
+// EmbeddingSpendUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmbeddingSpend nodes.
+type EmbeddingSpendUpsertBulk struct {
+	create *EmbeddingSpendCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingspend.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingSpendUpsertBulk) UpdateNewValues() *EmbeddingSpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(embeddingspend.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingSpend.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmbeddingSpendUpsertBulk) Ignore() *EmbeddingSpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingSpendUpsertBulk) DoNothing() *EmbeddingSpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingSpendCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmbeddingSpendUpsertBulk) Update(set func(*EmbeddingSpendUpsert)) *EmbeddingSpendUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingSpendUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDay sets the "day" field.
+func (u *EmbeddingSpendUpsertBulk) SetDay(v time.Time) *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetDay(v)
+	})
+}
+
+// UpdateDay sets the "day" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertBulk) UpdateDay() *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateDay()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmbeddingSpendUpsertBulk) SetProvider(v string) *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertBulk) UpdateProvider() *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *EmbeddingSpendUpsertBulk) SetSpent(v float64) *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// AddSpent adds v to the "spent" field.
+func (u *EmbeddingSpendUpsertBulk) AddSpent(v float64) *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.AddSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertBulk) UpdateSpent() *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateSpent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmbeddingSpendUpsertBulk) SetUpdatedAt(v time.Time) *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmbeddingSpendUpsertBulk) UpdateUpdatedAt() *EmbeddingSpendUpsertBulk {
+	return u.Update(func(s *EmbeddingSpendUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingSpendUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmbeddingSpendCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingSpendCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingSpendUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
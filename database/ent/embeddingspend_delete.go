@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// EmbeddingSpendDelete is the builder for deleting a EmbeddingSpend entity.
+type EmbeddingSpendDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingSpendMutation
+}
+
+// Where appends a list predicates to the EmbeddingSpendDelete builder.
+func (esd *EmbeddingSpendDelete) Where(ps ...predicate.EmbeddingSpend) *EmbeddingSpendDelete {
+	esd.mutation.Where(ps...)
+	return esd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (esd *EmbeddingSpendDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, esd.sqlExec, esd.mutation, esd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esd *EmbeddingSpendDelete) ExecX(ctx context.Context) int {
+	n, err := esd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (esd *EmbeddingSpendDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(embeddingspend.Table, sqlgraph.NewFieldSpec(embeddingspend.FieldID, field.TypeUUID))
+	if ps := esd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, esd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	esd.mutation.done = true
+	return affected, err
+}
+
+// EmbeddingSpendDeleteOne is the builder for deleting a single EmbeddingSpend entity.
+type EmbeddingSpendDeleteOne struct {
+	esd *EmbeddingSpendDelete
+}
+
+// Where appends a list predicates to the EmbeddingSpendDelete builder.
+func (esdo *EmbeddingSpendDeleteOne) Where(ps ...predicate.EmbeddingSpend) *EmbeddingSpendDeleteOne {
+	esdo.esd.mutation.Where(ps...)
+	return esdo
+}
+
+// Exec executes the deletion query.
+func (esdo *EmbeddingSpendDeleteOne) Exec(ctx context.Context) error {
+	n, err := esdo.esd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{embeddingspend.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esdo *EmbeddingSpendDeleteOne) ExecX(ctx context.Context) {
+	if err := esdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
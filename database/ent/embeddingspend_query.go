@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// EmbeddingSpendQuery is the builder for querying EmbeddingSpend entities.
+type EmbeddingSpendQuery struct {
+	config
+	ctx        *QueryContext
+	order      []embeddingspend.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmbeddingSpend
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmbeddingSpendQuery builder.
+func (esq *EmbeddingSpendQuery) Where(ps ...predicate.EmbeddingSpend) *EmbeddingSpendQuery {
+	esq.predicates = append(esq.predicates, ps...)
+	return esq
+}
+
+// Limit the number of records to be returned by this query.
+func (esq *EmbeddingSpendQuery) Limit(limit int) *EmbeddingSpendQuery {
+	esq.ctx.Limit = &limit
+	return esq
+}
+
+// Offset to start from.
+func (esq *EmbeddingSpendQuery) Offset(offset int) *EmbeddingSpendQuery {
+	esq.ctx.Offset = &offset
+	return esq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (esq *EmbeddingSpendQuery) Unique(unique bool) *EmbeddingSpendQuery {
+	esq.ctx.Unique = &unique
+	return esq
+}
+
+// Order specifies how the records should be ordered.
+func (esq *EmbeddingSpendQuery) Order(o ...embeddingspend.OrderOption) *EmbeddingSpendQuery {
+	esq.order = append(esq.order, o...)
+	return esq
+}
+
+// First returns the first EmbeddingSpend entity from the query.
+// Returns a *NotFoundError when no EmbeddingSpend was found.
+func (esq *EmbeddingSpendQuery) First(ctx context.Context) (*EmbeddingSpend, error) {
+	nodes, err := esq.Limit(1).All(setContextOp(ctx, esq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{embeddingspend.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) FirstX(ctx context.Context) *EmbeddingSpend {
+	node, err := esq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmbeddingSpend ID from the query.
+// Returns a *NotFoundError when no EmbeddingSpend ID was found.
+func (esq *EmbeddingSpendQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = esq.Limit(1).IDs(setContextOp(ctx, esq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{embeddingspend.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := esq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmbeddingSpend entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmbeddingSpend entity is found.
+// Returns a *NotFoundError when no EmbeddingSpend entities are found.
+func (esq *EmbeddingSpendQuery) Only(ctx context.Context) (*EmbeddingSpend, error) {
+	nodes, err := esq.Limit(2).All(setContextOp(ctx, esq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{embeddingspend.Label}
+	default:
+		return nil, &NotSingularError{embeddingspend.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) OnlyX(ctx context.Context) *EmbeddingSpend {
+	node, err := esq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmbeddingSpend ID in the query.
+// Returns a *NotSingularError when more than one EmbeddingSpend ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (esq *EmbeddingSpendQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = esq.Limit(2).IDs(setContextOp(ctx, esq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{embeddingspend.Label}
+	default:
+		err = &NotSingularError{embeddingspend.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := esq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmbeddingSpends.
+func (esq *EmbeddingSpendQuery) All(ctx context.Context) ([]*EmbeddingSpend, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryAll)
+	if err := esq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmbeddingSpend, *EmbeddingSpendQuery]()
+	return withInterceptors[[]*EmbeddingSpend](ctx, esq, qr, esq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) AllX(ctx context.Context) []*EmbeddingSpend {
+	nodes, err := esq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmbeddingSpend IDs.
+func (esq *EmbeddingSpendQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if esq.ctx.Unique == nil && esq.path != nil {
+		esq.Unique(true)
+	}
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryIDs)
+	if err = esq.Select(embeddingspend.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := esq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (esq *EmbeddingSpendQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryCount)
+	if err := esq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, esq, querierCount[*EmbeddingSpendQuery](), esq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) CountX(ctx context.Context) int {
+	count, err := esq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (esq *EmbeddingSpendQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, esq.ctx, ent.OpQueryExist)
+	switch _, err := esq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (esq *EmbeddingSpendQuery) ExistX(ctx context.Context) bool {
+	exist, err := esq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmbeddingSpendQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (esq *EmbeddingSpendQuery) Clone() *EmbeddingSpendQuery {
+	if esq == nil {
+		return nil
+	}
+	return &EmbeddingSpendQuery{
+		config:     esq.config,
+		ctx:        esq.ctx.Clone(),
+		order:      append([]embeddingspend.OrderOption{}, esq.order...),
+		inters:     append([]Interceptor{}, esq.inters...),
+		predicates: append([]predicate.EmbeddingSpend{}, esq.predicates...),
+		// clone intermediate query.
+		sql:       esq.sql.Clone(),
+		path:      esq.path,
+		modifiers: append([]func(*sql.Selector){}, esq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Day time.Time `json:"day,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmbeddingSpend.Query().
+//		GroupBy(embeddingspend.FieldDay).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (esq *EmbeddingSpendQuery) GroupBy(field string, fields ...string) *EmbeddingSpendGroupBy {
+	esq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmbeddingSpendGroupBy{build: esq}
+	grbuild.flds = &esq.ctx.Fields
+	grbuild.label = embeddingspend.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Day time.Time `json:"day,omitempty"`
+//	}
+//
+//	client.EmbeddingSpend.Query().
+//		Select(embeddingspend.FieldDay).
+//		Scan(ctx, &v)
+func (esq *EmbeddingSpendQuery) Select(fields ...string) *EmbeddingSpendSelect {
+	esq.ctx.Fields = append(esq.ctx.Fields, fields...)
+	sbuild := &EmbeddingSpendSelect{EmbeddingSpendQuery: esq}
+	sbuild.label = embeddingspend.Label
+	sbuild.flds, sbuild.scan = &esq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmbeddingSpendSelect configured with the given aggregations.
+func (esq *EmbeddingSpendQuery) Aggregate(fns ...AggregateFunc) *EmbeddingSpendSelect {
+	return esq.Select().Aggregate(fns...)
+}
+
+func (esq *EmbeddingSpendQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range esq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, esq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range esq.ctx.Fields {
+		if !embeddingspend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if esq.path != nil {
+		prev, err := esq.path(ctx)
+		if err != nil {
+			return err
+		}
+		esq.sql = prev
+	}
+	return nil
+}
+
+func (esq *EmbeddingSpendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmbeddingSpend, error) {
+	var (
+		nodes = []*EmbeddingSpend{}
+		_spec = esq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmbeddingSpend).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmbeddingSpend{config: esq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(esq.modifiers) > 0 {
+		_spec.Modifiers = esq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, esq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (esq *EmbeddingSpendQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := esq.querySpec()
+	if len(esq.modifiers) > 0 {
+		_spec.Modifiers = esq.modifiers
+	}
+	_spec.Node.Columns = esq.ctx.Fields
+	if len(esq.ctx.Fields) > 0 {
+		_spec.Unique = esq.ctx.Unique != nil && *esq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, esq.driver, _spec)
+}
+
+func (esq *EmbeddingSpendQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(embeddingspend.Table, embeddingspend.Columns, sqlgraph.NewFieldSpec(embeddingspend.FieldID, field.TypeUUID))
+	_spec.From = esq.sql
+	if unique := esq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if esq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := esq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingspend.FieldID)
+		for i := range fields {
+			if fields[i] != embeddingspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := esq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := esq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := esq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := esq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (esq *EmbeddingSpendQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(esq.driver.Dialect())
+	t1 := builder.Table(embeddingspend.Table)
+	columns := esq.ctx.Fields
+	if len(columns) == 0 {
+		columns = embeddingspend.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if esq.sql != nil {
+		selector = esq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if esq.ctx.Unique != nil && *esq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range esq.modifiers {
+		m(selector)
+	}
+	for _, p := range esq.predicates {
+		p(selector)
+	}
+	for _, p := range esq.order {
+		p(selector)
+	}
+	if offset := esq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := esq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (esq *EmbeddingSpendQuery) Modify(modifiers ...func(s *sql.Selector)) *EmbeddingSpendSelect {
+	esq.modifiers = append(esq.modifiers, modifiers...)
+	return esq.Select()
+}
+
+// EmbeddingSpendGroupBy is the group-by builder for EmbeddingSpend entities.
+type EmbeddingSpendGroupBy struct {
+	selector
+	build *EmbeddingSpendQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (esgb *EmbeddingSpendGroupBy) Aggregate(fns ...AggregateFunc) *EmbeddingSpendGroupBy {
+	esgb.fns = append(esgb.fns, fns...)
+	return esgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (esgb *EmbeddingSpendGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, esgb.build.ctx, ent.OpQueryGroupBy)
+	if err := esgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmbeddingSpendQuery, *EmbeddingSpendGroupBy](ctx, esgb.build, esgb, esgb.build.inters, v)
+}
+
+func (esgb *EmbeddingSpendGroupBy) sqlScan(ctx context.Context, root *EmbeddingSpendQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(esgb.fns))
+	for _, fn := range esgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*esgb.flds)+len(esgb.fns))
+		for _, f := range *esgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*esgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := esgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmbeddingSpendSelect is the builder for selecting fields of EmbeddingSpend entities.
+type EmbeddingSpendSelect struct {
+	*EmbeddingSpendQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ess *EmbeddingSpendSelect) Aggregate(fns ...AggregateFunc) *EmbeddingSpendSelect {
+	ess.fns = append(ess.fns, fns...)
+	return ess
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ess *EmbeddingSpendSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ess.ctx, ent.OpQuerySelect)
+	if err := ess.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmbeddingSpendQuery, *EmbeddingSpendSelect](ctx, ess.EmbeddingSpendQuery, ess, ess.inters, v)
+}
+
+func (ess *EmbeddingSpendSelect) sqlScan(ctx context.Context, root *EmbeddingSpendQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ess.fns))
+	for _, fn := range ess.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ess.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ess.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ess *EmbeddingSpendSelect) Modify(modifiers ...func(s *sql.Selector)) *EmbeddingSpendSelect {
+	ess.modifiers = append(ess.modifiers, modifiers...)
+	return ess
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// EmbeddingSpendUpdate is the builder for updating EmbeddingSpend entities.
+type EmbeddingSpendUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmbeddingSpendMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmbeddingSpendUpdate builder.
+func (esu *EmbeddingSpendUpdate) Where(ps ...predicate.EmbeddingSpend) *EmbeddingSpendUpdate {
+	esu.mutation.Where(ps...)
+	return esu
+}
+
+// SetDay sets the "day" field.
+func (esu *EmbeddingSpendUpdate) SetDay(t time.Time) *EmbeddingSpendUpdate {
+	esu.mutation.SetDay(t)
+	return esu
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (esu *EmbeddingSpendUpdate) SetNillableDay(t *time.Time) *EmbeddingSpendUpdate {
+	if t != nil {
+		esu.SetDay(*t)
+	}
+	return esu
+}
+
+// SetProvider sets the "provider" field.
+func (esu *EmbeddingSpendUpdate) SetProvider(s string) *EmbeddingSpendUpdate {
+	esu.mutation.SetProvider(s)
+	return esu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (esu *EmbeddingSpendUpdate) SetNillableProvider(s *string) *EmbeddingSpendUpdate {
+	if s != nil {
+		esu.SetProvider(*s)
+	}
+	return esu
+}
+
+// SetSpent sets the "spent" field.
+func (esu *EmbeddingSpendUpdate) SetSpent(f float64) *EmbeddingSpendUpdate {
+	esu.mutation.ResetSpent()
+	esu.mutation.SetSpent(f)
+	return esu
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (esu *EmbeddingSpendUpdate) SetNillableSpent(f *float64) *EmbeddingSpendUpdate {
+	if f != nil {
+		esu.SetSpent(*f)
+	}
+	return esu
+}
+
+// AddSpent adds f to the "spent" field.
+func (esu *EmbeddingSpendUpdate) AddSpent(f float64) *EmbeddingSpendUpdate {
+	esu.mutation.AddSpent(f)
+	return esu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esu *EmbeddingSpendUpdate) SetUpdatedAt(t time.Time) *EmbeddingSpendUpdate {
+	esu.mutation.SetUpdatedAt(t)
+	return esu
+}
+
+// Mutation returns the EmbeddingSpendMutation object of the builder.
+func (esu *EmbeddingSpendUpdate) Mutation() *EmbeddingSpendMutation {
+	return esu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (esu *EmbeddingSpendUpdate) Save(ctx context.Context) (int, error) {
+	esu.defaults()
+	return withHooks(ctx, esu.sqlSave, esu.mutation, esu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esu *EmbeddingSpendUpdate) SaveX(ctx context.Context) int {
+	affected, err := esu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (esu *EmbeddingSpendUpdate) Exec(ctx context.Context) error {
+	_, err := esu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esu *EmbeddingSpendUpdate) ExecX(ctx context.Context) {
+	if err := esu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esu *EmbeddingSpendUpdate) defaults() {
+	if _, ok := esu.mutation.UpdatedAt(); !ok {
+		v := embeddingspend.UpdateDefaultUpdatedAt()
+		esu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (esu *EmbeddingSpendUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmbeddingSpendUpdate {
+	esu.modifiers = append(esu.modifiers, modifiers...)
+	return esu
+}
+
+func (esu *EmbeddingSpendUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingspend.Table, embeddingspend.Columns, sqlgraph.NewFieldSpec(embeddingspend.FieldID, field.TypeUUID))
+	if ps := esu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esu.mutation.Day(); ok {
+		_spec.SetField(embeddingspend.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := esu.mutation.Provider(); ok {
+		_spec.SetField(embeddingspend.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Spent(); ok {
+		_spec.SetField(embeddingspend.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := esu.mutation.AddedSpent(); ok {
+		_spec.AddField(embeddingspend.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := esu.mutation.UpdatedAt(); ok {
+		_spec.SetField(embeddingspend.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(esu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, esu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	esu.mutation.done = true
+	return n, nil
+}
+
+// EmbeddingSpendUpdateOne is the builder for updating a single EmbeddingSpend entity.
+type EmbeddingSpendUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmbeddingSpendMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDay sets the "day" field.
+func (esuo *EmbeddingSpendUpdateOne) SetDay(t time.Time) *EmbeddingSpendUpdateOne {
+	esuo.mutation.SetDay(t)
+	return esuo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (esuo *EmbeddingSpendUpdateOne) SetNillableDay(t *time.Time) *EmbeddingSpendUpdateOne {
+	if t != nil {
+		esuo.SetDay(*t)
+	}
+	return esuo
+}
+
+// SetProvider sets the "provider" field.
+func (esuo *EmbeddingSpendUpdateOne) SetProvider(s string) *EmbeddingSpendUpdateOne {
+	esuo.mutation.SetProvider(s)
+	return esuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (esuo *EmbeddingSpendUpdateOne) SetNillableProvider(s *string) *EmbeddingSpendUpdateOne {
+	if s != nil {
+		esuo.SetProvider(*s)
+	}
+	return esuo
+}
+
+// SetSpent sets the "spent" field.
+func (esuo *EmbeddingSpendUpdateOne) SetSpent(f float64) *EmbeddingSpendUpdateOne {
+	esuo.mutation.ResetSpent()
+	esuo.mutation.SetSpent(f)
+	return esuo
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (esuo *EmbeddingSpendUpdateOne) SetNillableSpent(f *float64) *EmbeddingSpendUpdateOne {
+	if f != nil {
+		esuo.SetSpent(*f)
+	}
+	return esuo
+}
+
+// AddSpent adds f to the "spent" field.
+func (esuo *EmbeddingSpendUpdateOne) AddSpent(f float64) *EmbeddingSpendUpdateOne {
+	esuo.mutation.AddSpent(f)
+	return esuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esuo *EmbeddingSpendUpdateOne) SetUpdatedAt(t time.Time) *EmbeddingSpendUpdateOne {
+	esuo.mutation.SetUpdatedAt(t)
+	return esuo
+}
+
+// Mutation returns the EmbeddingSpendMutation object of the builder.
+func (esuo *EmbeddingSpendUpdateOne) Mutation() *EmbeddingSpendMutation {
+	return esuo.mutation
+}
+
+// Where appends a list predicates to the EmbeddingSpendUpdate builder.
+func (esuo *EmbeddingSpendUpdateOne) Where(ps ...predicate.EmbeddingSpend) *EmbeddingSpendUpdateOne {
+	esuo.mutation.Where(ps...)
+	return esuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (esuo *EmbeddingSpendUpdateOne) Select(field string, fields ...string) *EmbeddingSpendUpdateOne {
+	esuo.fields = append([]string{field}, fields...)
+	return esuo
+}
+
+// Save executes the query and returns the updated EmbeddingSpend entity.
+func (esuo *EmbeddingSpendUpdateOne) Save(ctx context.Context) (*EmbeddingSpend, error) {
+	esuo.defaults()
+	return withHooks(ctx, esuo.sqlSave, esuo.mutation, esuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esuo *EmbeddingSpendUpdateOne) SaveX(ctx context.Context) *EmbeddingSpend {
+	node, err := esuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (esuo *EmbeddingSpendUpdateOne) Exec(ctx context.Context) error {
+	_, err := esuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esuo *EmbeddingSpendUpdateOne) ExecX(ctx context.Context) {
+	if err := esuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esuo *EmbeddingSpendUpdateOne) defaults() {
+	if _, ok := esuo.mutation.UpdatedAt(); !ok {
+		v := embeddingspend.UpdateDefaultUpdatedAt()
+		esuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (esuo *EmbeddingSpendUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmbeddingSpendUpdateOne {
+	esuo.modifiers = append(esuo.modifiers, modifiers...)
+	return esuo
+}
+
+func (esuo *EmbeddingSpendUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingSpend, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingspend.Table, embeddingspend.Columns, sqlgraph.NewFieldSpec(embeddingspend.FieldID, field.TypeUUID))
+	id, ok := esuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingSpend.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := esuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingspend.FieldID)
+		for _, f := range fields {
+			if !embeddingspend.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := esuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esuo.mutation.Day(); ok {
+		_spec.SetField(embeddingspend.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := esuo.mutation.Provider(); ok {
+		_spec.SetField(embeddingspend.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Spent(); ok {
+		_spec.SetField(embeddingspend.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := esuo.mutation.AddedSpent(); ok {
+		_spec.AddField(embeddingspend.FieldSpent, field.TypeFloat64, value)
+	}
+	if value, ok := esuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(embeddingspend.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(esuo.modifiers...)
+	_node = &EmbeddingSpend{config: esuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, esuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	esuo.mutation.done = true
+	return _node, nil
+}
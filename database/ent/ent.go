@@ -13,7 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/database/ent/setting"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +83,17 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			dialog.Table:  dialog.ValidColumn,
-			message.Table: message.ValidColumn,
+			dialog.Table:               dialog.ValidColumn,
+			embeddingcache.Table:       embeddingcache.ValidColumn,
+			embeddingspend.Table:       embeddingspend.ValidColumn,
+			message.Table:              message.ValidColumn,
+			messagechunk.Table:         messagechunk.ValidColumn,
+			messagerevision.Table:      messagerevision.ValidColumn,
+			nextembedding.Table:        nextembedding.ValidColumn,
+			nextembeddingfailure.Table: nextembeddingfailure.ValidColumn,
+			observationstate.Table:     observationstate.ValidColumn,
+			sender.Table:               sender.ValidColumn,
+			setting.Table:              setting.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
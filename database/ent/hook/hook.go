@@ -21,6 +21,30 @@ func (f DialogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DialogMutation", m)
 }
 
+// The EmbeddingCacheFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingCache mutator.
+type EmbeddingCacheFunc func(context.Context, *ent.EmbeddingCacheMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingCacheFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingCacheMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingCacheMutation", m)
+}
+
+// The EmbeddingSpendFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingSpend mutator.
+type EmbeddingSpendFunc func(context.Context, *ent.EmbeddingSpendMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingSpendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingSpendMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingSpendMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *ent.MessageMutation) (ent.Value, error)
@@ -33,6 +57,90 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The MessageChunkFunc type is an adapter to allow the use of ordinary
+// function as MessageChunk mutator.
+type MessageChunkFunc func(context.Context, *ent.MessageChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageChunkMutation", m)
+}
+
+// The MessageRevisionFunc type is an adapter to allow the use of ordinary
+// function as MessageRevision mutator.
+type MessageRevisionFunc func(context.Context, *ent.MessageRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageRevisionMutation", m)
+}
+
+// The NextEmbeddingFunc type is an adapter to allow the use of ordinary
+// function as NextEmbedding mutator.
+type NextEmbeddingFunc func(context.Context, *ent.NextEmbeddingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NextEmbeddingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NextEmbeddingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NextEmbeddingMutation", m)
+}
+
+// The NextEmbeddingFailureFunc type is an adapter to allow the use of ordinary
+// function as NextEmbeddingFailure mutator.
+type NextEmbeddingFailureFunc func(context.Context, *ent.NextEmbeddingFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NextEmbeddingFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NextEmbeddingFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NextEmbeddingFailureMutation", m)
+}
+
+// The ObservationStateFunc type is an adapter to allow the use of ordinary
+// function as ObservationState mutator.
+type ObservationStateFunc func(context.Context, *ent.ObservationStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ObservationStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ObservationStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ObservationStateMutation", m)
+}
+
+// The SenderFunc type is an adapter to allow the use of ordinary
+// function as Sender mutator.
+type SenderFunc func(context.Context, *ent.SenderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SenderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SenderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderMutation", m)
+}
+
+// The SettingFunc type is an adapter to allow the use of ordinary
+// function as Setting mutator.
+type SettingFunc func(context.Context, *ent.SettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -14,6 +14,7 @@ import (
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/sender"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -26,16 +27,56 @@ type Message struct {
 	MsgID int `json:"msg_id,omitempty"`
 	// DialogID holds the value of the "dialog_id" field.
 	DialogID int64 `json:"dialog_id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID *int64 `json:"sender_id,omitempty"`
+	// ReplyToMsgID holds the value of the "reply_to_msg_id" field.
+	ReplyToMsgID *int `json:"reply_to_msg_id,omitempty"`
+	// TopMsgID holds the value of the "top_msg_id" field.
+	TopMsgID *int `json:"top_msg_id,omitempty"`
+	// TopicID holds the value of the "topic_id" field.
+	TopicID *int `json:"topic_id,omitempty"`
+	// FwdFromID holds the value of the "fwd_from_id" field.
+	FwdFromID *int64 `json:"fwd_from_id,omitempty"`
+	// FwdFromName holds the value of the "fwd_from_name" field.
+	FwdFromName *string `json:"fwd_from_name,omitempty"`
+	// FwdFromMsgID holds the value of the "fwd_from_msg_id" field.
+	FwdFromMsgID *int `json:"fwd_from_msg_id,omitempty"`
+	// FwdFromDate holds the value of the "fwd_from_date" field.
+	FwdFromDate *time.Time `json:"fwd_from_date,omitempty"`
+	// FwdPostAuthor holds the value of the "fwd_post_author" field.
+	FwdPostAuthor *string `json:"fwd_post_author,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// DerivedText holds the value of the "derived_text" field.
+	DerivedText string `json:"derived_text,omitempty"`
 	// TextEmbedding holds the value of the "text_embedding" field.
 	TextEmbedding pgvector.Vector `json:"text_embedding,omitempty"`
+	// EmbeddingStrategy holds the value of the "embedding_strategy" field.
+	EmbeddingStrategy *types.EmbeddingStrategy `json:"embedding_strategy,omitempty"`
+	// EmbeddingModel holds the value of the "embedding_model" field.
+	EmbeddingModel *string `json:"embedding_model,omitempty"`
+	// NextEmbeddingModel holds the value of the "next_embedding_model" field.
+	NextEmbeddingModel *string `json:"next_embedding_model,omitempty"`
+	// EmbeddingAttempts holds the value of the "embedding_attempts" field.
+	EmbeddingAttempts int `json:"embedding_attempts,omitempty"`
+	// EmbeddingError holds the value of the "embedding_error" field.
+	EmbeddingError *string `json:"embedding_error,omitempty"`
+	// EmbeddingRetryAt holds the value of the "embedding_retry_at" field.
+	EmbeddingRetryAt *time.Time `json:"embedding_retry_at,omitempty"`
+	// EmbeddingFailedAt holds the value of the "embedding_failed_at" field.
+	EmbeddingFailedAt *time.Time `json:"embedding_failed_at,omitempty"`
+	// EmbeddingSkipped holds the value of the "embedding_skipped" field.
+	EmbeddingSkipped bool `json:"embedding_skipped,omitempty"`
 	// HasMedia holds the value of the "has_media" field.
 	HasMedia bool `json:"has_media,omitempty"`
 	// MediaInfo holds the value of the "media_info" field.
 	MediaInfo *types.MediaInfo `json:"media_info,omitempty"`
 	// SentAt holds the value of the "sent_at" field.
 	SentAt time.Time `json:"sent_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MessageQuery when eager-loading is set.
 	Edges        MessageEdges `json:"edges"`
@@ -46,9 +87,19 @@ type Message struct {
 type MessageEdges struct {
 	// Dialog holds the value of the dialog edge.
 	Dialog *Dialog `json:"dialog,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *Sender `json:"sender,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Chunks holds the value of the chunks edge.
+	Chunks []*MessageChunk `json:"chunks,omitempty"`
+	// NextEmbeddings holds the value of the next_embeddings edge.
+	NextEmbeddings []*NextEmbedding `json:"next_embeddings,omitempty"`
+	// NextEmbeddingFailures holds the value of the next_embedding_failures edge.
+	NextEmbeddingFailures []*NextEmbeddingFailure `json:"next_embedding_failures,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // DialogOrErr returns the Dialog value or an error if the edge
@@ -62,6 +113,53 @@ func (e MessageEdges) DialogOrErr() (*Dialog, error) {
 	return nil, &NotLoadedError{edge: "dialog"}
 }
 
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) SenderOrErr() (*Sender, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: sender.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// ChunksOrErr returns the Chunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ChunksOrErr() ([]*MessageChunk, error) {
+	if e.loadedTypes[3] {
+		return e.Chunks, nil
+	}
+	return nil, &NotLoadedError{edge: "chunks"}
+}
+
+// NextEmbeddingsOrErr returns the NextEmbeddings value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) NextEmbeddingsOrErr() ([]*NextEmbedding, error) {
+	if e.loadedTypes[4] {
+		return e.NextEmbeddings, nil
+	}
+	return nil, &NotLoadedError{edge: "next_embeddings"}
+}
+
+// NextEmbeddingFailuresOrErr returns the NextEmbeddingFailures value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) NextEmbeddingFailuresOrErr() ([]*NextEmbeddingFailure, error) {
+	if e.loadedTypes[5] {
+		return e.NextEmbeddingFailures, nil
+	}
+	return nil, &NotLoadedError{edge: "next_embedding_failures"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -71,13 +169,13 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case message.FieldTextEmbedding:
 			values[i] = new(pgvector.Vector)
-		case message.FieldHasMedia:
+		case message.FieldEmbeddingSkipped, message.FieldHasMedia:
 			values[i] = new(sql.NullBool)
-		case message.FieldMsgID, message.FieldDialogID:
+		case message.FieldMsgID, message.FieldDialogID, message.FieldSenderID, message.FieldReplyToMsgID, message.FieldTopMsgID, message.FieldTopicID, message.FieldFwdFromID, message.FieldFwdFromMsgID, message.FieldEmbeddingAttempts:
 			values[i] = new(sql.NullInt64)
-		case message.FieldText:
+		case message.FieldFwdFromName, message.FieldFwdPostAuthor, message.FieldText, message.FieldDerivedText, message.FieldEmbeddingStrategy, message.FieldEmbeddingModel, message.FieldNextEmbeddingModel, message.FieldEmbeddingError:
 			values[i] = new(sql.NullString)
-		case message.FieldSentAt:
+		case message.FieldFwdFromDate, message.FieldEmbeddingRetryAt, message.FieldEmbeddingFailedAt, message.FieldSentAt, message.FieldEditedAt, message.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldID:
 			values[i] = new(uuid.UUID)
@@ -114,18 +212,141 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.DialogID = value.Int64
 			}
+		case message.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				m.SenderID = new(int64)
+				*m.SenderID = value.Int64
+			}
+		case message.FieldReplyToMsgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_msg_id", values[i])
+			} else if value.Valid {
+				m.ReplyToMsgID = new(int)
+				*m.ReplyToMsgID = int(value.Int64)
+			}
+		case message.FieldTopMsgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field top_msg_id", values[i])
+			} else if value.Valid {
+				m.TopMsgID = new(int)
+				*m.TopMsgID = int(value.Int64)
+			}
+		case message.FieldTopicID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field topic_id", values[i])
+			} else if value.Valid {
+				m.TopicID = new(int)
+				*m.TopicID = int(value.Int64)
+			}
+		case message.FieldFwdFromID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fwd_from_id", values[i])
+			} else if value.Valid {
+				m.FwdFromID = new(int64)
+				*m.FwdFromID = value.Int64
+			}
+		case message.FieldFwdFromName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fwd_from_name", values[i])
+			} else if value.Valid {
+				m.FwdFromName = new(string)
+				*m.FwdFromName = value.String
+			}
+		case message.FieldFwdFromMsgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fwd_from_msg_id", values[i])
+			} else if value.Valid {
+				m.FwdFromMsgID = new(int)
+				*m.FwdFromMsgID = int(value.Int64)
+			}
+		case message.FieldFwdFromDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fwd_from_date", values[i])
+			} else if value.Valid {
+				m.FwdFromDate = new(time.Time)
+				*m.FwdFromDate = value.Time
+			}
+		case message.FieldFwdPostAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fwd_post_author", values[i])
+			} else if value.Valid {
+				m.FwdPostAuthor = new(string)
+				*m.FwdPostAuthor = value.String
+			}
 		case message.FieldText:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field text", values[i])
 			} else if value.Valid {
 				m.Text = value.String
 			}
+		case message.FieldDerivedText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field derived_text", values[i])
+			} else if value.Valid {
+				m.DerivedText = value.String
+			}
 		case message.FieldTextEmbedding:
 			if value, ok := values[i].(*pgvector.Vector); !ok {
 				return fmt.Errorf("unexpected type %T for field text_embedding", values[i])
 			} else if value != nil {
 				m.TextEmbedding = *value
 			}
+		case message.FieldEmbeddingStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_strategy", values[i])
+			} else if value.Valid {
+				m.EmbeddingStrategy = new(types.EmbeddingStrategy)
+				*m.EmbeddingStrategy = types.EmbeddingStrategy(value.String)
+			}
+		case message.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				m.EmbeddingModel = new(string)
+				*m.EmbeddingModel = value.String
+			}
+		case message.FieldNextEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field next_embedding_model", values[i])
+			} else if value.Valid {
+				m.NextEmbeddingModel = new(string)
+				*m.NextEmbeddingModel = value.String
+			}
+		case message.FieldEmbeddingAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_attempts", values[i])
+			} else if value.Valid {
+				m.EmbeddingAttempts = int(value.Int64)
+			}
+		case message.FieldEmbeddingError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_error", values[i])
+			} else if value.Valid {
+				m.EmbeddingError = new(string)
+				*m.EmbeddingError = value.String
+			}
+		case message.FieldEmbeddingRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_retry_at", values[i])
+			} else if value.Valid {
+				m.EmbeddingRetryAt = new(time.Time)
+				*m.EmbeddingRetryAt = value.Time
+			}
+		case message.FieldEmbeddingFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_failed_at", values[i])
+			} else if value.Valid {
+				m.EmbeddingFailedAt = new(time.Time)
+				*m.EmbeddingFailedAt = value.Time
+			}
+		case message.FieldEmbeddingSkipped:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_skipped", values[i])
+			} else if value.Valid {
+				m.EmbeddingSkipped = value.Bool
+			}
 		case message.FieldHasMedia:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field has_media", values[i])
@@ -146,6 +367,20 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.SentAt = value.Time
 			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				m.EditedAt = new(time.Time)
+				*m.EditedAt = value.Time
+			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = new(time.Time)
+				*m.DeletedAt = value.Time
+			}
 		default:
 			m.selectValues.Set(columns[i], values[i])
 		}
@@ -164,6 +399,31 @@ func (m *Message) QueryDialog() *DialogQuery {
 	return NewMessageClient(m.config).QueryDialog(m)
 }
 
+// QuerySender queries the "sender" edge of the Message entity.
+func (m *Message) QuerySender() *SenderQuery {
+	return NewMessageClient(m.config).QuerySender(m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(m.config).QueryRevisions(m)
+}
+
+// QueryChunks queries the "chunks" edge of the Message entity.
+func (m *Message) QueryChunks() *MessageChunkQuery {
+	return NewMessageClient(m.config).QueryChunks(m)
+}
+
+// QueryNextEmbeddings queries the "next_embeddings" edge of the Message entity.
+func (m *Message) QueryNextEmbeddings() *NextEmbeddingQuery {
+	return NewMessageClient(m.config).QueryNextEmbeddings(m)
+}
+
+// QueryNextEmbeddingFailures queries the "next_embedding_failures" edge of the Message entity.
+func (m *Message) QueryNextEmbeddingFailures() *NextEmbeddingFailureQuery {
+	return NewMessageClient(m.config).QueryNextEmbeddingFailures(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,12 +453,96 @@ func (m *Message) String() string {
 	builder.WriteString("dialog_id=")
 	builder.WriteString(fmt.Sprintf("%v", m.DialogID))
 	builder.WriteString(", ")
+	if v := m.SenderID; v != nil {
+		builder.WriteString("sender_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.ReplyToMsgID; v != nil {
+		builder.WriteString("reply_to_msg_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.TopMsgID; v != nil {
+		builder.WriteString("top_msg_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.TopicID; v != nil {
+		builder.WriteString("topic_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.FwdFromID; v != nil {
+		builder.WriteString("fwd_from_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.FwdFromName; v != nil {
+		builder.WriteString("fwd_from_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.FwdFromMsgID; v != nil {
+		builder.WriteString("fwd_from_msg_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.FwdFromDate; v != nil {
+		builder.WriteString("fwd_from_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.FwdPostAuthor; v != nil {
+		builder.WriteString("fwd_post_author=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("text=")
 	builder.WriteString(m.Text)
 	builder.WriteString(", ")
+	builder.WriteString("derived_text=")
+	builder.WriteString(m.DerivedText)
+	builder.WriteString(", ")
 	builder.WriteString("text_embedding=")
 	builder.WriteString(fmt.Sprintf("%v", m.TextEmbedding))
 	builder.WriteString(", ")
+	if v := m.EmbeddingStrategy; v != nil {
+		builder.WriteString("embedding_strategy=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := m.EmbeddingModel; v != nil {
+		builder.WriteString("embedding_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.NextEmbeddingModel; v != nil {
+		builder.WriteString("next_embedding_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("embedding_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", m.EmbeddingAttempts))
+	builder.WriteString(", ")
+	if v := m.EmbeddingError; v != nil {
+		builder.WriteString("embedding_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := m.EmbeddingRetryAt; v != nil {
+		builder.WriteString("embedding_retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.EmbeddingFailedAt; v != nil {
+		builder.WriteString("embedding_failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("embedding_skipped=")
+	builder.WriteString(fmt.Sprintf("%v", m.EmbeddingSkipped))
+	builder.WriteString(", ")
 	builder.WriteString("has_media=")
 	builder.WriteString(fmt.Sprintf("%v", m.HasMedia))
 	builder.WriteString(", ")
@@ -207,6 +551,16 @@ func (m *Message) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sent_at=")
 	builder.WriteString(m.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := m.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
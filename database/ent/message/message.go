@@ -3,9 +3,12 @@
 package message
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/types"
 )
 
 const (
@@ -17,18 +20,68 @@ const (
 	FieldMsgID = "msg_id"
 	// FieldDialogID holds the string denoting the dialog_id field in the database.
 	FieldDialogID = "dialog_id"
+	// FieldSenderID holds the string denoting the sender_id field in the database.
+	FieldSenderID = "sender_id"
+	// FieldReplyToMsgID holds the string denoting the reply_to_msg_id field in the database.
+	FieldReplyToMsgID = "reply_to_msg_id"
+	// FieldTopMsgID holds the string denoting the top_msg_id field in the database.
+	FieldTopMsgID = "top_msg_id"
+	// FieldTopicID holds the string denoting the topic_id field in the database.
+	FieldTopicID = "topic_id"
+	// FieldFwdFromID holds the string denoting the fwd_from_id field in the database.
+	FieldFwdFromID = "fwd_from_id"
+	// FieldFwdFromName holds the string denoting the fwd_from_name field in the database.
+	FieldFwdFromName = "fwd_from_name"
+	// FieldFwdFromMsgID holds the string denoting the fwd_from_msg_id field in the database.
+	FieldFwdFromMsgID = "fwd_from_msg_id"
+	// FieldFwdFromDate holds the string denoting the fwd_from_date field in the database.
+	FieldFwdFromDate = "fwd_from_date"
+	// FieldFwdPostAuthor holds the string denoting the fwd_post_author field in the database.
+	FieldFwdPostAuthor = "fwd_post_author"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldDerivedText holds the string denoting the derived_text field in the database.
+	FieldDerivedText = "derived_text"
 	// FieldTextEmbedding holds the string denoting the text_embedding field in the database.
 	FieldTextEmbedding = "text_embedding"
+	// FieldEmbeddingStrategy holds the string denoting the embedding_strategy field in the database.
+	FieldEmbeddingStrategy = "embedding_strategy"
+	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
+	FieldEmbeddingModel = "embedding_model"
+	// FieldNextEmbeddingModel holds the string denoting the next_embedding_model field in the database.
+	FieldNextEmbeddingModel = "next_embedding_model"
+	// FieldEmbeddingAttempts holds the string denoting the embedding_attempts field in the database.
+	FieldEmbeddingAttempts = "embedding_attempts"
+	// FieldEmbeddingError holds the string denoting the embedding_error field in the database.
+	FieldEmbeddingError = "embedding_error"
+	// FieldEmbeddingRetryAt holds the string denoting the embedding_retry_at field in the database.
+	FieldEmbeddingRetryAt = "embedding_retry_at"
+	// FieldEmbeddingFailedAt holds the string denoting the embedding_failed_at field in the database.
+	FieldEmbeddingFailedAt = "embedding_failed_at"
+	// FieldEmbeddingSkipped holds the string denoting the embedding_skipped field in the database.
+	FieldEmbeddingSkipped = "embedding_skipped"
 	// FieldHasMedia holds the string denoting the has_media field in the database.
 	FieldHasMedia = "has_media"
 	// FieldMediaInfo holds the string denoting the media_info field in the database.
 	FieldMediaInfo = "media_info"
 	// FieldSentAt holds the string denoting the sent_at field in the database.
 	FieldSentAt = "sent_at"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeDialog holds the string denoting the dialog edge name in mutations.
 	EdgeDialog = "dialog"
+	// EdgeSender holds the string denoting the sender edge name in mutations.
+	EdgeSender = "sender"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeChunks holds the string denoting the chunks edge name in mutations.
+	EdgeChunks = "chunks"
+	// EdgeNextEmbeddings holds the string denoting the next_embeddings edge name in mutations.
+	EdgeNextEmbeddings = "next_embeddings"
+	// EdgeNextEmbeddingFailures holds the string denoting the next_embedding_failures edge name in mutations.
+	EdgeNextEmbeddingFailures = "next_embedding_failures"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 	// DialogTable is the table that holds the dialog relation/edge.
@@ -38,6 +91,41 @@ const (
 	DialogInverseTable = "dialogs"
 	// DialogColumn is the table column denoting the dialog relation/edge.
 	DialogColumn = "dialog_id"
+	// SenderTable is the table that holds the sender relation/edge.
+	SenderTable = "messages"
+	// SenderInverseTable is the table name for the Sender entity.
+	// It exists in this package in order to avoid circular dependency with the "sender" package.
+	SenderInverseTable = "senders"
+	// SenderColumn is the table column denoting the sender relation/edge.
+	SenderColumn = "sender_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "message_revisions"
+	// RevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	RevisionsInverseTable = "message_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "message_id"
+	// ChunksTable is the table that holds the chunks relation/edge.
+	ChunksTable = "message_chunks"
+	// ChunksInverseTable is the table name for the MessageChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "messagechunk" package.
+	ChunksInverseTable = "message_chunks"
+	// ChunksColumn is the table column denoting the chunks relation/edge.
+	ChunksColumn = "message_id"
+	// NextEmbeddingsTable is the table that holds the next_embeddings relation/edge.
+	NextEmbeddingsTable = "next_embeddings"
+	// NextEmbeddingsInverseTable is the table name for the NextEmbedding entity.
+	// It exists in this package in order to avoid circular dependency with the "nextembedding" package.
+	NextEmbeddingsInverseTable = "next_embeddings"
+	// NextEmbeddingsColumn is the table column denoting the next_embeddings relation/edge.
+	NextEmbeddingsColumn = "message_id"
+	// NextEmbeddingFailuresTable is the table that holds the next_embedding_failures relation/edge.
+	NextEmbeddingFailuresTable = "next_embedding_failures"
+	// NextEmbeddingFailuresInverseTable is the table name for the NextEmbeddingFailure entity.
+	// It exists in this package in order to avoid circular dependency with the "nextembeddingfailure" package.
+	NextEmbeddingFailuresInverseTable = "next_embedding_failures"
+	// NextEmbeddingFailuresColumn is the table column denoting the next_embedding_failures relation/edge.
+	NextEmbeddingFailuresColumn = "message_id"
 )
 
 // Columns holds all SQL columns for message fields.
@@ -45,11 +133,31 @@ var Columns = []string{
 	FieldID,
 	FieldMsgID,
 	FieldDialogID,
+	FieldSenderID,
+	FieldReplyToMsgID,
+	FieldTopMsgID,
+	FieldTopicID,
+	FieldFwdFromID,
+	FieldFwdFromName,
+	FieldFwdFromMsgID,
+	FieldFwdFromDate,
+	FieldFwdPostAuthor,
 	FieldText,
+	FieldDerivedText,
 	FieldTextEmbedding,
+	FieldEmbeddingStrategy,
+	FieldEmbeddingModel,
+	FieldNextEmbeddingModel,
+	FieldEmbeddingAttempts,
+	FieldEmbeddingError,
+	FieldEmbeddingRetryAt,
+	FieldEmbeddingFailedAt,
+	FieldEmbeddingSkipped,
 	FieldHasMedia,
 	FieldMediaInfo,
 	FieldSentAt,
+	FieldEditedAt,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,10 +171,26 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultDerivedText holds the default value on creation for the "derived_text" field.
+	DefaultDerivedText string
+	// DefaultEmbeddingAttempts holds the default value on creation for the "embedding_attempts" field.
+	DefaultEmbeddingAttempts int
+	// DefaultEmbeddingSkipped holds the default value on creation for the "embedding_skipped" field.
+	DefaultEmbeddingSkipped bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// EmbeddingStrategyValidator is a validator for the "embedding_strategy" field enum values. It is called by the builders before save.
+func EmbeddingStrategyValidator(es types.EmbeddingStrategy) error {
+	switch es {
+	case "message", "preceding", "reply":
+		return nil
+	default:
+		return fmt.Errorf("message: invalid enum value for embedding_strategy field: %q", es)
+	}
+}
+
 // OrderOption defines the ordering options for the Message queries.
 type OrderOption func(*sql.Selector)
 
@@ -85,16 +209,106 @@ func ByDialogID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDialogID, opts...).ToFunc()
 }
 
+// BySenderID orders the results by the sender_id field.
+func BySenderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderID, opts...).ToFunc()
+}
+
+// ByReplyToMsgID orders the results by the reply_to_msg_id field.
+func ByReplyToMsgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToMsgID, opts...).ToFunc()
+}
+
+// ByTopMsgID orders the results by the top_msg_id field.
+func ByTopMsgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopMsgID, opts...).ToFunc()
+}
+
+// ByTopicID orders the results by the topic_id field.
+func ByTopicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopicID, opts...).ToFunc()
+}
+
+// ByFwdFromID orders the results by the fwd_from_id field.
+func ByFwdFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFwdFromID, opts...).ToFunc()
+}
+
+// ByFwdFromName orders the results by the fwd_from_name field.
+func ByFwdFromName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFwdFromName, opts...).ToFunc()
+}
+
+// ByFwdFromMsgID orders the results by the fwd_from_msg_id field.
+func ByFwdFromMsgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFwdFromMsgID, opts...).ToFunc()
+}
+
+// ByFwdFromDate orders the results by the fwd_from_date field.
+func ByFwdFromDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFwdFromDate, opts...).ToFunc()
+}
+
+// ByFwdPostAuthor orders the results by the fwd_post_author field.
+func ByFwdPostAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFwdPostAuthor, opts...).ToFunc()
+}
+
 // ByText orders the results by the text field.
 func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
 }
 
+// ByDerivedText orders the results by the derived_text field.
+func ByDerivedText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDerivedText, opts...).ToFunc()
+}
+
 // ByTextEmbedding orders the results by the text_embedding field.
 func ByTextEmbedding(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTextEmbedding, opts...).ToFunc()
 }
 
+// ByEmbeddingStrategy orders the results by the embedding_strategy field.
+func ByEmbeddingStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingStrategy, opts...).ToFunc()
+}
+
+// ByEmbeddingModel orders the results by the embedding_model field.
+func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
+}
+
+// ByNextEmbeddingModel orders the results by the next_embedding_model field.
+func ByNextEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextEmbeddingModel, opts...).ToFunc()
+}
+
+// ByEmbeddingAttempts orders the results by the embedding_attempts field.
+func ByEmbeddingAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingAttempts, opts...).ToFunc()
+}
+
+// ByEmbeddingError orders the results by the embedding_error field.
+func ByEmbeddingError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingError, opts...).ToFunc()
+}
+
+// ByEmbeddingRetryAt orders the results by the embedding_retry_at field.
+func ByEmbeddingRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingRetryAt, opts...).ToFunc()
+}
+
+// ByEmbeddingFailedAt orders the results by the embedding_failed_at field.
+func ByEmbeddingFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingFailedAt, opts...).ToFunc()
+}
+
+// ByEmbeddingSkipped orders the results by the embedding_skipped field.
+func ByEmbeddingSkipped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingSkipped, opts...).ToFunc()
+}
+
 // ByHasMedia orders the results by the has_media field.
 func ByHasMedia(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHasMedia, opts...).ToFunc()
@@ -105,12 +319,85 @@ func BySentAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
 }
 
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByDialogField orders the results by dialog field.
 func ByDialogField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newDialogStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySenderField orders the results by sender field.
+func BySenderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChunksCount orders the results by chunks count.
+func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChunksStep(), opts...)
+	}
+}
+
+// ByChunks orders the results by chunks terms.
+func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNextEmbeddingsCount orders the results by next_embeddings count.
+func ByNextEmbeddingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNextEmbeddingsStep(), opts...)
+	}
+}
+
+// ByNextEmbeddings orders the results by next_embeddings terms.
+func ByNextEmbeddings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNextEmbeddingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNextEmbeddingFailuresCount orders the results by next_embedding_failures count.
+func ByNextEmbeddingFailuresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNextEmbeddingFailuresStep(), opts...)
+	}
+}
+
+// ByNextEmbeddingFailures orders the results by next_embedding_failures terms.
+func ByNextEmbeddingFailures(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNextEmbeddingFailuresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDialogStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -118,3 +405,38 @@ func newDialogStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, DialogTable, DialogColumn),
 	)
 }
+func newSenderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+	)
+}
+func newNextEmbeddingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NextEmbeddingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NextEmbeddingsTable, NextEmbeddingsColumn),
+	)
+}
+func newNextEmbeddingFailuresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NextEmbeddingFailuresInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NextEmbeddingFailuresTable, NextEmbeddingFailuresColumn),
+	)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/types"
 )
 
 // ID filters vertices based on their ID field.
@@ -67,16 +68,101 @@ func DialogID(v int64) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldDialogID, v))
 }
 
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderID, v))
+}
+
+// ReplyToMsgID applies equality check predicate on the "reply_to_msg_id" field. It's identical to ReplyToMsgIDEQ.
+func ReplyToMsgID(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMsgID, v))
+}
+
+// TopMsgID applies equality check predicate on the "top_msg_id" field. It's identical to TopMsgIDEQ.
+func TopMsgID(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTopMsgID, v))
+}
+
+// TopicID applies equality check predicate on the "topic_id" field. It's identical to TopicIDEQ.
+func TopicID(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTopicID, v))
+}
+
+// FwdFromID applies equality check predicate on the "fwd_from_id" field. It's identical to FwdFromIDEQ.
+func FwdFromID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromID, v))
+}
+
+// FwdFromName applies equality check predicate on the "fwd_from_name" field. It's identical to FwdFromNameEQ.
+func FwdFromName(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromName, v))
+}
+
+// FwdFromMsgID applies equality check predicate on the "fwd_from_msg_id" field. It's identical to FwdFromMsgIDEQ.
+func FwdFromMsgID(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromMsgID, v))
+}
+
+// FwdFromDate applies equality check predicate on the "fwd_from_date" field. It's identical to FwdFromDateEQ.
+func FwdFromDate(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromDate, v))
+}
+
+// FwdPostAuthor applies equality check predicate on the "fwd_post_author" field. It's identical to FwdPostAuthorEQ.
+func FwdPostAuthor(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdPostAuthor, v))
+}
+
 // Text applies equality check predicate on the "text" field. It's identical to TextEQ.
 func Text(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldText, v))
 }
 
+// DerivedText applies equality check predicate on the "derived_text" field. It's identical to DerivedTextEQ.
+func DerivedText(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDerivedText, v))
+}
+
 // TextEmbedding applies equality check predicate on the "text_embedding" field. It's identical to TextEmbeddingEQ.
 func TextEmbedding(v pgvector.Vector) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldTextEmbedding, v))
 }
 
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// NextEmbeddingModel applies equality check predicate on the "next_embedding_model" field. It's identical to NextEmbeddingModelEQ.
+func NextEmbeddingModel(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldNextEmbeddingModel, v))
+}
+
+// EmbeddingAttempts applies equality check predicate on the "embedding_attempts" field. It's identical to EmbeddingAttemptsEQ.
+func EmbeddingAttempts(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingError applies equality check predicate on the "embedding_error" field. It's identical to EmbeddingErrorEQ.
+func EmbeddingError(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingError, v))
+}
+
+// EmbeddingRetryAt applies equality check predicate on the "embedding_retry_at" field. It's identical to EmbeddingRetryAtEQ.
+func EmbeddingRetryAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingFailedAt applies equality check predicate on the "embedding_failed_at" field. It's identical to EmbeddingFailedAtEQ.
+func EmbeddingFailedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingSkipped applies equality check predicate on the "embedding_skipped" field. It's identical to EmbeddingSkippedEQ.
+func EmbeddingSkipped(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingSkipped, v))
+}
+
 // HasMedia applies equality check predicate on the "has_media" field. It's identical to HasMediaEQ.
 func HasMedia(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldHasMedia, v))
@@ -87,6 +173,16 @@ func SentAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldSentAt, v))
 }
 
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // MsgIDEQ applies the EQ predicate on the "msg_id" field.
 func MsgIDEQ(v int) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldMsgID, v))
@@ -147,6 +243,486 @@ func DialogIDNotIn(vs ...int64) predicate.Message {
 	return predicate.Message(sql.FieldNotIn(FieldDialogID, vs...))
 }
 
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// SenderIDIsNil applies the IsNil predicate on the "sender_id" field.
+func SenderIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldSenderID))
+}
+
+// SenderIDNotNil applies the NotNil predicate on the "sender_id" field.
+func SenderIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldSenderID))
+}
+
+// ReplyToMsgIDEQ applies the EQ predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDNEQ applies the NEQ predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDIn applies the In predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReplyToMsgID, vs...))
+}
+
+// ReplyToMsgIDNotIn applies the NotIn predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReplyToMsgID, vs...))
+}
+
+// ReplyToMsgIDGT applies the GT predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDGTE applies the GTE predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDLT applies the LT predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDLTE applies the LTE predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReplyToMsgID, v))
+}
+
+// ReplyToMsgIDIsNil applies the IsNil predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReplyToMsgID))
+}
+
+// ReplyToMsgIDNotNil applies the NotNil predicate on the "reply_to_msg_id" field.
+func ReplyToMsgIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReplyToMsgID))
+}
+
+// TopMsgIDEQ applies the EQ predicate on the "top_msg_id" field.
+func TopMsgIDEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTopMsgID, v))
+}
+
+// TopMsgIDNEQ applies the NEQ predicate on the "top_msg_id" field.
+func TopMsgIDNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldTopMsgID, v))
+}
+
+// TopMsgIDIn applies the In predicate on the "top_msg_id" field.
+func TopMsgIDIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldTopMsgID, vs...))
+}
+
+// TopMsgIDNotIn applies the NotIn predicate on the "top_msg_id" field.
+func TopMsgIDNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldTopMsgID, vs...))
+}
+
+// TopMsgIDGT applies the GT predicate on the "top_msg_id" field.
+func TopMsgIDGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldTopMsgID, v))
+}
+
+// TopMsgIDGTE applies the GTE predicate on the "top_msg_id" field.
+func TopMsgIDGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldTopMsgID, v))
+}
+
+// TopMsgIDLT applies the LT predicate on the "top_msg_id" field.
+func TopMsgIDLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldTopMsgID, v))
+}
+
+// TopMsgIDLTE applies the LTE predicate on the "top_msg_id" field.
+func TopMsgIDLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldTopMsgID, v))
+}
+
+// TopMsgIDIsNil applies the IsNil predicate on the "top_msg_id" field.
+func TopMsgIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldTopMsgID))
+}
+
+// TopMsgIDNotNil applies the NotNil predicate on the "top_msg_id" field.
+func TopMsgIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldTopMsgID))
+}
+
+// TopicIDEQ applies the EQ predicate on the "topic_id" field.
+func TopicIDEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTopicID, v))
+}
+
+// TopicIDNEQ applies the NEQ predicate on the "topic_id" field.
+func TopicIDNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldTopicID, v))
+}
+
+// TopicIDIn applies the In predicate on the "topic_id" field.
+func TopicIDIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldTopicID, vs...))
+}
+
+// TopicIDNotIn applies the NotIn predicate on the "topic_id" field.
+func TopicIDNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldTopicID, vs...))
+}
+
+// TopicIDGT applies the GT predicate on the "topic_id" field.
+func TopicIDGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldTopicID, v))
+}
+
+// TopicIDGTE applies the GTE predicate on the "topic_id" field.
+func TopicIDGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldTopicID, v))
+}
+
+// TopicIDLT applies the LT predicate on the "topic_id" field.
+func TopicIDLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldTopicID, v))
+}
+
+// TopicIDLTE applies the LTE predicate on the "topic_id" field.
+func TopicIDLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldTopicID, v))
+}
+
+// TopicIDIsNil applies the IsNil predicate on the "topic_id" field.
+func TopicIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldTopicID))
+}
+
+// TopicIDNotNil applies the NotNil predicate on the "topic_id" field.
+func TopicIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldTopicID))
+}
+
+// FwdFromIDEQ applies the EQ predicate on the "fwd_from_id" field.
+func FwdFromIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromID, v))
+}
+
+// FwdFromIDNEQ applies the NEQ predicate on the "fwd_from_id" field.
+func FwdFromIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldFwdFromID, v))
+}
+
+// FwdFromIDIn applies the In predicate on the "fwd_from_id" field.
+func FwdFromIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldFwdFromID, vs...))
+}
+
+// FwdFromIDNotIn applies the NotIn predicate on the "fwd_from_id" field.
+func FwdFromIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldFwdFromID, vs...))
+}
+
+// FwdFromIDGT applies the GT predicate on the "fwd_from_id" field.
+func FwdFromIDGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldFwdFromID, v))
+}
+
+// FwdFromIDGTE applies the GTE predicate on the "fwd_from_id" field.
+func FwdFromIDGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldFwdFromID, v))
+}
+
+// FwdFromIDLT applies the LT predicate on the "fwd_from_id" field.
+func FwdFromIDLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldFwdFromID, v))
+}
+
+// FwdFromIDLTE applies the LTE predicate on the "fwd_from_id" field.
+func FwdFromIDLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldFwdFromID, v))
+}
+
+// FwdFromIDIsNil applies the IsNil predicate on the "fwd_from_id" field.
+func FwdFromIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldFwdFromID))
+}
+
+// FwdFromIDNotNil applies the NotNil predicate on the "fwd_from_id" field.
+func FwdFromIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldFwdFromID))
+}
+
+// FwdFromNameEQ applies the EQ predicate on the "fwd_from_name" field.
+func FwdFromNameEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromName, v))
+}
+
+// FwdFromNameNEQ applies the NEQ predicate on the "fwd_from_name" field.
+func FwdFromNameNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldFwdFromName, v))
+}
+
+// FwdFromNameIn applies the In predicate on the "fwd_from_name" field.
+func FwdFromNameIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldFwdFromName, vs...))
+}
+
+// FwdFromNameNotIn applies the NotIn predicate on the "fwd_from_name" field.
+func FwdFromNameNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldFwdFromName, vs...))
+}
+
+// FwdFromNameGT applies the GT predicate on the "fwd_from_name" field.
+func FwdFromNameGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldFwdFromName, v))
+}
+
+// FwdFromNameGTE applies the GTE predicate on the "fwd_from_name" field.
+func FwdFromNameGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldFwdFromName, v))
+}
+
+// FwdFromNameLT applies the LT predicate on the "fwd_from_name" field.
+func FwdFromNameLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldFwdFromName, v))
+}
+
+// FwdFromNameLTE applies the LTE predicate on the "fwd_from_name" field.
+func FwdFromNameLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldFwdFromName, v))
+}
+
+// FwdFromNameContains applies the Contains predicate on the "fwd_from_name" field.
+func FwdFromNameContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldFwdFromName, v))
+}
+
+// FwdFromNameHasPrefix applies the HasPrefix predicate on the "fwd_from_name" field.
+func FwdFromNameHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldFwdFromName, v))
+}
+
+// FwdFromNameHasSuffix applies the HasSuffix predicate on the "fwd_from_name" field.
+func FwdFromNameHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldFwdFromName, v))
+}
+
+// FwdFromNameIsNil applies the IsNil predicate on the "fwd_from_name" field.
+func FwdFromNameIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldFwdFromName))
+}
+
+// FwdFromNameNotNil applies the NotNil predicate on the "fwd_from_name" field.
+func FwdFromNameNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldFwdFromName))
+}
+
+// FwdFromNameEqualFold applies the EqualFold predicate on the "fwd_from_name" field.
+func FwdFromNameEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldFwdFromName, v))
+}
+
+// FwdFromNameContainsFold applies the ContainsFold predicate on the "fwd_from_name" field.
+func FwdFromNameContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldFwdFromName, v))
+}
+
+// FwdFromMsgIDEQ applies the EQ predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDNEQ applies the NEQ predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDIn applies the In predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldFwdFromMsgID, vs...))
+}
+
+// FwdFromMsgIDNotIn applies the NotIn predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldFwdFromMsgID, vs...))
+}
+
+// FwdFromMsgIDGT applies the GT predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDGTE applies the GTE predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDLT applies the LT predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDLTE applies the LTE predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldFwdFromMsgID, v))
+}
+
+// FwdFromMsgIDIsNil applies the IsNil predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldFwdFromMsgID))
+}
+
+// FwdFromMsgIDNotNil applies the NotNil predicate on the "fwd_from_msg_id" field.
+func FwdFromMsgIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldFwdFromMsgID))
+}
+
+// FwdFromDateEQ applies the EQ predicate on the "fwd_from_date" field.
+func FwdFromDateEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdFromDate, v))
+}
+
+// FwdFromDateNEQ applies the NEQ predicate on the "fwd_from_date" field.
+func FwdFromDateNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldFwdFromDate, v))
+}
+
+// FwdFromDateIn applies the In predicate on the "fwd_from_date" field.
+func FwdFromDateIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldFwdFromDate, vs...))
+}
+
+// FwdFromDateNotIn applies the NotIn predicate on the "fwd_from_date" field.
+func FwdFromDateNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldFwdFromDate, vs...))
+}
+
+// FwdFromDateGT applies the GT predicate on the "fwd_from_date" field.
+func FwdFromDateGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldFwdFromDate, v))
+}
+
+// FwdFromDateGTE applies the GTE predicate on the "fwd_from_date" field.
+func FwdFromDateGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldFwdFromDate, v))
+}
+
+// FwdFromDateLT applies the LT predicate on the "fwd_from_date" field.
+func FwdFromDateLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldFwdFromDate, v))
+}
+
+// FwdFromDateLTE applies the LTE predicate on the "fwd_from_date" field.
+func FwdFromDateLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldFwdFromDate, v))
+}
+
+// FwdFromDateIsNil applies the IsNil predicate on the "fwd_from_date" field.
+func FwdFromDateIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldFwdFromDate))
+}
+
+// FwdFromDateNotNil applies the NotNil predicate on the "fwd_from_date" field.
+func FwdFromDateNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldFwdFromDate))
+}
+
+// FwdPostAuthorEQ applies the EQ predicate on the "fwd_post_author" field.
+func FwdPostAuthorEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorNEQ applies the NEQ predicate on the "fwd_post_author" field.
+func FwdPostAuthorNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorIn applies the In predicate on the "fwd_post_author" field.
+func FwdPostAuthorIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldFwdPostAuthor, vs...))
+}
+
+// FwdPostAuthorNotIn applies the NotIn predicate on the "fwd_post_author" field.
+func FwdPostAuthorNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldFwdPostAuthor, vs...))
+}
+
+// FwdPostAuthorGT applies the GT predicate on the "fwd_post_author" field.
+func FwdPostAuthorGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorGTE applies the GTE predicate on the "fwd_post_author" field.
+func FwdPostAuthorGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorLT applies the LT predicate on the "fwd_post_author" field.
+func FwdPostAuthorLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorLTE applies the LTE predicate on the "fwd_post_author" field.
+func FwdPostAuthorLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorContains applies the Contains predicate on the "fwd_post_author" field.
+func FwdPostAuthorContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorHasPrefix applies the HasPrefix predicate on the "fwd_post_author" field.
+func FwdPostAuthorHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorHasSuffix applies the HasSuffix predicate on the "fwd_post_author" field.
+func FwdPostAuthorHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorIsNil applies the IsNil predicate on the "fwd_post_author" field.
+func FwdPostAuthorIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldFwdPostAuthor))
+}
+
+// FwdPostAuthorNotNil applies the NotNil predicate on the "fwd_post_author" field.
+func FwdPostAuthorNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldFwdPostAuthor))
+}
+
+// FwdPostAuthorEqualFold applies the EqualFold predicate on the "fwd_post_author" field.
+func FwdPostAuthorEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldFwdPostAuthor, v))
+}
+
+// FwdPostAuthorContainsFold applies the ContainsFold predicate on the "fwd_post_author" field.
+func FwdPostAuthorContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldFwdPostAuthor, v))
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldText, v))
@@ -212,6 +788,71 @@ func TextContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldText, v))
 }
 
+// DerivedTextEQ applies the EQ predicate on the "derived_text" field.
+func DerivedTextEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDerivedText, v))
+}
+
+// DerivedTextNEQ applies the NEQ predicate on the "derived_text" field.
+func DerivedTextNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldDerivedText, v))
+}
+
+// DerivedTextIn applies the In predicate on the "derived_text" field.
+func DerivedTextIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldDerivedText, vs...))
+}
+
+// DerivedTextNotIn applies the NotIn predicate on the "derived_text" field.
+func DerivedTextNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldDerivedText, vs...))
+}
+
+// DerivedTextGT applies the GT predicate on the "derived_text" field.
+func DerivedTextGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldDerivedText, v))
+}
+
+// DerivedTextGTE applies the GTE predicate on the "derived_text" field.
+func DerivedTextGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldDerivedText, v))
+}
+
+// DerivedTextLT applies the LT predicate on the "derived_text" field.
+func DerivedTextLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldDerivedText, v))
+}
+
+// DerivedTextLTE applies the LTE predicate on the "derived_text" field.
+func DerivedTextLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldDerivedText, v))
+}
+
+// DerivedTextContains applies the Contains predicate on the "derived_text" field.
+func DerivedTextContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldDerivedText, v))
+}
+
+// DerivedTextHasPrefix applies the HasPrefix predicate on the "derived_text" field.
+func DerivedTextHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldDerivedText, v))
+}
+
+// DerivedTextHasSuffix applies the HasSuffix predicate on the "derived_text" field.
+func DerivedTextHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldDerivedText, v))
+}
+
+// DerivedTextEqualFold applies the EqualFold predicate on the "derived_text" field.
+func DerivedTextEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldDerivedText, v))
+}
+
+// DerivedTextContainsFold applies the ContainsFold predicate on the "derived_text" field.
+func DerivedTextContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldDerivedText, v))
+}
+
 // TextEmbeddingEQ applies the EQ predicate on the "text_embedding" field.
 func TextEmbeddingEQ(v pgvector.Vector) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldTextEmbedding, v))
@@ -262,6 +903,421 @@ func TextEmbeddingNotNil() predicate.Message {
 	return predicate.Message(sql.FieldNotNull(FieldTextEmbedding))
 }
 
+// EmbeddingStrategyEQ applies the EQ predicate on the "embedding_strategy" field.
+func EmbeddingStrategyEQ(v types.EmbeddingStrategy) predicate.Message {
+	vc := v
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingStrategy, vc))
+}
+
+// EmbeddingStrategyNEQ applies the NEQ predicate on the "embedding_strategy" field.
+func EmbeddingStrategyNEQ(v types.EmbeddingStrategy) predicate.Message {
+	vc := v
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingStrategy, vc))
+}
+
+// EmbeddingStrategyIn applies the In predicate on the "embedding_strategy" field.
+func EmbeddingStrategyIn(vs ...types.EmbeddingStrategy) predicate.Message {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Message(sql.FieldIn(FieldEmbeddingStrategy, v...))
+}
+
+// EmbeddingStrategyNotIn applies the NotIn predicate on the "embedding_strategy" field.
+func EmbeddingStrategyNotIn(vs ...types.EmbeddingStrategy) predicate.Message {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingStrategy, v...))
+}
+
+// EmbeddingStrategyIsNil applies the IsNil predicate on the "embedding_strategy" field.
+func EmbeddingStrategyIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEmbeddingStrategy))
+}
+
+// EmbeddingStrategyNotNil applies the NotNil predicate on the "embedding_strategy" field.
+func EmbeddingStrategyNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEmbeddingStrategy))
+}
+
+// EmbeddingModelEQ applies the EQ predicate on the "embedding_model" field.
+func EmbeddingModelEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelNEQ applies the NEQ predicate on the "embedding_model" field.
+func EmbeddingModelNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIn applies the In predicate on the "embedding_model" field.
+func EmbeddingModelIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelNotIn applies the NotIn predicate on the "embedding_model" field.
+func EmbeddingModelNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelGT applies the GT predicate on the "embedding_model" field.
+func EmbeddingModelGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelGTE applies the GTE predicate on the "embedding_model" field.
+func EmbeddingModelGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLT applies the LT predicate on the "embedding_model" field.
+func EmbeddingModelLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLTE applies the LTE predicate on the "embedding_model" field.
+func EmbeddingModelLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContains applies the Contains predicate on the "embedding_model" field.
+func EmbeddingModelContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasPrefix applies the HasPrefix predicate on the "embedding_model" field.
+func EmbeddingModelHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasSuffix applies the HasSuffix predicate on the "embedding_model" field.
+func EmbeddingModelHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIsNil applies the IsNil predicate on the "embedding_model" field.
+func EmbeddingModelIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelNotNil applies the NotNil predicate on the "embedding_model" field.
+func EmbeddingModelNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEmbeddingModel))
+}
+
+// EmbeddingModelEqualFold applies the EqualFold predicate on the "embedding_model" field.
+func EmbeddingModelEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContainsFold applies the ContainsFold predicate on the "embedding_model" field.
+func EmbeddingModelContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldEmbeddingModel, v))
+}
+
+// NextEmbeddingModelEQ applies the EQ predicate on the "next_embedding_model" field.
+func NextEmbeddingModelEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelNEQ applies the NEQ predicate on the "next_embedding_model" field.
+func NextEmbeddingModelNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelIn applies the In predicate on the "next_embedding_model" field.
+func NextEmbeddingModelIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldNextEmbeddingModel, vs...))
+}
+
+// NextEmbeddingModelNotIn applies the NotIn predicate on the "next_embedding_model" field.
+func NextEmbeddingModelNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldNextEmbeddingModel, vs...))
+}
+
+// NextEmbeddingModelGT applies the GT predicate on the "next_embedding_model" field.
+func NextEmbeddingModelGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelGTE applies the GTE predicate on the "next_embedding_model" field.
+func NextEmbeddingModelGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelLT applies the LT predicate on the "next_embedding_model" field.
+func NextEmbeddingModelLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelLTE applies the LTE predicate on the "next_embedding_model" field.
+func NextEmbeddingModelLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelContains applies the Contains predicate on the "next_embedding_model" field.
+func NextEmbeddingModelContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelHasPrefix applies the HasPrefix predicate on the "next_embedding_model" field.
+func NextEmbeddingModelHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelHasSuffix applies the HasSuffix predicate on the "next_embedding_model" field.
+func NextEmbeddingModelHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelIsNil applies the IsNil predicate on the "next_embedding_model" field.
+func NextEmbeddingModelIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldNextEmbeddingModel))
+}
+
+// NextEmbeddingModelNotNil applies the NotNil predicate on the "next_embedding_model" field.
+func NextEmbeddingModelNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldNextEmbeddingModel))
+}
+
+// NextEmbeddingModelEqualFold applies the EqualFold predicate on the "next_embedding_model" field.
+func NextEmbeddingModelEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldNextEmbeddingModel, v))
+}
+
+// NextEmbeddingModelContainsFold applies the ContainsFold predicate on the "next_embedding_model" field.
+func NextEmbeddingModelContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldNextEmbeddingModel, v))
+}
+
+// EmbeddingAttemptsEQ applies the EQ predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingAttemptsNEQ applies the NEQ predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingAttemptsIn applies the In predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEmbeddingAttempts, vs...))
+}
+
+// EmbeddingAttemptsNotIn applies the NotIn predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingAttempts, vs...))
+}
+
+// EmbeddingAttemptsGT applies the GT predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingAttemptsGTE applies the GTE predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingAttemptsLT applies the LT predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingAttemptsLTE applies the LTE predicate on the "embedding_attempts" field.
+func EmbeddingAttemptsLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEmbeddingAttempts, v))
+}
+
+// EmbeddingErrorEQ applies the EQ predicate on the "embedding_error" field.
+func EmbeddingErrorEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorNEQ applies the NEQ predicate on the "embedding_error" field.
+func EmbeddingErrorNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorIn applies the In predicate on the "embedding_error" field.
+func EmbeddingErrorIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEmbeddingError, vs...))
+}
+
+// EmbeddingErrorNotIn applies the NotIn predicate on the "embedding_error" field.
+func EmbeddingErrorNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingError, vs...))
+}
+
+// EmbeddingErrorGT applies the GT predicate on the "embedding_error" field.
+func EmbeddingErrorGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorGTE applies the GTE predicate on the "embedding_error" field.
+func EmbeddingErrorGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorLT applies the LT predicate on the "embedding_error" field.
+func EmbeddingErrorLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorLTE applies the LTE predicate on the "embedding_error" field.
+func EmbeddingErrorLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorContains applies the Contains predicate on the "embedding_error" field.
+func EmbeddingErrorContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorHasPrefix applies the HasPrefix predicate on the "embedding_error" field.
+func EmbeddingErrorHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorHasSuffix applies the HasSuffix predicate on the "embedding_error" field.
+func EmbeddingErrorHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorIsNil applies the IsNil predicate on the "embedding_error" field.
+func EmbeddingErrorIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEmbeddingError))
+}
+
+// EmbeddingErrorNotNil applies the NotNil predicate on the "embedding_error" field.
+func EmbeddingErrorNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEmbeddingError))
+}
+
+// EmbeddingErrorEqualFold applies the EqualFold predicate on the "embedding_error" field.
+func EmbeddingErrorEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldEmbeddingError, v))
+}
+
+// EmbeddingErrorContainsFold applies the ContainsFold predicate on the "embedding_error" field.
+func EmbeddingErrorContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldEmbeddingError, v))
+}
+
+// EmbeddingRetryAtEQ applies the EQ predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtNEQ applies the NEQ predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtIn applies the In predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEmbeddingRetryAt, vs...))
+}
+
+// EmbeddingRetryAtNotIn applies the NotIn predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingRetryAt, vs...))
+}
+
+// EmbeddingRetryAtGT applies the GT predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtGTE applies the GTE predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtLT applies the LT predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtLTE applies the LTE predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEmbeddingRetryAt, v))
+}
+
+// EmbeddingRetryAtIsNil applies the IsNil predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEmbeddingRetryAt))
+}
+
+// EmbeddingRetryAtNotNil applies the NotNil predicate on the "embedding_retry_at" field.
+func EmbeddingRetryAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEmbeddingRetryAt))
+}
+
+// EmbeddingFailedAtEQ applies the EQ predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtNEQ applies the NEQ predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtIn applies the In predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEmbeddingFailedAt, vs...))
+}
+
+// EmbeddingFailedAtNotIn applies the NotIn predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEmbeddingFailedAt, vs...))
+}
+
+// EmbeddingFailedAtGT applies the GT predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtGTE applies the GTE predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtLT applies the LT predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtLTE applies the LTE predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEmbeddingFailedAt, v))
+}
+
+// EmbeddingFailedAtIsNil applies the IsNil predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEmbeddingFailedAt))
+}
+
+// EmbeddingFailedAtNotNil applies the NotNil predicate on the "embedding_failed_at" field.
+func EmbeddingFailedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEmbeddingFailedAt))
+}
+
+// EmbeddingSkippedEQ applies the EQ predicate on the "embedding_skipped" field.
+func EmbeddingSkippedEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEmbeddingSkipped, v))
+}
+
+// EmbeddingSkippedNEQ applies the NEQ predicate on the "embedding_skipped" field.
+func EmbeddingSkippedNEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEmbeddingSkipped, v))
+}
+
 // HasMediaEQ applies the EQ predicate on the "has_media" field.
 func HasMediaEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldHasMedia, v))
@@ -312,6 +1368,106 @@ func SentAtLTE(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldLTE(FieldSentAt, v))
 }
 
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// EditedAtIsNil applies the IsNil predicate on the "edited_at" field.
+func EditedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEditedAt))
+}
+
+// EditedAtNotNil applies the NotNil predicate on the "edited_at" field.
+func EditedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEditedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasDialog applies the HasEdge predicate on the "dialog" edge.
 func HasDialog() predicate.Message {
 	return predicate.Message(func(s *sql.Selector) {
@@ -335,6 +1491,121 @@ func HasDialogWith(preds ...predicate.Dialog) predicate.Message {
 	})
 }
 
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.Sender) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.MessageRevision) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChunks applies the HasEdge predicate on the "chunks" edge.
+func HasChunks() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunksWith applies the HasEdge predicate on the "chunks" edge with a given conditions (other predicates).
+func HasChunksWith(preds ...predicate.MessageChunk) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNextEmbeddings applies the HasEdge predicate on the "next_embeddings" edge.
+func HasNextEmbeddings() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NextEmbeddingsTable, NextEmbeddingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNextEmbeddingsWith applies the HasEdge predicate on the "next_embeddings" edge with a given conditions (other predicates).
+func HasNextEmbeddingsWith(preds ...predicate.NextEmbedding) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newNextEmbeddingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNextEmbeddingFailures applies the HasEdge predicate on the "next_embedding_failures" edge.
+func HasNextEmbeddingFailures() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NextEmbeddingFailuresTable, NextEmbeddingFailuresColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNextEmbeddingFailuresWith applies the HasEdge predicate on the "next_embedding_failures" edge with a given conditions (other predicates).
+func HasNextEmbeddingFailuresWith(preds ...predicate.NextEmbeddingFailure) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newNextEmbeddingFailuresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Message) predicate.Message {
 	return predicate.Message(sql.AndPredicates(predicates...))
@@ -8,12 +8,19 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/sender"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -22,6 +29,7 @@ type MessageCreate struct {
 	config
 	mutation *MessageMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetMsgID sets the "msg_id" field.
@@ -36,12 +44,152 @@ func (mc *MessageCreate) SetDialogID(i int64) *MessageCreate {
 	return mc
 }
 
+// SetSenderID sets the "sender_id" field.
+func (mc *MessageCreate) SetSenderID(i int64) *MessageCreate {
+	mc.mutation.SetSenderID(i)
+	return mc
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableSenderID(i *int64) *MessageCreate {
+	if i != nil {
+		mc.SetSenderID(*i)
+	}
+	return mc
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (mc *MessageCreate) SetReplyToMsgID(i int) *MessageCreate {
+	mc.mutation.SetReplyToMsgID(i)
+	return mc
+}
+
+// SetNillableReplyToMsgID sets the "reply_to_msg_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyToMsgID(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetReplyToMsgID(*i)
+	}
+	return mc
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (mc *MessageCreate) SetTopMsgID(i int) *MessageCreate {
+	mc.mutation.SetTopMsgID(i)
+	return mc
+}
+
+// SetNillableTopMsgID sets the "top_msg_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableTopMsgID(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetTopMsgID(*i)
+	}
+	return mc
+}
+
+// SetTopicID sets the "topic_id" field.
+func (mc *MessageCreate) SetTopicID(i int) *MessageCreate {
+	mc.mutation.SetTopicID(i)
+	return mc
+}
+
+// SetNillableTopicID sets the "topic_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableTopicID(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetTopicID(*i)
+	}
+	return mc
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (mc *MessageCreate) SetFwdFromID(i int64) *MessageCreate {
+	mc.mutation.SetFwdFromID(i)
+	return mc
+}
+
+// SetNillableFwdFromID sets the "fwd_from_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableFwdFromID(i *int64) *MessageCreate {
+	if i != nil {
+		mc.SetFwdFromID(*i)
+	}
+	return mc
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (mc *MessageCreate) SetFwdFromName(s string) *MessageCreate {
+	mc.mutation.SetFwdFromName(s)
+	return mc
+}
+
+// SetNillableFwdFromName sets the "fwd_from_name" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableFwdFromName(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetFwdFromName(*s)
+	}
+	return mc
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (mc *MessageCreate) SetFwdFromMsgID(i int) *MessageCreate {
+	mc.mutation.SetFwdFromMsgID(i)
+	return mc
+}
+
+// SetNillableFwdFromMsgID sets the "fwd_from_msg_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableFwdFromMsgID(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetFwdFromMsgID(*i)
+	}
+	return mc
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (mc *MessageCreate) SetFwdFromDate(t time.Time) *MessageCreate {
+	mc.mutation.SetFwdFromDate(t)
+	return mc
+}
+
+// SetNillableFwdFromDate sets the "fwd_from_date" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableFwdFromDate(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetFwdFromDate(*t)
+	}
+	return mc
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (mc *MessageCreate) SetFwdPostAuthor(s string) *MessageCreate {
+	mc.mutation.SetFwdPostAuthor(s)
+	return mc
+}
+
+// SetNillableFwdPostAuthor sets the "fwd_post_author" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableFwdPostAuthor(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetFwdPostAuthor(*s)
+	}
+	return mc
+}
+
 // SetText sets the "text" field.
 func (mc *MessageCreate) SetText(s string) *MessageCreate {
 	mc.mutation.SetText(s)
 	return mc
 }
 
+// SetDerivedText sets the "derived_text" field.
+func (mc *MessageCreate) SetDerivedText(s string) *MessageCreate {
+	mc.mutation.SetDerivedText(s)
+	return mc
+}
+
+// SetNillableDerivedText sets the "derived_text" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableDerivedText(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetDerivedText(*s)
+	}
+	return mc
+}
+
 // SetTextEmbedding sets the "text_embedding" field.
 func (mc *MessageCreate) SetTextEmbedding(pg pgvector.Vector) *MessageCreate {
 	mc.mutation.SetTextEmbedding(pg)
@@ -56,6 +204,118 @@ func (mc *MessageCreate) SetNillableTextEmbedding(pg *pgvector.Vector) *MessageC
 	return mc
 }
 
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (mc *MessageCreate) SetEmbeddingStrategy(ts types.EmbeddingStrategy) *MessageCreate {
+	mc.mutation.SetEmbeddingStrategy(ts)
+	return mc
+}
+
+// SetNillableEmbeddingStrategy sets the "embedding_strategy" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingStrategy(ts *types.EmbeddingStrategy) *MessageCreate {
+	if ts != nil {
+		mc.SetEmbeddingStrategy(*ts)
+	}
+	return mc
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (mc *MessageCreate) SetEmbeddingModel(s string) *MessageCreate {
+	mc.mutation.SetEmbeddingModel(s)
+	return mc
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingModel(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetEmbeddingModel(*s)
+	}
+	return mc
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (mc *MessageCreate) SetNextEmbeddingModel(s string) *MessageCreate {
+	mc.mutation.SetNextEmbeddingModel(s)
+	return mc
+}
+
+// SetNillableNextEmbeddingModel sets the "next_embedding_model" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableNextEmbeddingModel(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetNextEmbeddingModel(*s)
+	}
+	return mc
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (mc *MessageCreate) SetEmbeddingAttempts(i int) *MessageCreate {
+	mc.mutation.SetEmbeddingAttempts(i)
+	return mc
+}
+
+// SetNillableEmbeddingAttempts sets the "embedding_attempts" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingAttempts(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetEmbeddingAttempts(*i)
+	}
+	return mc
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (mc *MessageCreate) SetEmbeddingError(s string) *MessageCreate {
+	mc.mutation.SetEmbeddingError(s)
+	return mc
+}
+
+// SetNillableEmbeddingError sets the "embedding_error" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingError(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetEmbeddingError(*s)
+	}
+	return mc
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (mc *MessageCreate) SetEmbeddingRetryAt(t time.Time) *MessageCreate {
+	mc.mutation.SetEmbeddingRetryAt(t)
+	return mc
+}
+
+// SetNillableEmbeddingRetryAt sets the "embedding_retry_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingRetryAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetEmbeddingRetryAt(*t)
+	}
+	return mc
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (mc *MessageCreate) SetEmbeddingFailedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetEmbeddingFailedAt(t)
+	return mc
+}
+
+// SetNillableEmbeddingFailedAt sets the "embedding_failed_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingFailedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetEmbeddingFailedAt(*t)
+	}
+	return mc
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (mc *MessageCreate) SetEmbeddingSkipped(b bool) *MessageCreate {
+	mc.mutation.SetEmbeddingSkipped(b)
+	return mc
+}
+
+// SetNillableEmbeddingSkipped sets the "embedding_skipped" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEmbeddingSkipped(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetEmbeddingSkipped(*b)
+	}
+	return mc
+}
+
 // SetHasMedia sets the "has_media" field.
 func (mc *MessageCreate) SetHasMedia(b bool) *MessageCreate {
 	mc.mutation.SetHasMedia(b)
@@ -74,6 +334,34 @@ func (mc *MessageCreate) SetSentAt(t time.Time) *MessageCreate {
 	return mc
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (mc *MessageCreate) SetEditedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetEditedAt(t)
+	return mc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEditedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetEditedAt(*t)
+	}
+	return mc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mc *MessageCreate) SetDeletedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetDeletedAt(t)
+	return mc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableDeletedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetDeletedAt(*t)
+	}
+	return mc
+}
+
 // SetID sets the "id" field.
 func (mc *MessageCreate) SetID(u uuid.UUID) *MessageCreate {
 	mc.mutation.SetID(u)
@@ -93,6 +381,71 @@ func (mc *MessageCreate) SetDialog(d *Dialog) *MessageCreate {
 	return mc.SetDialogID(d.ID)
 }
 
+// SetSender sets the "sender" edge to the Sender entity.
+func (mc *MessageCreate) SetSender(s *Sender) *MessageCreate {
+	return mc.SetSenderID(s.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mc *MessageCreate) AddRevisionIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddRevisionIDs(ids...)
+	return mc
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mc *MessageCreate) AddRevisions(m ...*MessageRevision) *MessageCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddRevisionIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the MessageChunk entity by IDs.
+func (mc *MessageCreate) AddChunkIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddChunkIDs(ids...)
+	return mc
+}
+
+// AddChunks adds the "chunks" edges to the MessageChunk entity.
+func (mc *MessageCreate) AddChunks(m ...*MessageChunk) *MessageCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddChunkIDs(ids...)
+}
+
+// AddNextEmbeddingIDs adds the "next_embeddings" edge to the NextEmbedding entity by IDs.
+func (mc *MessageCreate) AddNextEmbeddingIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddNextEmbeddingIDs(ids...)
+	return mc
+}
+
+// AddNextEmbeddings adds the "next_embeddings" edges to the NextEmbedding entity.
+func (mc *MessageCreate) AddNextEmbeddings(n ...*NextEmbedding) *MessageCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mc.AddNextEmbeddingIDs(ids...)
+}
+
+// AddNextEmbeddingFailureIDs adds the "next_embedding_failures" edge to the NextEmbeddingFailure entity by IDs.
+func (mc *MessageCreate) AddNextEmbeddingFailureIDs(ids ...uuid.UUID) *MessageCreate {
+	mc.mutation.AddNextEmbeddingFailureIDs(ids...)
+	return mc
+}
+
+// AddNextEmbeddingFailures adds the "next_embedding_failures" edges to the NextEmbeddingFailure entity.
+func (mc *MessageCreate) AddNextEmbeddingFailures(n ...*NextEmbeddingFailure) *MessageCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mc.AddNextEmbeddingFailureIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -128,6 +481,18 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (mc *MessageCreate) defaults() {
+	if _, ok := mc.mutation.DerivedText(); !ok {
+		v := message.DefaultDerivedText
+		mc.mutation.SetDerivedText(v)
+	}
+	if _, ok := mc.mutation.EmbeddingAttempts(); !ok {
+		v := message.DefaultEmbeddingAttempts
+		mc.mutation.SetEmbeddingAttempts(v)
+	}
+	if _, ok := mc.mutation.EmbeddingSkipped(); !ok {
+		v := message.DefaultEmbeddingSkipped
+		mc.mutation.SetEmbeddingSkipped(v)
+	}
 	if _, ok := mc.mutation.ID(); !ok {
 		v := message.DefaultID()
 		mc.mutation.SetID(v)
@@ -145,6 +510,20 @@ func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.Text(); !ok {
 		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Message.text"`)}
 	}
+	if _, ok := mc.mutation.DerivedText(); !ok {
+		return &ValidationError{Name: "derived_text", err: errors.New(`ent: missing required field "Message.derived_text"`)}
+	}
+	if v, ok := mc.mutation.EmbeddingStrategy(); ok {
+		if err := message.EmbeddingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_strategy", err: fmt.Errorf(`ent: validator failed for field "Message.embedding_strategy": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.EmbeddingAttempts(); !ok {
+		return &ValidationError{Name: "embedding_attempts", err: errors.New(`ent: missing required field "Message.embedding_attempts"`)}
+	}
+	if _, ok := mc.mutation.EmbeddingSkipped(); !ok {
+		return &ValidationError{Name: "embedding_skipped", err: errors.New(`ent: missing required field "Message.embedding_skipped"`)}
+	}
 	if _, ok := mc.mutation.HasMedia(); !ok {
 		return &ValidationError{Name: "has_media", err: errors.New(`ent: missing required field "Message.has_media"`)}
 	}
@@ -188,6 +567,7 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node = &Message{config: mc.config}
 		_spec = sqlgraph.NewCreateSpec(message.Table, sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = mc.conflict
 	if id, ok := mc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -196,14 +576,82 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldMsgID, field.TypeInt, value)
 		_node.MsgID = value
 	}
+	if value, ok := mc.mutation.ReplyToMsgID(); ok {
+		_spec.SetField(message.FieldReplyToMsgID, field.TypeInt, value)
+		_node.ReplyToMsgID = &value
+	}
+	if value, ok := mc.mutation.TopMsgID(); ok {
+		_spec.SetField(message.FieldTopMsgID, field.TypeInt, value)
+		_node.TopMsgID = &value
+	}
+	if value, ok := mc.mutation.TopicID(); ok {
+		_spec.SetField(message.FieldTopicID, field.TypeInt, value)
+		_node.TopicID = &value
+	}
+	if value, ok := mc.mutation.FwdFromID(); ok {
+		_spec.SetField(message.FieldFwdFromID, field.TypeInt64, value)
+		_node.FwdFromID = &value
+	}
+	if value, ok := mc.mutation.FwdFromName(); ok {
+		_spec.SetField(message.FieldFwdFromName, field.TypeString, value)
+		_node.FwdFromName = &value
+	}
+	if value, ok := mc.mutation.FwdFromMsgID(); ok {
+		_spec.SetField(message.FieldFwdFromMsgID, field.TypeInt, value)
+		_node.FwdFromMsgID = &value
+	}
+	if value, ok := mc.mutation.FwdFromDate(); ok {
+		_spec.SetField(message.FieldFwdFromDate, field.TypeTime, value)
+		_node.FwdFromDate = &value
+	}
+	if value, ok := mc.mutation.FwdPostAuthor(); ok {
+		_spec.SetField(message.FieldFwdPostAuthor, field.TypeString, value)
+		_node.FwdPostAuthor = &value
+	}
 	if value, ok := mc.mutation.Text(); ok {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 		_node.Text = value
 	}
+	if value, ok := mc.mutation.DerivedText(); ok {
+		_spec.SetField(message.FieldDerivedText, field.TypeString, value)
+		_node.DerivedText = value
+	}
 	if value, ok := mc.mutation.TextEmbedding(); ok {
 		_spec.SetField(message.FieldTextEmbedding, field.TypeOther, value)
 		_node.TextEmbedding = value
 	}
+	if value, ok := mc.mutation.EmbeddingStrategy(); ok {
+		_spec.SetField(message.FieldEmbeddingStrategy, field.TypeEnum, value)
+		_node.EmbeddingStrategy = &value
+	}
+	if value, ok := mc.mutation.EmbeddingModel(); ok {
+		_spec.SetField(message.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = &value
+	}
+	if value, ok := mc.mutation.NextEmbeddingModel(); ok {
+		_spec.SetField(message.FieldNextEmbeddingModel, field.TypeString, value)
+		_node.NextEmbeddingModel = &value
+	}
+	if value, ok := mc.mutation.EmbeddingAttempts(); ok {
+		_spec.SetField(message.FieldEmbeddingAttempts, field.TypeInt, value)
+		_node.EmbeddingAttempts = value
+	}
+	if value, ok := mc.mutation.EmbeddingError(); ok {
+		_spec.SetField(message.FieldEmbeddingError, field.TypeString, value)
+		_node.EmbeddingError = &value
+	}
+	if value, ok := mc.mutation.EmbeddingRetryAt(); ok {
+		_spec.SetField(message.FieldEmbeddingRetryAt, field.TypeTime, value)
+		_node.EmbeddingRetryAt = &value
+	}
+	if value, ok := mc.mutation.EmbeddingFailedAt(); ok {
+		_spec.SetField(message.FieldEmbeddingFailedAt, field.TypeTime, value)
+		_node.EmbeddingFailedAt = &value
+	}
+	if value, ok := mc.mutation.EmbeddingSkipped(); ok {
+		_spec.SetField(message.FieldEmbeddingSkipped, field.TypeBool, value)
+		_node.EmbeddingSkipped = value
+	}
 	if value, ok := mc.mutation.HasMedia(); ok {
 		_spec.SetField(message.FieldHasMedia, field.TypeBool, value)
 		_node.HasMedia = value
@@ -216,6 +664,14 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 		_node.SentAt = value
 	}
+	if value, ok := mc.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := mc.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := mc.mutation.DialogIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -233,22 +689,1266 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.DialogID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SenderID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.NextEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.NextEmbeddingFailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// MessageCreateBulk is the builder for creating many Message entities in bulk.
-type MessageCreateBulk struct {
-	config
-	err      error
-	builders []*MessageCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Message.Create().
+//		SetMsgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageUpsert) {
+//			SetMsgID(v+v).
+//		}).
+//		Exec(ctx)
+func (mc *MessageCreate) OnConflict(opts ...sql.ConflictOption) *MessageUpsertOne {
+	mc.conflict = opts
+	return &MessageUpsertOne{
+		create: mc,
+	}
 }
 
-// Save creates the Message entities in the database.
-func (mcb *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
-	if mcb.err != nil {
-		return nil, mcb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mc *MessageCreate) OnConflictColumns(columns ...string) *MessageUpsertOne {
+	mc.conflict = append(mc.conflict, sql.ConflictColumns(columns...))
+	return &MessageUpsertOne{
+		create: mc,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+}
+
+type (
+	// MessageUpsertOne is the builder for "upsert"-ing
+	//  one Message node.
+	MessageUpsertOne struct {
+		create *MessageCreate
+	}
+
+	// MessageUpsert is the "OnConflict" setter.
+	MessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMsgID sets the "msg_id" field.
+func (u *MessageUpsert) SetMsgID(v int) *MessageUpsert {
+	u.Set(message.FieldMsgID, v)
+	return u
+}
+
+// UpdateMsgID sets the "msg_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateMsgID() *MessageUpsert {
+	u.SetExcluded(message.FieldMsgID)
+	return u
+}
+
+// AddMsgID adds v to the "msg_id" field.
+func (u *MessageUpsert) AddMsgID(v int) *MessageUpsert {
+	u.Add(message.FieldMsgID, v)
+	return u
+}
+
+// SetDialogID sets the "dialog_id" field.
+func (u *MessageUpsert) SetDialogID(v int64) *MessageUpsert {
+	u.Set(message.FieldDialogID, v)
+	return u
+}
+
+// UpdateDialogID sets the "dialog_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateDialogID() *MessageUpsert {
+	u.SetExcluded(message.FieldDialogID)
+	return u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *MessageUpsert) SetSenderID(v int64) *MessageUpsert {
+	u.Set(message.FieldSenderID, v)
+	return u
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateSenderID() *MessageUpsert {
+	u.SetExcluded(message.FieldSenderID)
+	return u
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (u *MessageUpsert) ClearSenderID() *MessageUpsert {
+	u.SetNull(message.FieldSenderID)
+	return u
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (u *MessageUpsert) SetReplyToMsgID(v int) *MessageUpsert {
+	u.Set(message.FieldReplyToMsgID, v)
+	return u
+}
+
+// UpdateReplyToMsgID sets the "reply_to_msg_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateReplyToMsgID() *MessageUpsert {
+	u.SetExcluded(message.FieldReplyToMsgID)
+	return u
+}
+
+// AddReplyToMsgID adds v to the "reply_to_msg_id" field.
+func (u *MessageUpsert) AddReplyToMsgID(v int) *MessageUpsert {
+	u.Add(message.FieldReplyToMsgID, v)
+	return u
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (u *MessageUpsert) ClearReplyToMsgID() *MessageUpsert {
+	u.SetNull(message.FieldReplyToMsgID)
+	return u
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (u *MessageUpsert) SetTopMsgID(v int) *MessageUpsert {
+	u.Set(message.FieldTopMsgID, v)
+	return u
+}
+
+// UpdateTopMsgID sets the "top_msg_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateTopMsgID() *MessageUpsert {
+	u.SetExcluded(message.FieldTopMsgID)
+	return u
+}
+
+// AddTopMsgID adds v to the "top_msg_id" field.
+func (u *MessageUpsert) AddTopMsgID(v int) *MessageUpsert {
+	u.Add(message.FieldTopMsgID, v)
+	return u
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (u *MessageUpsert) ClearTopMsgID() *MessageUpsert {
+	u.SetNull(message.FieldTopMsgID)
+	return u
+}
+
+// SetTopicID sets the "topic_id" field.
+func (u *MessageUpsert) SetTopicID(v int) *MessageUpsert {
+	u.Set(message.FieldTopicID, v)
+	return u
+}
+
+// UpdateTopicID sets the "topic_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateTopicID() *MessageUpsert {
+	u.SetExcluded(message.FieldTopicID)
+	return u
+}
+
+// AddTopicID adds v to the "topic_id" field.
+func (u *MessageUpsert) AddTopicID(v int) *MessageUpsert {
+	u.Add(message.FieldTopicID, v)
+	return u
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (u *MessageUpsert) ClearTopicID() *MessageUpsert {
+	u.SetNull(message.FieldTopicID)
+	return u
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (u *MessageUpsert) SetFwdFromID(v int64) *MessageUpsert {
+	u.Set(message.FieldFwdFromID, v)
+	return u
+}
+
+// UpdateFwdFromID sets the "fwd_from_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateFwdFromID() *MessageUpsert {
+	u.SetExcluded(message.FieldFwdFromID)
+	return u
+}
+
+// AddFwdFromID adds v to the "fwd_from_id" field.
+func (u *MessageUpsert) AddFwdFromID(v int64) *MessageUpsert {
+	u.Add(message.FieldFwdFromID, v)
+	return u
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (u *MessageUpsert) ClearFwdFromID() *MessageUpsert {
+	u.SetNull(message.FieldFwdFromID)
+	return u
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (u *MessageUpsert) SetFwdFromName(v string) *MessageUpsert {
+	u.Set(message.FieldFwdFromName, v)
+	return u
+}
+
+// UpdateFwdFromName sets the "fwd_from_name" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateFwdFromName() *MessageUpsert {
+	u.SetExcluded(message.FieldFwdFromName)
+	return u
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (u *MessageUpsert) ClearFwdFromName() *MessageUpsert {
+	u.SetNull(message.FieldFwdFromName)
+	return u
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (u *MessageUpsert) SetFwdFromMsgID(v int) *MessageUpsert {
+	u.Set(message.FieldFwdFromMsgID, v)
+	return u
+}
+
+// UpdateFwdFromMsgID sets the "fwd_from_msg_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateFwdFromMsgID() *MessageUpsert {
+	u.SetExcluded(message.FieldFwdFromMsgID)
+	return u
+}
+
+// AddFwdFromMsgID adds v to the "fwd_from_msg_id" field.
+func (u *MessageUpsert) AddFwdFromMsgID(v int) *MessageUpsert {
+	u.Add(message.FieldFwdFromMsgID, v)
+	return u
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (u *MessageUpsert) ClearFwdFromMsgID() *MessageUpsert {
+	u.SetNull(message.FieldFwdFromMsgID)
+	return u
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (u *MessageUpsert) SetFwdFromDate(v time.Time) *MessageUpsert {
+	u.Set(message.FieldFwdFromDate, v)
+	return u
+}
+
+// UpdateFwdFromDate sets the "fwd_from_date" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateFwdFromDate() *MessageUpsert {
+	u.SetExcluded(message.FieldFwdFromDate)
+	return u
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (u *MessageUpsert) ClearFwdFromDate() *MessageUpsert {
+	u.SetNull(message.FieldFwdFromDate)
+	return u
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (u *MessageUpsert) SetFwdPostAuthor(v string) *MessageUpsert {
+	u.Set(message.FieldFwdPostAuthor, v)
+	return u
+}
+
+// UpdateFwdPostAuthor sets the "fwd_post_author" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateFwdPostAuthor() *MessageUpsert {
+	u.SetExcluded(message.FieldFwdPostAuthor)
+	return u
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (u *MessageUpsert) ClearFwdPostAuthor() *MessageUpsert {
+	u.SetNull(message.FieldFwdPostAuthor)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *MessageUpsert) SetText(v string) *MessageUpsert {
+	u.Set(message.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateText() *MessageUpsert {
+	u.SetExcluded(message.FieldText)
+	return u
+}
+
+// SetDerivedText sets the "derived_text" field.
+func (u *MessageUpsert) SetDerivedText(v string) *MessageUpsert {
+	u.Set(message.FieldDerivedText, v)
+	return u
+}
+
+// UpdateDerivedText sets the "derived_text" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateDerivedText() *MessageUpsert {
+	u.SetExcluded(message.FieldDerivedText)
+	return u
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageUpsert) SetTextEmbedding(v pgvector.Vector) *MessageUpsert {
+	u.Set(message.FieldTextEmbedding, v)
+	return u
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateTextEmbedding() *MessageUpsert {
+	u.SetExcluded(message.FieldTextEmbedding)
+	return u
+}
+
+// ClearTextEmbedding clears the value of the "text_embedding" field.
+func (u *MessageUpsert) ClearTextEmbedding() *MessageUpsert {
+	u.SetNull(message.FieldTextEmbedding)
+	return u
+}
+
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (u *MessageUpsert) SetEmbeddingStrategy(v types.EmbeddingStrategy) *MessageUpsert {
+	u.Set(message.FieldEmbeddingStrategy, v)
+	return u
+}
+
+// UpdateEmbeddingStrategy sets the "embedding_strategy" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingStrategy() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingStrategy)
+	return u
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (u *MessageUpsert) ClearEmbeddingStrategy() *MessageUpsert {
+	u.SetNull(message.FieldEmbeddingStrategy)
+	return u
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *MessageUpsert) SetEmbeddingModel(v string) *MessageUpsert {
+	u.Set(message.FieldEmbeddingModel, v)
+	return u
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingModel() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingModel)
+	return u
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (u *MessageUpsert) ClearEmbeddingModel() *MessageUpsert {
+	u.SetNull(message.FieldEmbeddingModel)
+	return u
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (u *MessageUpsert) SetNextEmbeddingModel(v string) *MessageUpsert {
+	u.Set(message.FieldNextEmbeddingModel, v)
+	return u
+}
+
+// UpdateNextEmbeddingModel sets the "next_embedding_model" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateNextEmbeddingModel() *MessageUpsert {
+	u.SetExcluded(message.FieldNextEmbeddingModel)
+	return u
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (u *MessageUpsert) ClearNextEmbeddingModel() *MessageUpsert {
+	u.SetNull(message.FieldNextEmbeddingModel)
+	return u
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (u *MessageUpsert) SetEmbeddingAttempts(v int) *MessageUpsert {
+	u.Set(message.FieldEmbeddingAttempts, v)
+	return u
+}
+
+// UpdateEmbeddingAttempts sets the "embedding_attempts" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingAttempts() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingAttempts)
+	return u
+}
+
+// AddEmbeddingAttempts adds v to the "embedding_attempts" field.
+func (u *MessageUpsert) AddEmbeddingAttempts(v int) *MessageUpsert {
+	u.Add(message.FieldEmbeddingAttempts, v)
+	return u
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (u *MessageUpsert) SetEmbeddingError(v string) *MessageUpsert {
+	u.Set(message.FieldEmbeddingError, v)
+	return u
+}
+
+// UpdateEmbeddingError sets the "embedding_error" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingError() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingError)
+	return u
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (u *MessageUpsert) ClearEmbeddingError() *MessageUpsert {
+	u.SetNull(message.FieldEmbeddingError)
+	return u
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (u *MessageUpsert) SetEmbeddingRetryAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldEmbeddingRetryAt, v)
+	return u
+}
+
+// UpdateEmbeddingRetryAt sets the "embedding_retry_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingRetryAt() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingRetryAt)
+	return u
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (u *MessageUpsert) ClearEmbeddingRetryAt() *MessageUpsert {
+	u.SetNull(message.FieldEmbeddingRetryAt)
+	return u
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (u *MessageUpsert) SetEmbeddingFailedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldEmbeddingFailedAt, v)
+	return u
+}
+
+// UpdateEmbeddingFailedAt sets the "embedding_failed_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingFailedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingFailedAt)
+	return u
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (u *MessageUpsert) ClearEmbeddingFailedAt() *MessageUpsert {
+	u.SetNull(message.FieldEmbeddingFailedAt)
+	return u
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (u *MessageUpsert) SetEmbeddingSkipped(v bool) *MessageUpsert {
+	u.Set(message.FieldEmbeddingSkipped, v)
+	return u
+}
+
+// UpdateEmbeddingSkipped sets the "embedding_skipped" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEmbeddingSkipped() *MessageUpsert {
+	u.SetExcluded(message.FieldEmbeddingSkipped)
+	return u
+}
+
+// SetHasMedia sets the "has_media" field.
+func (u *MessageUpsert) SetHasMedia(v bool) *MessageUpsert {
+	u.Set(message.FieldHasMedia, v)
+	return u
+}
+
+// UpdateHasMedia sets the "has_media" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateHasMedia() *MessageUpsert {
+	u.SetExcluded(message.FieldHasMedia)
+	return u
+}
+
+// SetMediaInfo sets the "media_info" field.
+func (u *MessageUpsert) SetMediaInfo(v *types.MediaInfo) *MessageUpsert {
+	u.Set(message.FieldMediaInfo, v)
+	return u
+}
+
+// UpdateMediaInfo sets the "media_info" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateMediaInfo() *MessageUpsert {
+	u.SetExcluded(message.FieldMediaInfo)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *MessageUpsert) SetSentAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateSentAt() *MessageUpsert {
+	u.SetExcluded(message.FieldSentAt)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsert) SetEditedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateEditedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsert) ClearEditedAt() *MessageUpsert {
+	u.SetNull(message.FieldEditedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsert) SetDeletedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateDeletedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsert) ClearDeletedAt() *MessageUpsert {
+	u.SetNull(message.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(message.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageUpsertOne) UpdateNewValues() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(message.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageUpsertOne) Ignore() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageUpsertOne) DoNothing() *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageCreate.OnConflict
+// documentation for more info.
+func (u *MessageUpsertOne) Update(set func(*MessageUpsert)) *MessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMsgID sets the "msg_id" field.
+func (u *MessageUpsertOne) SetMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetMsgID(v)
+	})
+}
+
+// AddMsgID adds v to the "msg_id" field.
+func (u *MessageUpsertOne) AddMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddMsgID(v)
+	})
+}
+
+// UpdateMsgID sets the "msg_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateMsgID()
+	})
+}
+
+// SetDialogID sets the "dialog_id" field.
+func (u *MessageUpsertOne) SetDialogID(v int64) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDialogID(v)
+	})
+}
+
+// UpdateDialogID sets the "dialog_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateDialogID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDialogID()
+	})
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *MessageUpsertOne) SetSenderID(v int64) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetSenderID(v)
+	})
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateSenderID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateSenderID()
+	})
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (u *MessageUpsertOne) ClearSenderID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearSenderID()
+	})
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (u *MessageUpsertOne) SetReplyToMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReplyToMsgID(v)
+	})
+}
+
+// AddReplyToMsgID adds v to the "reply_to_msg_id" field.
+func (u *MessageUpsertOne) AddReplyToMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddReplyToMsgID(v)
+	})
+}
+
+// UpdateReplyToMsgID sets the "reply_to_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateReplyToMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReplyToMsgID()
+	})
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (u *MessageUpsertOne) ClearReplyToMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReplyToMsgID()
+	})
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (u *MessageUpsertOne) SetTopMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTopMsgID(v)
+	})
+}
+
+// AddTopMsgID adds v to the "top_msg_id" field.
+func (u *MessageUpsertOne) AddTopMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddTopMsgID(v)
+	})
+}
+
+// UpdateTopMsgID sets the "top_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateTopMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTopMsgID()
+	})
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (u *MessageUpsertOne) ClearTopMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTopMsgID()
+	})
+}
+
+// SetTopicID sets the "topic_id" field.
+func (u *MessageUpsertOne) SetTopicID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTopicID(v)
+	})
+}
+
+// AddTopicID adds v to the "topic_id" field.
+func (u *MessageUpsertOne) AddTopicID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddTopicID(v)
+	})
+}
+
+// UpdateTopicID sets the "topic_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateTopicID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTopicID()
+	})
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (u *MessageUpsertOne) ClearTopicID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTopicID()
+	})
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (u *MessageUpsertOne) SetFwdFromID(v int64) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromID(v)
+	})
+}
+
+// AddFwdFromID adds v to the "fwd_from_id" field.
+func (u *MessageUpsertOne) AddFwdFromID(v int64) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddFwdFromID(v)
+	})
+}
+
+// UpdateFwdFromID sets the "fwd_from_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateFwdFromID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromID()
+	})
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (u *MessageUpsertOne) ClearFwdFromID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromID()
+	})
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (u *MessageUpsertOne) SetFwdFromName(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromName(v)
+	})
+}
+
+// UpdateFwdFromName sets the "fwd_from_name" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateFwdFromName() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromName()
+	})
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (u *MessageUpsertOne) ClearFwdFromName() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromName()
+	})
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (u *MessageUpsertOne) SetFwdFromMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromMsgID(v)
+	})
+}
+
+// AddFwdFromMsgID adds v to the "fwd_from_msg_id" field.
+func (u *MessageUpsertOne) AddFwdFromMsgID(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddFwdFromMsgID(v)
+	})
+}
+
+// UpdateFwdFromMsgID sets the "fwd_from_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateFwdFromMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromMsgID()
+	})
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (u *MessageUpsertOne) ClearFwdFromMsgID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromMsgID()
+	})
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (u *MessageUpsertOne) SetFwdFromDate(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromDate(v)
+	})
+}
+
+// UpdateFwdFromDate sets the "fwd_from_date" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateFwdFromDate() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromDate()
+	})
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (u *MessageUpsertOne) ClearFwdFromDate() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromDate()
+	})
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (u *MessageUpsertOne) SetFwdPostAuthor(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdPostAuthor(v)
+	})
+}
+
+// UpdateFwdPostAuthor sets the "fwd_post_author" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateFwdPostAuthor() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdPostAuthor()
+	})
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (u *MessageUpsertOne) ClearFwdPostAuthor() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdPostAuthor()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *MessageUpsertOne) SetText(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateText() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetDerivedText sets the "derived_text" field.
+func (u *MessageUpsertOne) SetDerivedText(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDerivedText(v)
+	})
+}
+
+// UpdateDerivedText sets the "derived_text" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateDerivedText() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDerivedText()
+	})
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageUpsertOne) SetTextEmbedding(v pgvector.Vector) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTextEmbedding(v)
+	})
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateTextEmbedding() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTextEmbedding()
+	})
+}
+
+// ClearTextEmbedding clears the value of the "text_embedding" field.
+func (u *MessageUpsertOne) ClearTextEmbedding() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTextEmbedding()
+	})
+}
+
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (u *MessageUpsertOne) SetEmbeddingStrategy(v types.EmbeddingStrategy) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingStrategy(v)
+	})
+}
+
+// UpdateEmbeddingStrategy sets the "embedding_strategy" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingStrategy() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingStrategy()
+	})
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (u *MessageUpsertOne) ClearEmbeddingStrategy() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingStrategy()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *MessageUpsertOne) SetEmbeddingModel(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingModel() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (u *MessageUpsertOne) ClearEmbeddingModel() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingModel()
+	})
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (u *MessageUpsertOne) SetNextEmbeddingModel(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetNextEmbeddingModel(v)
+	})
+}
+
+// UpdateNextEmbeddingModel sets the "next_embedding_model" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateNextEmbeddingModel() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateNextEmbeddingModel()
+	})
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (u *MessageUpsertOne) ClearNextEmbeddingModel() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearNextEmbeddingModel()
+	})
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (u *MessageUpsertOne) SetEmbeddingAttempts(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingAttempts(v)
+	})
+}
+
+// AddEmbeddingAttempts adds v to the "embedding_attempts" field.
+func (u *MessageUpsertOne) AddEmbeddingAttempts(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddEmbeddingAttempts(v)
+	})
+}
+
+// UpdateEmbeddingAttempts sets the "embedding_attempts" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingAttempts() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingAttempts()
+	})
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (u *MessageUpsertOne) SetEmbeddingError(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingError(v)
+	})
+}
+
+// UpdateEmbeddingError sets the "embedding_error" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingError() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingError()
+	})
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (u *MessageUpsertOne) ClearEmbeddingError() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingError()
+	})
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (u *MessageUpsertOne) SetEmbeddingRetryAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingRetryAt(v)
+	})
+}
+
+// UpdateEmbeddingRetryAt sets the "embedding_retry_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingRetryAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingRetryAt()
+	})
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (u *MessageUpsertOne) ClearEmbeddingRetryAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingRetryAt()
+	})
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (u *MessageUpsertOne) SetEmbeddingFailedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingFailedAt(v)
+	})
+}
+
+// UpdateEmbeddingFailedAt sets the "embedding_failed_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingFailedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingFailedAt()
+	})
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (u *MessageUpsertOne) ClearEmbeddingFailedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingFailedAt()
+	})
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (u *MessageUpsertOne) SetEmbeddingSkipped(v bool) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingSkipped(v)
+	})
+}
+
+// UpdateEmbeddingSkipped sets the "embedding_skipped" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEmbeddingSkipped() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingSkipped()
+	})
+}
+
+// SetHasMedia sets the "has_media" field.
+func (u *MessageUpsertOne) SetHasMedia(v bool) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetHasMedia(v)
+	})
+}
+
+// UpdateHasMedia sets the "has_media" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateHasMedia() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateHasMedia()
+	})
+}
+
+// SetMediaInfo sets the "media_info" field.
+func (u *MessageUpsertOne) SetMediaInfo(v *types.MediaInfo) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetMediaInfo(v)
+	})
+}
+
+// UpdateMediaInfo sets the "media_info" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateMediaInfo() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateMediaInfo()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *MessageUpsertOne) SetSentAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateSentAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsertOne) SetEditedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateEditedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsertOne) ClearEditedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsertOne) SetDeletedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateDeletedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsertOne) ClearDeletedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MessageUpsertOne.ID is not supported by MySQL driver. Use MessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageCreateBulk is the builder for creating many Message entities in bulk.
+type MessageCreateBulk struct {
+	config
+	err      error
+	builders []*MessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Message entities in the database.
+func (mcb *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
 	nodes := make([]*Message, len(mcb.builders))
 	mutators := make([]Mutator, len(mcb.builders))
 	for i := range mcb.builders {
@@ -270,6 +1970,7 @@ func (mcb *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
 					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -319,3 +2020,670 @@ func (mcb *MessageCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Message.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageUpsert) {
+//			SetMsgID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcb *MessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageUpsertBulk {
+	mcb.conflict = opts
+	return &MessageUpsertBulk{
+		create: mcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcb *MessageCreateBulk) OnConflictColumns(columns ...string) *MessageUpsertBulk {
+	mcb.conflict = append(mcb.conflict, sql.ConflictColumns(columns...))
+	return &MessageUpsertBulk{
+		create: mcb,
+	}
+}
+
+// MessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Message nodes.
+type MessageUpsertBulk struct {
+	create *MessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(message.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageUpsertBulk) UpdateNewValues() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(message.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Message.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageUpsertBulk) Ignore() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageUpsertBulk) DoNothing() *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageUpsertBulk) Update(set func(*MessageUpsert)) *MessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMsgID sets the "msg_id" field.
+func (u *MessageUpsertBulk) SetMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetMsgID(v)
+	})
+}
+
+// AddMsgID adds v to the "msg_id" field.
+func (u *MessageUpsertBulk) AddMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddMsgID(v)
+	})
+}
+
+// UpdateMsgID sets the "msg_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateMsgID()
+	})
+}
+
+// SetDialogID sets the "dialog_id" field.
+func (u *MessageUpsertBulk) SetDialogID(v int64) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDialogID(v)
+	})
+}
+
+// UpdateDialogID sets the "dialog_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateDialogID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDialogID()
+	})
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *MessageUpsertBulk) SetSenderID(v int64) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetSenderID(v)
+	})
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateSenderID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateSenderID()
+	})
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (u *MessageUpsertBulk) ClearSenderID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearSenderID()
+	})
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (u *MessageUpsertBulk) SetReplyToMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReplyToMsgID(v)
+	})
+}
+
+// AddReplyToMsgID adds v to the "reply_to_msg_id" field.
+func (u *MessageUpsertBulk) AddReplyToMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddReplyToMsgID(v)
+	})
+}
+
+// UpdateReplyToMsgID sets the "reply_to_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateReplyToMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReplyToMsgID()
+	})
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (u *MessageUpsertBulk) ClearReplyToMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReplyToMsgID()
+	})
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (u *MessageUpsertBulk) SetTopMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTopMsgID(v)
+	})
+}
+
+// AddTopMsgID adds v to the "top_msg_id" field.
+func (u *MessageUpsertBulk) AddTopMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddTopMsgID(v)
+	})
+}
+
+// UpdateTopMsgID sets the "top_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateTopMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTopMsgID()
+	})
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (u *MessageUpsertBulk) ClearTopMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTopMsgID()
+	})
+}
+
+// SetTopicID sets the "topic_id" field.
+func (u *MessageUpsertBulk) SetTopicID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTopicID(v)
+	})
+}
+
+// AddTopicID adds v to the "topic_id" field.
+func (u *MessageUpsertBulk) AddTopicID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddTopicID(v)
+	})
+}
+
+// UpdateTopicID sets the "topic_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateTopicID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTopicID()
+	})
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (u *MessageUpsertBulk) ClearTopicID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTopicID()
+	})
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (u *MessageUpsertBulk) SetFwdFromID(v int64) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromID(v)
+	})
+}
+
+// AddFwdFromID adds v to the "fwd_from_id" field.
+func (u *MessageUpsertBulk) AddFwdFromID(v int64) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddFwdFromID(v)
+	})
+}
+
+// UpdateFwdFromID sets the "fwd_from_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateFwdFromID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromID()
+	})
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (u *MessageUpsertBulk) ClearFwdFromID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromID()
+	})
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (u *MessageUpsertBulk) SetFwdFromName(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromName(v)
+	})
+}
+
+// UpdateFwdFromName sets the "fwd_from_name" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateFwdFromName() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromName()
+	})
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (u *MessageUpsertBulk) ClearFwdFromName() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromName()
+	})
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (u *MessageUpsertBulk) SetFwdFromMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromMsgID(v)
+	})
+}
+
+// AddFwdFromMsgID adds v to the "fwd_from_msg_id" field.
+func (u *MessageUpsertBulk) AddFwdFromMsgID(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddFwdFromMsgID(v)
+	})
+}
+
+// UpdateFwdFromMsgID sets the "fwd_from_msg_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateFwdFromMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromMsgID()
+	})
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (u *MessageUpsertBulk) ClearFwdFromMsgID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromMsgID()
+	})
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (u *MessageUpsertBulk) SetFwdFromDate(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdFromDate(v)
+	})
+}
+
+// UpdateFwdFromDate sets the "fwd_from_date" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateFwdFromDate() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdFromDate()
+	})
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (u *MessageUpsertBulk) ClearFwdFromDate() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdFromDate()
+	})
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (u *MessageUpsertBulk) SetFwdPostAuthor(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetFwdPostAuthor(v)
+	})
+}
+
+// UpdateFwdPostAuthor sets the "fwd_post_author" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateFwdPostAuthor() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateFwdPostAuthor()
+	})
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (u *MessageUpsertBulk) ClearFwdPostAuthor() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearFwdPostAuthor()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *MessageUpsertBulk) SetText(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateText() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetDerivedText sets the "derived_text" field.
+func (u *MessageUpsertBulk) SetDerivedText(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDerivedText(v)
+	})
+}
+
+// UpdateDerivedText sets the "derived_text" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateDerivedText() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDerivedText()
+	})
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageUpsertBulk) SetTextEmbedding(v pgvector.Vector) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetTextEmbedding(v)
+	})
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateTextEmbedding() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateTextEmbedding()
+	})
+}
+
+// ClearTextEmbedding clears the value of the "text_embedding" field.
+func (u *MessageUpsertBulk) ClearTextEmbedding() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearTextEmbedding()
+	})
+}
+
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (u *MessageUpsertBulk) SetEmbeddingStrategy(v types.EmbeddingStrategy) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingStrategy(v)
+	})
+}
+
+// UpdateEmbeddingStrategy sets the "embedding_strategy" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingStrategy() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingStrategy()
+	})
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (u *MessageUpsertBulk) ClearEmbeddingStrategy() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingStrategy()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *MessageUpsertBulk) SetEmbeddingModel(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingModel() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (u *MessageUpsertBulk) ClearEmbeddingModel() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingModel()
+	})
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (u *MessageUpsertBulk) SetNextEmbeddingModel(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetNextEmbeddingModel(v)
+	})
+}
+
+// UpdateNextEmbeddingModel sets the "next_embedding_model" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateNextEmbeddingModel() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateNextEmbeddingModel()
+	})
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (u *MessageUpsertBulk) ClearNextEmbeddingModel() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearNextEmbeddingModel()
+	})
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (u *MessageUpsertBulk) SetEmbeddingAttempts(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingAttempts(v)
+	})
+}
+
+// AddEmbeddingAttempts adds v to the "embedding_attempts" field.
+func (u *MessageUpsertBulk) AddEmbeddingAttempts(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddEmbeddingAttempts(v)
+	})
+}
+
+// UpdateEmbeddingAttempts sets the "embedding_attempts" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingAttempts() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingAttempts()
+	})
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (u *MessageUpsertBulk) SetEmbeddingError(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingError(v)
+	})
+}
+
+// UpdateEmbeddingError sets the "embedding_error" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingError() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingError()
+	})
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (u *MessageUpsertBulk) ClearEmbeddingError() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingError()
+	})
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (u *MessageUpsertBulk) SetEmbeddingRetryAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingRetryAt(v)
+	})
+}
+
+// UpdateEmbeddingRetryAt sets the "embedding_retry_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingRetryAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingRetryAt()
+	})
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (u *MessageUpsertBulk) ClearEmbeddingRetryAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingRetryAt()
+	})
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (u *MessageUpsertBulk) SetEmbeddingFailedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingFailedAt(v)
+	})
+}
+
+// UpdateEmbeddingFailedAt sets the "embedding_failed_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingFailedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingFailedAt()
+	})
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (u *MessageUpsertBulk) ClearEmbeddingFailedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEmbeddingFailedAt()
+	})
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (u *MessageUpsertBulk) SetEmbeddingSkipped(v bool) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEmbeddingSkipped(v)
+	})
+}
+
+// UpdateEmbeddingSkipped sets the "embedding_skipped" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEmbeddingSkipped() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEmbeddingSkipped()
+	})
+}
+
+// SetHasMedia sets the "has_media" field.
+func (u *MessageUpsertBulk) SetHasMedia(v bool) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetHasMedia(v)
+	})
+}
+
+// UpdateHasMedia sets the "has_media" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateHasMedia() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateHasMedia()
+	})
+}
+
+// SetMediaInfo sets the "media_info" field.
+func (u *MessageUpsertBulk) SetMediaInfo(v *types.MediaInfo) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetMediaInfo(v)
+	})
+}
+
+// UpdateMediaInfo sets the "media_info" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateMediaInfo() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateMediaInfo()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *MessageUpsertBulk) SetSentAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateSentAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *MessageUpsertBulk) SetEditedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateEditedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *MessageUpsertBulk) ClearEditedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageUpsertBulk) SetDeletedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateDeletedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageUpsertBulk) ClearDeletedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,18 +15,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
 	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
 )
 
 // MessageQuery is the builder for querying Message entities.
 type MessageQuery struct {
 	config
-	ctx        *QueryContext
-	order      []message.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Message
-	withDialog *DialogQuery
-	modifiers  []func(*sql.Selector)
+	ctx                       *QueryContext
+	order                     []message.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Message
+	withDialog                *DialogQuery
+	withSender                *SenderQuery
+	withRevisions             *MessageRevisionQuery
+	withChunks                *MessageChunkQuery
+	withNextEmbeddings        *NextEmbeddingQuery
+	withNextEmbeddingFailures *NextEmbeddingFailureQuery
+	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +95,116 @@ func (mq *MessageQuery) QueryDialog() *DialogQuery {
 	return query
 }
 
+// QuerySender chains the current query on the "sender" edge.
+func (mq *MessageQuery) QuerySender() *SenderQuery {
+	query := (&SenderClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(sender.Table, sender.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.SenderTable, message.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (mq *MessageQuery) QueryRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChunks chains the current query on the "chunks" edge.
+func (mq *MessageQuery) QueryChunks() *MessageChunkQuery {
+	query := (&MessageChunkClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagechunk.Table, messagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ChunksTable, message.ChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNextEmbeddings chains the current query on the "next_embeddings" edge.
+func (mq *MessageQuery) QueryNextEmbeddings() *NextEmbeddingQuery {
+	query := (&NextEmbeddingClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(nextembedding.Table, nextembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.NextEmbeddingsTable, message.NextEmbeddingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNextEmbeddingFailures chains the current query on the "next_embedding_failures" edge.
+func (mq *MessageQuery) QueryNextEmbeddingFailures() *NextEmbeddingFailureQuery {
+	query := (&NextEmbeddingFailureClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(nextembeddingfailure.Table, nextembeddingfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.NextEmbeddingFailuresTable, message.NextEmbeddingFailuresColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Message entity from the query.
 // Returns a *NotFoundError when no Message was found.
 func (mq *MessageQuery) First(ctx context.Context) (*Message, error) {
@@ -271,12 +392,17 @@ func (mq *MessageQuery) Clone() *MessageQuery {
 		return nil
 	}
 	return &MessageQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]message.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Message{}, mq.predicates...),
-		withDialog: mq.withDialog.Clone(),
+		config:                    mq.config,
+		ctx:                       mq.ctx.Clone(),
+		order:                     append([]message.OrderOption{}, mq.order...),
+		inters:                    append([]Interceptor{}, mq.inters...),
+		predicates:                append([]predicate.Message{}, mq.predicates...),
+		withDialog:                mq.withDialog.Clone(),
+		withSender:                mq.withSender.Clone(),
+		withRevisions:             mq.withRevisions.Clone(),
+		withChunks:                mq.withChunks.Clone(),
+		withNextEmbeddings:        mq.withNextEmbeddings.Clone(),
+		withNextEmbeddingFailures: mq.withNextEmbeddingFailures.Clone(),
 		// clone intermediate query.
 		sql:       mq.sql.Clone(),
 		path:      mq.path,
@@ -295,6 +421,61 @@ func (mq *MessageQuery) WithDialog(opts ...func(*DialogQuery)) *MessageQuery {
 	return mq
 }
 
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithSender(opts ...func(*SenderQuery)) *MessageQuery {
+	query := (&SenderClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withSender = query
+	return mq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithRevisions(opts ...func(*MessageRevisionQuery)) *MessageQuery {
+	query := (&MessageRevisionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withRevisions = query
+	return mq
+}
+
+// WithChunks tells the query-builder to eager-load the nodes that are connected to
+// the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithChunks(opts ...func(*MessageChunkQuery)) *MessageQuery {
+	query := (&MessageChunkClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withChunks = query
+	return mq
+}
+
+// WithNextEmbeddings tells the query-builder to eager-load the nodes that are connected to
+// the "next_embeddings" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithNextEmbeddings(opts ...func(*NextEmbeddingQuery)) *MessageQuery {
+	query := (&NextEmbeddingClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withNextEmbeddings = query
+	return mq
+}
+
+// WithNextEmbeddingFailures tells the query-builder to eager-load the nodes that are connected to
+// the "next_embedding_failures" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithNextEmbeddingFailures(opts ...func(*NextEmbeddingFailureQuery)) *MessageQuery {
+	query := (&NextEmbeddingFailureClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withNextEmbeddingFailures = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +554,13 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 	var (
 		nodes       = []*Message{}
 		_spec       = mq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [6]bool{
 			mq.withDialog != nil,
+			mq.withSender != nil,
+			mq.withRevisions != nil,
+			mq.withChunks != nil,
+			mq.withNextEmbeddings != nil,
+			mq.withNextEmbeddingFailures != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -404,6 +590,42 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			return nil, err
 		}
 	}
+	if query := mq.withSender; query != nil {
+		if err := mq.loadSender(ctx, query, nodes, nil,
+			func(n *Message, e *Sender) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withRevisions; query != nil {
+		if err := mq.loadRevisions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Revisions = []*MessageRevision{} },
+			func(n *Message, e *MessageRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withChunks; query != nil {
+		if err := mq.loadChunks(ctx, query, nodes,
+			func(n *Message) { n.Edges.Chunks = []*MessageChunk{} },
+			func(n *Message, e *MessageChunk) { n.Edges.Chunks = append(n.Edges.Chunks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withNextEmbeddings; query != nil {
+		if err := mq.loadNextEmbeddings(ctx, query, nodes,
+			func(n *Message) { n.Edges.NextEmbeddings = []*NextEmbedding{} },
+			func(n *Message, e *NextEmbedding) { n.Edges.NextEmbeddings = append(n.Edges.NextEmbeddings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withNextEmbeddingFailures; query != nil {
+		if err := mq.loadNextEmbeddingFailures(ctx, query, nodes,
+			func(n *Message) { n.Edges.NextEmbeddingFailures = []*NextEmbeddingFailure{} },
+			func(n *Message, e *NextEmbeddingFailure) {
+				n.Edges.NextEmbeddingFailures = append(n.Edges.NextEmbeddingFailures, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +658,158 @@ func (mq *MessageQuery) loadDialog(ctx context.Context, query *DialogQuery, node
 	}
 	return nil
 }
+func (mq *MessageQuery) loadSender(ctx context.Context, query *SenderQuery, nodes []*Message, init func(*Message), assign func(*Message, *Sender)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*Message)
+	for i := range nodes {
+		if nodes[i].SenderID == nil {
+			continue
+		}
+		fk := *nodes[i].SenderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sender.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagerevision.FieldMessageID)
+	}
+	query.Where(predicate.MessageRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadChunks(ctx context.Context, query *MessageChunkQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagechunk.FieldMessageID)
+	}
+	query.Where(predicate.MessageChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadNextEmbeddings(ctx context.Context, query *NextEmbeddingQuery, nodes []*Message, init func(*Message), assign func(*Message, *NextEmbedding)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(nextembedding.FieldMessageID)
+	}
+	query.Where(predicate.NextEmbedding(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.NextEmbeddingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadNextEmbeddingFailures(ctx context.Context, query *NextEmbeddingFailureQuery, nodes []*Message, init func(*Message), assign func(*Message, *NextEmbeddingFailure)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(nextembeddingfailure.FieldMessageID)
+	}
+	query.Where(predicate.NextEmbeddingFailure(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.NextEmbeddingFailuresColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mq *MessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
@@ -468,6 +842,9 @@ func (mq *MessageQuery) querySpec() *sqlgraph.QuerySpec {
 		if mq.withDialog != nil {
 			_spec.Node.AddColumnOnce(message.FieldDialogID)
 		}
+		if mq.withSender != nil {
+			_spec.Node.AddColumnOnce(message.FieldSenderID)
+		}
 	}
 	if ps := mq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -11,10 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
 	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -67,6 +73,221 @@ func (mu *MessageUpdate) SetNillableDialogID(i *int64) *MessageUpdate {
 	return mu
 }
 
+// SetSenderID sets the "sender_id" field.
+func (mu *MessageUpdate) SetSenderID(i int64) *MessageUpdate {
+	mu.mutation.SetSenderID(i)
+	return mu
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableSenderID(i *int64) *MessageUpdate {
+	if i != nil {
+		mu.SetSenderID(*i)
+	}
+	return mu
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (mu *MessageUpdate) ClearSenderID() *MessageUpdate {
+	mu.mutation.ClearSenderID()
+	return mu
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (mu *MessageUpdate) SetReplyToMsgID(i int) *MessageUpdate {
+	mu.mutation.ResetReplyToMsgID()
+	mu.mutation.SetReplyToMsgID(i)
+	return mu
+}
+
+// SetNillableReplyToMsgID sets the "reply_to_msg_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableReplyToMsgID(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetReplyToMsgID(*i)
+	}
+	return mu
+}
+
+// AddReplyToMsgID adds i to the "reply_to_msg_id" field.
+func (mu *MessageUpdate) AddReplyToMsgID(i int) *MessageUpdate {
+	mu.mutation.AddReplyToMsgID(i)
+	return mu
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (mu *MessageUpdate) ClearReplyToMsgID() *MessageUpdate {
+	mu.mutation.ClearReplyToMsgID()
+	return mu
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (mu *MessageUpdate) SetTopMsgID(i int) *MessageUpdate {
+	mu.mutation.ResetTopMsgID()
+	mu.mutation.SetTopMsgID(i)
+	return mu
+}
+
+// SetNillableTopMsgID sets the "top_msg_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableTopMsgID(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetTopMsgID(*i)
+	}
+	return mu
+}
+
+// AddTopMsgID adds i to the "top_msg_id" field.
+func (mu *MessageUpdate) AddTopMsgID(i int) *MessageUpdate {
+	mu.mutation.AddTopMsgID(i)
+	return mu
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (mu *MessageUpdate) ClearTopMsgID() *MessageUpdate {
+	mu.mutation.ClearTopMsgID()
+	return mu
+}
+
+// SetTopicID sets the "topic_id" field.
+func (mu *MessageUpdate) SetTopicID(i int) *MessageUpdate {
+	mu.mutation.ResetTopicID()
+	mu.mutation.SetTopicID(i)
+	return mu
+}
+
+// SetNillableTopicID sets the "topic_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableTopicID(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetTopicID(*i)
+	}
+	return mu
+}
+
+// AddTopicID adds i to the "topic_id" field.
+func (mu *MessageUpdate) AddTopicID(i int) *MessageUpdate {
+	mu.mutation.AddTopicID(i)
+	return mu
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (mu *MessageUpdate) ClearTopicID() *MessageUpdate {
+	mu.mutation.ClearTopicID()
+	return mu
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (mu *MessageUpdate) SetFwdFromID(i int64) *MessageUpdate {
+	mu.mutation.ResetFwdFromID()
+	mu.mutation.SetFwdFromID(i)
+	return mu
+}
+
+// SetNillableFwdFromID sets the "fwd_from_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableFwdFromID(i *int64) *MessageUpdate {
+	if i != nil {
+		mu.SetFwdFromID(*i)
+	}
+	return mu
+}
+
+// AddFwdFromID adds i to the "fwd_from_id" field.
+func (mu *MessageUpdate) AddFwdFromID(i int64) *MessageUpdate {
+	mu.mutation.AddFwdFromID(i)
+	return mu
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (mu *MessageUpdate) ClearFwdFromID() *MessageUpdate {
+	mu.mutation.ClearFwdFromID()
+	return mu
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (mu *MessageUpdate) SetFwdFromName(s string) *MessageUpdate {
+	mu.mutation.SetFwdFromName(s)
+	return mu
+}
+
+// SetNillableFwdFromName sets the "fwd_from_name" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableFwdFromName(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetFwdFromName(*s)
+	}
+	return mu
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (mu *MessageUpdate) ClearFwdFromName() *MessageUpdate {
+	mu.mutation.ClearFwdFromName()
+	return mu
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (mu *MessageUpdate) SetFwdFromMsgID(i int) *MessageUpdate {
+	mu.mutation.ResetFwdFromMsgID()
+	mu.mutation.SetFwdFromMsgID(i)
+	return mu
+}
+
+// SetNillableFwdFromMsgID sets the "fwd_from_msg_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableFwdFromMsgID(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetFwdFromMsgID(*i)
+	}
+	return mu
+}
+
+// AddFwdFromMsgID adds i to the "fwd_from_msg_id" field.
+func (mu *MessageUpdate) AddFwdFromMsgID(i int) *MessageUpdate {
+	mu.mutation.AddFwdFromMsgID(i)
+	return mu
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (mu *MessageUpdate) ClearFwdFromMsgID() *MessageUpdate {
+	mu.mutation.ClearFwdFromMsgID()
+	return mu
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (mu *MessageUpdate) SetFwdFromDate(t time.Time) *MessageUpdate {
+	mu.mutation.SetFwdFromDate(t)
+	return mu
+}
+
+// SetNillableFwdFromDate sets the "fwd_from_date" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableFwdFromDate(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetFwdFromDate(*t)
+	}
+	return mu
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (mu *MessageUpdate) ClearFwdFromDate() *MessageUpdate {
+	mu.mutation.ClearFwdFromDate()
+	return mu
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (mu *MessageUpdate) SetFwdPostAuthor(s string) *MessageUpdate {
+	mu.mutation.SetFwdPostAuthor(s)
+	return mu
+}
+
+// SetNillableFwdPostAuthor sets the "fwd_post_author" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableFwdPostAuthor(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetFwdPostAuthor(*s)
+	}
+	return mu
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (mu *MessageUpdate) ClearFwdPostAuthor() *MessageUpdate {
+	mu.mutation.ClearFwdPostAuthor()
+	return mu
+}
+
 // SetText sets the "text" field.
 func (mu *MessageUpdate) SetText(s string) *MessageUpdate {
 	mu.mutation.SetText(s)
@@ -81,6 +302,20 @@ func (mu *MessageUpdate) SetNillableText(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetDerivedText sets the "derived_text" field.
+func (mu *MessageUpdate) SetDerivedText(s string) *MessageUpdate {
+	mu.mutation.SetDerivedText(s)
+	return mu
+}
+
+// SetNillableDerivedText sets the "derived_text" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDerivedText(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetDerivedText(*s)
+	}
+	return mu
+}
+
 // SetTextEmbedding sets the "text_embedding" field.
 func (mu *MessageUpdate) SetTextEmbedding(pg pgvector.Vector) *MessageUpdate {
 	mu.mutation.SetTextEmbedding(pg)
@@ -101,6 +336,161 @@ func (mu *MessageUpdate) ClearTextEmbedding() *MessageUpdate {
 	return mu
 }
 
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (mu *MessageUpdate) SetEmbeddingStrategy(ts types.EmbeddingStrategy) *MessageUpdate {
+	mu.mutation.SetEmbeddingStrategy(ts)
+	return mu
+}
+
+// SetNillableEmbeddingStrategy sets the "embedding_strategy" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingStrategy(ts *types.EmbeddingStrategy) *MessageUpdate {
+	if ts != nil {
+		mu.SetEmbeddingStrategy(*ts)
+	}
+	return mu
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (mu *MessageUpdate) ClearEmbeddingStrategy() *MessageUpdate {
+	mu.mutation.ClearEmbeddingStrategy()
+	return mu
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (mu *MessageUpdate) SetEmbeddingModel(s string) *MessageUpdate {
+	mu.mutation.SetEmbeddingModel(s)
+	return mu
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingModel(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetEmbeddingModel(*s)
+	}
+	return mu
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (mu *MessageUpdate) ClearEmbeddingModel() *MessageUpdate {
+	mu.mutation.ClearEmbeddingModel()
+	return mu
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (mu *MessageUpdate) SetNextEmbeddingModel(s string) *MessageUpdate {
+	mu.mutation.SetNextEmbeddingModel(s)
+	return mu
+}
+
+// SetNillableNextEmbeddingModel sets the "next_embedding_model" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableNextEmbeddingModel(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetNextEmbeddingModel(*s)
+	}
+	return mu
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (mu *MessageUpdate) ClearNextEmbeddingModel() *MessageUpdate {
+	mu.mutation.ClearNextEmbeddingModel()
+	return mu
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (mu *MessageUpdate) SetEmbeddingAttempts(i int) *MessageUpdate {
+	mu.mutation.ResetEmbeddingAttempts()
+	mu.mutation.SetEmbeddingAttempts(i)
+	return mu
+}
+
+// SetNillableEmbeddingAttempts sets the "embedding_attempts" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingAttempts(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetEmbeddingAttempts(*i)
+	}
+	return mu
+}
+
+// AddEmbeddingAttempts adds i to the "embedding_attempts" field.
+func (mu *MessageUpdate) AddEmbeddingAttempts(i int) *MessageUpdate {
+	mu.mutation.AddEmbeddingAttempts(i)
+	return mu
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (mu *MessageUpdate) SetEmbeddingError(s string) *MessageUpdate {
+	mu.mutation.SetEmbeddingError(s)
+	return mu
+}
+
+// SetNillableEmbeddingError sets the "embedding_error" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingError(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetEmbeddingError(*s)
+	}
+	return mu
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (mu *MessageUpdate) ClearEmbeddingError() *MessageUpdate {
+	mu.mutation.ClearEmbeddingError()
+	return mu
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (mu *MessageUpdate) SetEmbeddingRetryAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEmbeddingRetryAt(t)
+	return mu
+}
+
+// SetNillableEmbeddingRetryAt sets the "embedding_retry_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingRetryAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEmbeddingRetryAt(*t)
+	}
+	return mu
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (mu *MessageUpdate) ClearEmbeddingRetryAt() *MessageUpdate {
+	mu.mutation.ClearEmbeddingRetryAt()
+	return mu
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (mu *MessageUpdate) SetEmbeddingFailedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEmbeddingFailedAt(t)
+	return mu
+}
+
+// SetNillableEmbeddingFailedAt sets the "embedding_failed_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingFailedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEmbeddingFailedAt(*t)
+	}
+	return mu
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (mu *MessageUpdate) ClearEmbeddingFailedAt() *MessageUpdate {
+	mu.mutation.ClearEmbeddingFailedAt()
+	return mu
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (mu *MessageUpdate) SetEmbeddingSkipped(b bool) *MessageUpdate {
+	mu.mutation.SetEmbeddingSkipped(b)
+	return mu
+}
+
+// SetNillableEmbeddingSkipped sets the "embedding_skipped" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEmbeddingSkipped(b *bool) *MessageUpdate {
+	if b != nil {
+		mu.SetEmbeddingSkipped(*b)
+	}
+	return mu
+}
+
 // SetHasMedia sets the "has_media" field.
 func (mu *MessageUpdate) SetHasMedia(b bool) *MessageUpdate {
 	mu.mutation.SetHasMedia(b)
@@ -135,66 +525,266 @@ func (mu *MessageUpdate) SetNillableSentAt(t *time.Time) *MessageUpdate {
 	return mu
 }
 
-// SetDialog sets the "dialog" edge to the Dialog entity.
-func (mu *MessageUpdate) SetDialog(d *Dialog) *MessageUpdate {
-	return mu.SetDialogID(d.ID)
+// SetEditedAt sets the "edited_at" field.
+func (mu *MessageUpdate) SetEditedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetEditedAt(t)
+	return mu
 }
 
-// Mutation returns the MessageMutation object of the builder.
-func (mu *MessageUpdate) Mutation() *MessageMutation {
-	return mu.mutation
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEditedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetEditedAt(*t)
+	}
+	return mu
 }
 
-// ClearDialog clears the "dialog" edge to the Dialog entity.
-func (mu *MessageUpdate) ClearDialog() *MessageUpdate {
-	mu.mutation.ClearDialog()
+// ClearEditedAt clears the value of the "edited_at" field.
+func (mu *MessageUpdate) ClearEditedAt() *MessageUpdate {
+	mu.mutation.ClearEditedAt()
 	return mu
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MessageUpdate) SetDeletedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (mu *MessageUpdate) SaveX(ctx context.Context) int {
-	affected, err := mu.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
 	}
-	return affected
+	return mu
 }
 
-// Exec executes the query.
-func (mu *MessageUpdate) Exec(ctx context.Context) error {
-	_, err := mu.Save(ctx)
-	return err
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (mu *MessageUpdate) ExecX(ctx context.Context) {
-	if err := mu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetDialog sets the "dialog" edge to the Dialog entity.
+func (mu *MessageUpdate) SetDialog(d *Dialog) *MessageUpdate {
+	return mu.SetDialogID(d.ID)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (mu *MessageUpdate) check() error {
-	if mu.mutation.DialogCleared() && len(mu.mutation.DialogIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Message.dialog"`)
-	}
-	return nil
+// SetSender sets the "sender" edge to the Sender entity.
+func (mu *MessageUpdate) SetSender(s *Sender) *MessageUpdate {
+	return mu.SetSenderID(s.ID)
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (mu *MessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageUpdate {
-	mu.modifiers = append(mu.modifiers, modifiers...)
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mu *MessageUpdate) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddRevisionIDs(ids...)
 	return mu
 }
 
-func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := mu.check(); err != nil {
-		return n, err
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) AddRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddRevisionIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the MessageChunk entity by IDs.
+func (mu *MessageUpdate) AddChunkIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddChunkIDs(ids...)
+	return mu
+}
+
+// AddChunks adds the "chunks" edges to the MessageChunk entity.
+func (mu *MessageUpdate) AddChunks(m ...*MessageChunk) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddChunkIDs(ids...)
+}
+
+// AddNextEmbeddingIDs adds the "next_embeddings" edge to the NextEmbedding entity by IDs.
+func (mu *MessageUpdate) AddNextEmbeddingIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddNextEmbeddingIDs(ids...)
+	return mu
+}
+
+// AddNextEmbeddings adds the "next_embeddings" edges to the NextEmbedding entity.
+func (mu *MessageUpdate) AddNextEmbeddings(n ...*NextEmbedding) *MessageUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.AddNextEmbeddingIDs(ids...)
+}
+
+// AddNextEmbeddingFailureIDs adds the "next_embedding_failures" edge to the NextEmbeddingFailure entity by IDs.
+func (mu *MessageUpdate) AddNextEmbeddingFailureIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.AddNextEmbeddingFailureIDs(ids...)
+	return mu
+}
+
+// AddNextEmbeddingFailures adds the "next_embedding_failures" edges to the NextEmbeddingFailure entity.
+func (mu *MessageUpdate) AddNextEmbeddingFailures(n ...*NextEmbeddingFailure) *MessageUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.AddNextEmbeddingFailureIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (mu *MessageUpdate) Mutation() *MessageMutation {
+	return mu.mutation
+}
+
+// ClearDialog clears the "dialog" edge to the Dialog entity.
+func (mu *MessageUpdate) ClearDialog() *MessageUpdate {
+	mu.mutation.ClearDialog()
+	return mu
+}
+
+// ClearSender clears the "sender" edge to the Sender entity.
+func (mu *MessageUpdate) ClearSender() *MessageUpdate {
+	mu.mutation.ClearSender()
+	return mu
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) ClearRevisions() *MessageUpdate {
+	mu.mutation.ClearRevisions()
+	return mu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (mu *MessageUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveRevisionIDs(ids...)
+	return mu
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (mu *MessageUpdate) RemoveRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveRevisionIDs(ids...)
+}
+
+// ClearChunks clears all "chunks" edges to the MessageChunk entity.
+func (mu *MessageUpdate) ClearChunks() *MessageUpdate {
+	mu.mutation.ClearChunks()
+	return mu
+}
+
+// RemoveChunkIDs removes the "chunks" edge to MessageChunk entities by IDs.
+func (mu *MessageUpdate) RemoveChunkIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveChunkIDs(ids...)
+	return mu
+}
+
+// RemoveChunks removes "chunks" edges to MessageChunk entities.
+func (mu *MessageUpdate) RemoveChunks(m ...*MessageChunk) *MessageUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveChunkIDs(ids...)
+}
+
+// ClearNextEmbeddings clears all "next_embeddings" edges to the NextEmbedding entity.
+func (mu *MessageUpdate) ClearNextEmbeddings() *MessageUpdate {
+	mu.mutation.ClearNextEmbeddings()
+	return mu
+}
+
+// RemoveNextEmbeddingIDs removes the "next_embeddings" edge to NextEmbedding entities by IDs.
+func (mu *MessageUpdate) RemoveNextEmbeddingIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveNextEmbeddingIDs(ids...)
+	return mu
+}
+
+// RemoveNextEmbeddings removes "next_embeddings" edges to NextEmbedding entities.
+func (mu *MessageUpdate) RemoveNextEmbeddings(n ...*NextEmbedding) *MessageUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.RemoveNextEmbeddingIDs(ids...)
+}
+
+// ClearNextEmbeddingFailures clears all "next_embedding_failures" edges to the NextEmbeddingFailure entity.
+func (mu *MessageUpdate) ClearNextEmbeddingFailures() *MessageUpdate {
+	mu.mutation.ClearNextEmbeddingFailures()
+	return mu
+}
+
+// RemoveNextEmbeddingFailureIDs removes the "next_embedding_failures" edge to NextEmbeddingFailure entities by IDs.
+func (mu *MessageUpdate) RemoveNextEmbeddingFailureIDs(ids ...uuid.UUID) *MessageUpdate {
+	mu.mutation.RemoveNextEmbeddingFailureIDs(ids...)
+	return mu
+}
+
+// RemoveNextEmbeddingFailures removes "next_embedding_failures" edges to NextEmbeddingFailure entities.
+func (mu *MessageUpdate) RemoveNextEmbeddingFailures(n ...*NextEmbeddingFailure) *MessageUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.RemoveNextEmbeddingFailureIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MessageUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.EmbeddingStrategy(); ok {
+		if err := message.EmbeddingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_strategy", err: fmt.Errorf(`ent: validator failed for field "Message.embedding_strategy": %w`, err)}
+		}
+	}
+	if mu.mutation.DialogCleared() && len(mu.mutation.DialogIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Message.dialog"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mu *MessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageUpdate {
+	mu.modifiers = append(mu.modifiers, modifiers...)
+	return mu
+}
+
+func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID))
 	if ps := mu.mutation.predicates; len(ps) > 0 {
@@ -210,15 +800,126 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.AddedMsgID(); ok {
 		_spec.AddField(message.FieldMsgID, field.TypeInt, value)
 	}
+	if value, ok := mu.mutation.ReplyToMsgID(); ok {
+		_spec.SetField(message.FieldReplyToMsgID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedReplyToMsgID(); ok {
+		_spec.AddField(message.FieldReplyToMsgID, field.TypeInt, value)
+	}
+	if mu.mutation.ReplyToMsgIDCleared() {
+		_spec.ClearField(message.FieldReplyToMsgID, field.TypeInt)
+	}
+	if value, ok := mu.mutation.TopMsgID(); ok {
+		_spec.SetField(message.FieldTopMsgID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedTopMsgID(); ok {
+		_spec.AddField(message.FieldTopMsgID, field.TypeInt, value)
+	}
+	if mu.mutation.TopMsgIDCleared() {
+		_spec.ClearField(message.FieldTopMsgID, field.TypeInt)
+	}
+	if value, ok := mu.mutation.TopicID(); ok {
+		_spec.SetField(message.FieldTopicID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedTopicID(); ok {
+		_spec.AddField(message.FieldTopicID, field.TypeInt, value)
+	}
+	if mu.mutation.TopicIDCleared() {
+		_spec.ClearField(message.FieldTopicID, field.TypeInt)
+	}
+	if value, ok := mu.mutation.FwdFromID(); ok {
+		_spec.SetField(message.FieldFwdFromID, field.TypeInt64, value)
+	}
+	if value, ok := mu.mutation.AddedFwdFromID(); ok {
+		_spec.AddField(message.FieldFwdFromID, field.TypeInt64, value)
+	}
+	if mu.mutation.FwdFromIDCleared() {
+		_spec.ClearField(message.FieldFwdFromID, field.TypeInt64)
+	}
+	if value, ok := mu.mutation.FwdFromName(); ok {
+		_spec.SetField(message.FieldFwdFromName, field.TypeString, value)
+	}
+	if mu.mutation.FwdFromNameCleared() {
+		_spec.ClearField(message.FieldFwdFromName, field.TypeString)
+	}
+	if value, ok := mu.mutation.FwdFromMsgID(); ok {
+		_spec.SetField(message.FieldFwdFromMsgID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedFwdFromMsgID(); ok {
+		_spec.AddField(message.FieldFwdFromMsgID, field.TypeInt, value)
+	}
+	if mu.mutation.FwdFromMsgIDCleared() {
+		_spec.ClearField(message.FieldFwdFromMsgID, field.TypeInt)
+	}
+	if value, ok := mu.mutation.FwdFromDate(); ok {
+		_spec.SetField(message.FieldFwdFromDate, field.TypeTime, value)
+	}
+	if mu.mutation.FwdFromDateCleared() {
+		_spec.ClearField(message.FieldFwdFromDate, field.TypeTime)
+	}
+	if value, ok := mu.mutation.FwdPostAuthor(); ok {
+		_spec.SetField(message.FieldFwdPostAuthor, field.TypeString, value)
+	}
+	if mu.mutation.FwdPostAuthorCleared() {
+		_spec.ClearField(message.FieldFwdPostAuthor, field.TypeString)
+	}
 	if value, ok := mu.mutation.Text(); ok {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.DerivedText(); ok {
+		_spec.SetField(message.FieldDerivedText, field.TypeString, value)
+	}
 	if value, ok := mu.mutation.TextEmbedding(); ok {
 		_spec.SetField(message.FieldTextEmbedding, field.TypeOther, value)
 	}
 	if mu.mutation.TextEmbeddingCleared() {
 		_spec.ClearField(message.FieldTextEmbedding, field.TypeOther)
 	}
+	if value, ok := mu.mutation.EmbeddingStrategy(); ok {
+		_spec.SetField(message.FieldEmbeddingStrategy, field.TypeEnum, value)
+	}
+	if mu.mutation.EmbeddingStrategyCleared() {
+		_spec.ClearField(message.FieldEmbeddingStrategy, field.TypeEnum)
+	}
+	if value, ok := mu.mutation.EmbeddingModel(); ok {
+		_spec.SetField(message.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if mu.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(message.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := mu.mutation.NextEmbeddingModel(); ok {
+		_spec.SetField(message.FieldNextEmbeddingModel, field.TypeString, value)
+	}
+	if mu.mutation.NextEmbeddingModelCleared() {
+		_spec.ClearField(message.FieldNextEmbeddingModel, field.TypeString)
+	}
+	if value, ok := mu.mutation.EmbeddingAttempts(); ok {
+		_spec.SetField(message.FieldEmbeddingAttempts, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedEmbeddingAttempts(); ok {
+		_spec.AddField(message.FieldEmbeddingAttempts, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.EmbeddingError(); ok {
+		_spec.SetField(message.FieldEmbeddingError, field.TypeString, value)
+	}
+	if mu.mutation.EmbeddingErrorCleared() {
+		_spec.ClearField(message.FieldEmbeddingError, field.TypeString)
+	}
+	if value, ok := mu.mutation.EmbeddingRetryAt(); ok {
+		_spec.SetField(message.FieldEmbeddingRetryAt, field.TypeTime, value)
+	}
+	if mu.mutation.EmbeddingRetryAtCleared() {
+		_spec.ClearField(message.FieldEmbeddingRetryAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.EmbeddingFailedAt(); ok {
+		_spec.SetField(message.FieldEmbeddingFailedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EmbeddingFailedAtCleared() {
+		_spec.ClearField(message.FieldEmbeddingFailedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.EmbeddingSkipped(); ok {
+		_spec.SetField(message.FieldEmbeddingSkipped, field.TypeBool, value)
+	}
 	if value, ok := mu.mutation.HasMedia(); ok {
 		_spec.SetField(message.FieldHasMedia, field.TypeBool, value)
 	}
@@ -228,6 +929,18 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.SentAt(); ok {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := mu.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if mu.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
 	if mu.mutation.DialogCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -257,145 +970,933 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(mu.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{message.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if mu.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	mu.mutation.done = true
-	return n, nil
-}
-
-// MessageUpdateOne is the builder for updating a single Message entity.
-type MessageUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *MessageMutation
-	modifiers []func(*sql.UpdateBuilder)
+	if nodes := mu.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedChunksIDs(); len(nodes) > 0 && !mu.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.NextEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedNextEmbeddingsIDs(); len(nodes) > 0 && !mu.mutation.NextEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.NextEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.NextEmbeddingFailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedNextEmbeddingFailuresIDs(); len(nodes) > 0 && !mu.mutation.NextEmbeddingFailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.NextEmbeddingFailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMsgID sets the "msg_id" field.
+func (muo *MessageUpdateOne) SetMsgID(i int) *MessageUpdateOne {
+	muo.mutation.ResetMsgID()
+	muo.mutation.SetMsgID(i)
+	return muo
+}
+
+// SetNillableMsgID sets the "msg_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableMsgID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetMsgID(*i)
+	}
+	return muo
+}
+
+// AddMsgID adds i to the "msg_id" field.
+func (muo *MessageUpdateOne) AddMsgID(i int) *MessageUpdateOne {
+	muo.mutation.AddMsgID(i)
+	return muo
+}
+
+// SetDialogID sets the "dialog_id" field.
+func (muo *MessageUpdateOne) SetDialogID(i int64) *MessageUpdateOne {
+	muo.mutation.SetDialogID(i)
+	return muo
+}
+
+// SetNillableDialogID sets the "dialog_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDialogID(i *int64) *MessageUpdateOne {
+	if i != nil {
+		muo.SetDialogID(*i)
+	}
+	return muo
+}
+
+// SetSenderID sets the "sender_id" field.
+func (muo *MessageUpdateOne) SetSenderID(i int64) *MessageUpdateOne {
+	muo.mutation.SetSenderID(i)
+	return muo
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSenderID(i *int64) *MessageUpdateOne {
+	if i != nil {
+		muo.SetSenderID(*i)
+	}
+	return muo
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (muo *MessageUpdateOne) ClearSenderID() *MessageUpdateOne {
+	muo.mutation.ClearSenderID()
+	return muo
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (muo *MessageUpdateOne) SetReplyToMsgID(i int) *MessageUpdateOne {
+	muo.mutation.ResetReplyToMsgID()
+	muo.mutation.SetReplyToMsgID(i)
+	return muo
+}
+
+// SetNillableReplyToMsgID sets the "reply_to_msg_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableReplyToMsgID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetReplyToMsgID(*i)
+	}
+	return muo
+}
+
+// AddReplyToMsgID adds i to the "reply_to_msg_id" field.
+func (muo *MessageUpdateOne) AddReplyToMsgID(i int) *MessageUpdateOne {
+	muo.mutation.AddReplyToMsgID(i)
+	return muo
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (muo *MessageUpdateOne) ClearReplyToMsgID() *MessageUpdateOne {
+	muo.mutation.ClearReplyToMsgID()
+	return muo
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (muo *MessageUpdateOne) SetTopMsgID(i int) *MessageUpdateOne {
+	muo.mutation.ResetTopMsgID()
+	muo.mutation.SetTopMsgID(i)
+	return muo
+}
+
+// SetNillableTopMsgID sets the "top_msg_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableTopMsgID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetTopMsgID(*i)
+	}
+	return muo
+}
+
+// AddTopMsgID adds i to the "top_msg_id" field.
+func (muo *MessageUpdateOne) AddTopMsgID(i int) *MessageUpdateOne {
+	muo.mutation.AddTopMsgID(i)
+	return muo
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (muo *MessageUpdateOne) ClearTopMsgID() *MessageUpdateOne {
+	muo.mutation.ClearTopMsgID()
+	return muo
+}
+
+// SetTopicID sets the "topic_id" field.
+func (muo *MessageUpdateOne) SetTopicID(i int) *MessageUpdateOne {
+	muo.mutation.ResetTopicID()
+	muo.mutation.SetTopicID(i)
+	return muo
+}
+
+// SetNillableTopicID sets the "topic_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableTopicID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetTopicID(*i)
+	}
+	return muo
+}
+
+// AddTopicID adds i to the "topic_id" field.
+func (muo *MessageUpdateOne) AddTopicID(i int) *MessageUpdateOne {
+	muo.mutation.AddTopicID(i)
+	return muo
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (muo *MessageUpdateOne) ClearTopicID() *MessageUpdateOne {
+	muo.mutation.ClearTopicID()
+	return muo
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (muo *MessageUpdateOne) SetFwdFromID(i int64) *MessageUpdateOne {
+	muo.mutation.ResetFwdFromID()
+	muo.mutation.SetFwdFromID(i)
+	return muo
+}
+
+// SetNillableFwdFromID sets the "fwd_from_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFwdFromID(i *int64) *MessageUpdateOne {
+	if i != nil {
+		muo.SetFwdFromID(*i)
+	}
+	return muo
+}
+
+// AddFwdFromID adds i to the "fwd_from_id" field.
+func (muo *MessageUpdateOne) AddFwdFromID(i int64) *MessageUpdateOne {
+	muo.mutation.AddFwdFromID(i)
+	return muo
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (muo *MessageUpdateOne) ClearFwdFromID() *MessageUpdateOne {
+	muo.mutation.ClearFwdFromID()
+	return muo
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (muo *MessageUpdateOne) SetFwdFromName(s string) *MessageUpdateOne {
+	muo.mutation.SetFwdFromName(s)
+	return muo
+}
+
+// SetNillableFwdFromName sets the "fwd_from_name" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFwdFromName(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetFwdFromName(*s)
+	}
+	return muo
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (muo *MessageUpdateOne) ClearFwdFromName() *MessageUpdateOne {
+	muo.mutation.ClearFwdFromName()
+	return muo
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (muo *MessageUpdateOne) SetFwdFromMsgID(i int) *MessageUpdateOne {
+	muo.mutation.ResetFwdFromMsgID()
+	muo.mutation.SetFwdFromMsgID(i)
+	return muo
+}
+
+// SetNillableFwdFromMsgID sets the "fwd_from_msg_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFwdFromMsgID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetFwdFromMsgID(*i)
+	}
+	return muo
+}
+
+// AddFwdFromMsgID adds i to the "fwd_from_msg_id" field.
+func (muo *MessageUpdateOne) AddFwdFromMsgID(i int) *MessageUpdateOne {
+	muo.mutation.AddFwdFromMsgID(i)
+	return muo
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (muo *MessageUpdateOne) ClearFwdFromMsgID() *MessageUpdateOne {
+	muo.mutation.ClearFwdFromMsgID()
+	return muo
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (muo *MessageUpdateOne) SetFwdFromDate(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetFwdFromDate(t)
+	return muo
+}
+
+// SetNillableFwdFromDate sets the "fwd_from_date" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFwdFromDate(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetFwdFromDate(*t)
+	}
+	return muo
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (muo *MessageUpdateOne) ClearFwdFromDate() *MessageUpdateOne {
+	muo.mutation.ClearFwdFromDate()
+	return muo
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (muo *MessageUpdateOne) SetFwdPostAuthor(s string) *MessageUpdateOne {
+	muo.mutation.SetFwdPostAuthor(s)
+	return muo
+}
+
+// SetNillableFwdPostAuthor sets the "fwd_post_author" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableFwdPostAuthor(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetFwdPostAuthor(*s)
+	}
+	return muo
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (muo *MessageUpdateOne) ClearFwdPostAuthor() *MessageUpdateOne {
+	muo.mutation.ClearFwdPostAuthor()
+	return muo
+}
+
+// SetText sets the "text" field.
+func (muo *MessageUpdateOne) SetText(s string) *MessageUpdateOne {
+	muo.mutation.SetText(s)
+	return muo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableText(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetText(*s)
+	}
+	return muo
+}
+
+// SetDerivedText sets the "derived_text" field.
+func (muo *MessageUpdateOne) SetDerivedText(s string) *MessageUpdateOne {
+	muo.mutation.SetDerivedText(s)
+	return muo
+}
+
+// SetNillableDerivedText sets the "derived_text" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDerivedText(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetDerivedText(*s)
+	}
+	return muo
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (muo *MessageUpdateOne) SetTextEmbedding(pg pgvector.Vector) *MessageUpdateOne {
+	muo.mutation.SetTextEmbedding(pg)
+	return muo
+}
+
+// SetNillableTextEmbedding sets the "text_embedding" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableTextEmbedding(pg *pgvector.Vector) *MessageUpdateOne {
+	if pg != nil {
+		muo.SetTextEmbedding(*pg)
+	}
+	return muo
+}
+
+// ClearTextEmbedding clears the value of the "text_embedding" field.
+func (muo *MessageUpdateOne) ClearTextEmbedding() *MessageUpdateOne {
+	muo.mutation.ClearTextEmbedding()
+	return muo
+}
+
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (muo *MessageUpdateOne) SetEmbeddingStrategy(ts types.EmbeddingStrategy) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingStrategy(ts)
+	return muo
+}
+
+// SetNillableEmbeddingStrategy sets the "embedding_strategy" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingStrategy(ts *types.EmbeddingStrategy) *MessageUpdateOne {
+	if ts != nil {
+		muo.SetEmbeddingStrategy(*ts)
+	}
+	return muo
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (muo *MessageUpdateOne) ClearEmbeddingStrategy() *MessageUpdateOne {
+	muo.mutation.ClearEmbeddingStrategy()
+	return muo
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (muo *MessageUpdateOne) SetEmbeddingModel(s string) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingModel(s)
+	return muo
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingModel(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetEmbeddingModel(*s)
+	}
+	return muo
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (muo *MessageUpdateOne) ClearEmbeddingModel() *MessageUpdateOne {
+	muo.mutation.ClearEmbeddingModel()
+	return muo
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (muo *MessageUpdateOne) SetNextEmbeddingModel(s string) *MessageUpdateOne {
+	muo.mutation.SetNextEmbeddingModel(s)
+	return muo
+}
+
+// SetNillableNextEmbeddingModel sets the "next_embedding_model" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableNextEmbeddingModel(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetNextEmbeddingModel(*s)
+	}
+	return muo
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (muo *MessageUpdateOne) ClearNextEmbeddingModel() *MessageUpdateOne {
+	muo.mutation.ClearNextEmbeddingModel()
+	return muo
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (muo *MessageUpdateOne) SetEmbeddingAttempts(i int) *MessageUpdateOne {
+	muo.mutation.ResetEmbeddingAttempts()
+	muo.mutation.SetEmbeddingAttempts(i)
+	return muo
+}
+
+// SetNillableEmbeddingAttempts sets the "embedding_attempts" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingAttempts(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetEmbeddingAttempts(*i)
+	}
+	return muo
+}
+
+// AddEmbeddingAttempts adds i to the "embedding_attempts" field.
+func (muo *MessageUpdateOne) AddEmbeddingAttempts(i int) *MessageUpdateOne {
+	muo.mutation.AddEmbeddingAttempts(i)
+	return muo
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (muo *MessageUpdateOne) SetEmbeddingError(s string) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingError(s)
+	return muo
+}
+
+// SetNillableEmbeddingError sets the "embedding_error" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingError(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetEmbeddingError(*s)
+	}
+	return muo
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (muo *MessageUpdateOne) ClearEmbeddingError() *MessageUpdateOne {
+	muo.mutation.ClearEmbeddingError()
+	return muo
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (muo *MessageUpdateOne) SetEmbeddingRetryAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingRetryAt(t)
+	return muo
+}
+
+// SetNillableEmbeddingRetryAt sets the "embedding_retry_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingRetryAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEmbeddingRetryAt(*t)
+	}
+	return muo
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (muo *MessageUpdateOne) ClearEmbeddingRetryAt() *MessageUpdateOne {
+	muo.mutation.ClearEmbeddingRetryAt()
+	return muo
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (muo *MessageUpdateOne) SetEmbeddingFailedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingFailedAt(t)
+	return muo
+}
+
+// SetNillableEmbeddingFailedAt sets the "embedding_failed_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingFailedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEmbeddingFailedAt(*t)
+	}
+	return muo
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (muo *MessageUpdateOne) ClearEmbeddingFailedAt() *MessageUpdateOne {
+	muo.mutation.ClearEmbeddingFailedAt()
+	return muo
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (muo *MessageUpdateOne) SetEmbeddingSkipped(b bool) *MessageUpdateOne {
+	muo.mutation.SetEmbeddingSkipped(b)
+	return muo
+}
+
+// SetNillableEmbeddingSkipped sets the "embedding_skipped" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEmbeddingSkipped(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetEmbeddingSkipped(*b)
+	}
+	return muo
+}
+
+// SetHasMedia sets the "has_media" field.
+func (muo *MessageUpdateOne) SetHasMedia(b bool) *MessageUpdateOne {
+	muo.mutation.SetHasMedia(b)
+	return muo
+}
+
+// SetNillableHasMedia sets the "has_media" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableHasMedia(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetHasMedia(*b)
+	}
+	return muo
+}
+
+// SetMediaInfo sets the "media_info" field.
+func (muo *MessageUpdateOne) SetMediaInfo(ti *types.MediaInfo) *MessageUpdateOne {
+	muo.mutation.SetMediaInfo(ti)
+	return muo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (muo *MessageUpdateOne) SetSentAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetSentAt(t)
+	return muo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSentAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetSentAt(*t)
+	}
+	return muo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEditedAt(t)
+	return muo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEditedAt(*t)
+	}
+	return muo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	muo.mutation.ClearEditedAt()
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetDialog sets the "dialog" edge to the Dialog entity.
+func (muo *MessageUpdateOne) SetDialog(d *Dialog) *MessageUpdateOne {
+	return muo.SetDialogID(d.ID)
+}
+
+// SetSender sets the "sender" edge to the Sender entity.
+func (muo *MessageUpdateOne) SetSender(s *Sender) *MessageUpdateOne {
+	return muo.SetSenderID(s.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (muo *MessageUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddRevisionIDs(ids...)
+	return muo
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) AddRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddRevisionIDs(ids...)
 }
 
-// SetMsgID sets the "msg_id" field.
-func (muo *MessageUpdateOne) SetMsgID(i int) *MessageUpdateOne {
-	muo.mutation.ResetMsgID()
-	muo.mutation.SetMsgID(i)
+// AddChunkIDs adds the "chunks" edge to the MessageChunk entity by IDs.
+func (muo *MessageUpdateOne) AddChunkIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddChunkIDs(ids...)
 	return muo
 }
 
-// SetNillableMsgID sets the "msg_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableMsgID(i *int) *MessageUpdateOne {
-	if i != nil {
-		muo.SetMsgID(*i)
+// AddChunks adds the "chunks" edges to the MessageChunk entity.
+func (muo *MessageUpdateOne) AddChunks(m ...*MessageChunk) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return muo
+	return muo.AddChunkIDs(ids...)
 }
 
-// AddMsgID adds i to the "msg_id" field.
-func (muo *MessageUpdateOne) AddMsgID(i int) *MessageUpdateOne {
-	muo.mutation.AddMsgID(i)
+// AddNextEmbeddingIDs adds the "next_embeddings" edge to the NextEmbedding entity by IDs.
+func (muo *MessageUpdateOne) AddNextEmbeddingIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddNextEmbeddingIDs(ids...)
 	return muo
 }
 
-// SetDialogID sets the "dialog_id" field.
-func (muo *MessageUpdateOne) SetDialogID(i int64) *MessageUpdateOne {
-	muo.mutation.SetDialogID(i)
+// AddNextEmbeddings adds the "next_embeddings" edges to the NextEmbedding entity.
+func (muo *MessageUpdateOne) AddNextEmbeddings(n ...*NextEmbedding) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.AddNextEmbeddingIDs(ids...)
+}
+
+// AddNextEmbeddingFailureIDs adds the "next_embedding_failures" edge to the NextEmbeddingFailure entity by IDs.
+func (muo *MessageUpdateOne) AddNextEmbeddingFailureIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.AddNextEmbeddingFailureIDs(ids...)
 	return muo
 }
 
-// SetNillableDialogID sets the "dialog_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableDialogID(i *int64) *MessageUpdateOne {
-	if i != nil {
-		muo.SetDialogID(*i)
+// AddNextEmbeddingFailures adds the "next_embedding_failures" edges to the NextEmbeddingFailure entity.
+func (muo *MessageUpdateOne) AddNextEmbeddingFailures(n ...*NextEmbeddingFailure) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
 	}
+	return muo.AddNextEmbeddingFailureIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearDialog clears the "dialog" edge to the Dialog entity.
+func (muo *MessageUpdateOne) ClearDialog() *MessageUpdateOne {
+	muo.mutation.ClearDialog()
 	return muo
 }
 
-// SetText sets the "text" field.
-func (muo *MessageUpdateOne) SetText(s string) *MessageUpdateOne {
-	muo.mutation.SetText(s)
+// ClearSender clears the "sender" edge to the Sender entity.
+func (muo *MessageUpdateOne) ClearSender() *MessageUpdateOne {
+	muo.mutation.ClearSender()
 	return muo
 }
 
-// SetNillableText sets the "text" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableText(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetText(*s)
-	}
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	muo.mutation.ClearRevisions()
 	return muo
 }
 
-// SetTextEmbedding sets the "text_embedding" field.
-func (muo *MessageUpdateOne) SetTextEmbedding(pg pgvector.Vector) *MessageUpdateOne {
-	muo.mutation.SetTextEmbedding(pg)
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (muo *MessageUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveRevisionIDs(ids...)
 	return muo
 }
 
-// SetNillableTextEmbedding sets the "text_embedding" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableTextEmbedding(pg *pgvector.Vector) *MessageUpdateOne {
-	if pg != nil {
-		muo.SetTextEmbedding(*pg)
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (muo *MessageUpdateOne) RemoveRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return muo
+	return muo.RemoveRevisionIDs(ids...)
 }
 
-// ClearTextEmbedding clears the value of the "text_embedding" field.
-func (muo *MessageUpdateOne) ClearTextEmbedding() *MessageUpdateOne {
-	muo.mutation.ClearTextEmbedding()
+// ClearChunks clears all "chunks" edges to the MessageChunk entity.
+func (muo *MessageUpdateOne) ClearChunks() *MessageUpdateOne {
+	muo.mutation.ClearChunks()
 	return muo
 }
 
-// SetHasMedia sets the "has_media" field.
-func (muo *MessageUpdateOne) SetHasMedia(b bool) *MessageUpdateOne {
-	muo.mutation.SetHasMedia(b)
+// RemoveChunkIDs removes the "chunks" edge to MessageChunk entities by IDs.
+func (muo *MessageUpdateOne) RemoveChunkIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveChunkIDs(ids...)
 	return muo
 }
 
-// SetNillableHasMedia sets the "has_media" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableHasMedia(b *bool) *MessageUpdateOne {
-	if b != nil {
-		muo.SetHasMedia(*b)
+// RemoveChunks removes "chunks" edges to MessageChunk entities.
+func (muo *MessageUpdateOne) RemoveChunks(m ...*MessageChunk) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return muo
+	return muo.RemoveChunkIDs(ids...)
 }
 
-// SetMediaInfo sets the "media_info" field.
-func (muo *MessageUpdateOne) SetMediaInfo(ti *types.MediaInfo) *MessageUpdateOne {
-	muo.mutation.SetMediaInfo(ti)
+// ClearNextEmbeddings clears all "next_embeddings" edges to the NextEmbedding entity.
+func (muo *MessageUpdateOne) ClearNextEmbeddings() *MessageUpdateOne {
+	muo.mutation.ClearNextEmbeddings()
 	return muo
 }
 
-// SetSentAt sets the "sent_at" field.
-func (muo *MessageUpdateOne) SetSentAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetSentAt(t)
+// RemoveNextEmbeddingIDs removes the "next_embeddings" edge to NextEmbedding entities by IDs.
+func (muo *MessageUpdateOne) RemoveNextEmbeddingIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveNextEmbeddingIDs(ids...)
 	return muo
 }
 
-// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableSentAt(t *time.Time) *MessageUpdateOne {
-	if t != nil {
-		muo.SetSentAt(*t)
+// RemoveNextEmbeddings removes "next_embeddings" edges to NextEmbedding entities.
+func (muo *MessageUpdateOne) RemoveNextEmbeddings(n ...*NextEmbedding) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
 	}
-	return muo
+	return muo.RemoveNextEmbeddingIDs(ids...)
 }
 
-// SetDialog sets the "dialog" edge to the Dialog entity.
-func (muo *MessageUpdateOne) SetDialog(d *Dialog) *MessageUpdateOne {
-	return muo.SetDialogID(d.ID)
+// ClearNextEmbeddingFailures clears all "next_embedding_failures" edges to the NextEmbeddingFailure entity.
+func (muo *MessageUpdateOne) ClearNextEmbeddingFailures() *MessageUpdateOne {
+	muo.mutation.ClearNextEmbeddingFailures()
+	return muo
 }
 
-// Mutation returns the MessageMutation object of the builder.
-func (muo *MessageUpdateOne) Mutation() *MessageMutation {
-	return muo.mutation
+// RemoveNextEmbeddingFailureIDs removes the "next_embedding_failures" edge to NextEmbeddingFailure entities by IDs.
+func (muo *MessageUpdateOne) RemoveNextEmbeddingFailureIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	muo.mutation.RemoveNextEmbeddingFailureIDs(ids...)
+	return muo
 }
 
-// ClearDialog clears the "dialog" edge to the Dialog entity.
-func (muo *MessageUpdateOne) ClearDialog() *MessageUpdateOne {
-	muo.mutation.ClearDialog()
-	return muo
+// RemoveNextEmbeddingFailures removes "next_embedding_failures" edges to NextEmbeddingFailure entities.
+func (muo *MessageUpdateOne) RemoveNextEmbeddingFailures(n ...*NextEmbeddingFailure) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.RemoveNextEmbeddingFailureIDs(ids...)
 }
 
 // Where appends a list predicates to the MessageUpdate builder.
@@ -440,6 +1941,11 @@ func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.EmbeddingStrategy(); ok {
+		if err := message.EmbeddingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_strategy", err: fmt.Errorf(`ent: validator failed for field "Message.embedding_strategy": %w`, err)}
+		}
+	}
 	if muo.mutation.DialogCleared() && len(muo.mutation.DialogIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.dialog"`)
 	}
@@ -487,15 +1993,126 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.AddedMsgID(); ok {
 		_spec.AddField(message.FieldMsgID, field.TypeInt, value)
 	}
+	if value, ok := muo.mutation.ReplyToMsgID(); ok {
+		_spec.SetField(message.FieldReplyToMsgID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedReplyToMsgID(); ok {
+		_spec.AddField(message.FieldReplyToMsgID, field.TypeInt, value)
+	}
+	if muo.mutation.ReplyToMsgIDCleared() {
+		_spec.ClearField(message.FieldReplyToMsgID, field.TypeInt)
+	}
+	if value, ok := muo.mutation.TopMsgID(); ok {
+		_spec.SetField(message.FieldTopMsgID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedTopMsgID(); ok {
+		_spec.AddField(message.FieldTopMsgID, field.TypeInt, value)
+	}
+	if muo.mutation.TopMsgIDCleared() {
+		_spec.ClearField(message.FieldTopMsgID, field.TypeInt)
+	}
+	if value, ok := muo.mutation.TopicID(); ok {
+		_spec.SetField(message.FieldTopicID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedTopicID(); ok {
+		_spec.AddField(message.FieldTopicID, field.TypeInt, value)
+	}
+	if muo.mutation.TopicIDCleared() {
+		_spec.ClearField(message.FieldTopicID, field.TypeInt)
+	}
+	if value, ok := muo.mutation.FwdFromID(); ok {
+		_spec.SetField(message.FieldFwdFromID, field.TypeInt64, value)
+	}
+	if value, ok := muo.mutation.AddedFwdFromID(); ok {
+		_spec.AddField(message.FieldFwdFromID, field.TypeInt64, value)
+	}
+	if muo.mutation.FwdFromIDCleared() {
+		_spec.ClearField(message.FieldFwdFromID, field.TypeInt64)
+	}
+	if value, ok := muo.mutation.FwdFromName(); ok {
+		_spec.SetField(message.FieldFwdFromName, field.TypeString, value)
+	}
+	if muo.mutation.FwdFromNameCleared() {
+		_spec.ClearField(message.FieldFwdFromName, field.TypeString)
+	}
+	if value, ok := muo.mutation.FwdFromMsgID(); ok {
+		_spec.SetField(message.FieldFwdFromMsgID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedFwdFromMsgID(); ok {
+		_spec.AddField(message.FieldFwdFromMsgID, field.TypeInt, value)
+	}
+	if muo.mutation.FwdFromMsgIDCleared() {
+		_spec.ClearField(message.FieldFwdFromMsgID, field.TypeInt)
+	}
+	if value, ok := muo.mutation.FwdFromDate(); ok {
+		_spec.SetField(message.FieldFwdFromDate, field.TypeTime, value)
+	}
+	if muo.mutation.FwdFromDateCleared() {
+		_spec.ClearField(message.FieldFwdFromDate, field.TypeTime)
+	}
+	if value, ok := muo.mutation.FwdPostAuthor(); ok {
+		_spec.SetField(message.FieldFwdPostAuthor, field.TypeString, value)
+	}
+	if muo.mutation.FwdPostAuthorCleared() {
+		_spec.ClearField(message.FieldFwdPostAuthor, field.TypeString)
+	}
 	if value, ok := muo.mutation.Text(); ok {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.DerivedText(); ok {
+		_spec.SetField(message.FieldDerivedText, field.TypeString, value)
+	}
 	if value, ok := muo.mutation.TextEmbedding(); ok {
 		_spec.SetField(message.FieldTextEmbedding, field.TypeOther, value)
 	}
 	if muo.mutation.TextEmbeddingCleared() {
 		_spec.ClearField(message.FieldTextEmbedding, field.TypeOther)
 	}
+	if value, ok := muo.mutation.EmbeddingStrategy(); ok {
+		_spec.SetField(message.FieldEmbeddingStrategy, field.TypeEnum, value)
+	}
+	if muo.mutation.EmbeddingStrategyCleared() {
+		_spec.ClearField(message.FieldEmbeddingStrategy, field.TypeEnum)
+	}
+	if value, ok := muo.mutation.EmbeddingModel(); ok {
+		_spec.SetField(message.FieldEmbeddingModel, field.TypeString, value)
+	}
+	if muo.mutation.EmbeddingModelCleared() {
+		_spec.ClearField(message.FieldEmbeddingModel, field.TypeString)
+	}
+	if value, ok := muo.mutation.NextEmbeddingModel(); ok {
+		_spec.SetField(message.FieldNextEmbeddingModel, field.TypeString, value)
+	}
+	if muo.mutation.NextEmbeddingModelCleared() {
+		_spec.ClearField(message.FieldNextEmbeddingModel, field.TypeString)
+	}
+	if value, ok := muo.mutation.EmbeddingAttempts(); ok {
+		_spec.SetField(message.FieldEmbeddingAttempts, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedEmbeddingAttempts(); ok {
+		_spec.AddField(message.FieldEmbeddingAttempts, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.EmbeddingError(); ok {
+		_spec.SetField(message.FieldEmbeddingError, field.TypeString, value)
+	}
+	if muo.mutation.EmbeddingErrorCleared() {
+		_spec.ClearField(message.FieldEmbeddingError, field.TypeString)
+	}
+	if value, ok := muo.mutation.EmbeddingRetryAt(); ok {
+		_spec.SetField(message.FieldEmbeddingRetryAt, field.TypeTime, value)
+	}
+	if muo.mutation.EmbeddingRetryAtCleared() {
+		_spec.ClearField(message.FieldEmbeddingRetryAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.EmbeddingFailedAt(); ok {
+		_spec.SetField(message.FieldEmbeddingFailedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EmbeddingFailedAtCleared() {
+		_spec.ClearField(message.FieldEmbeddingFailedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.EmbeddingSkipped(); ok {
+		_spec.SetField(message.FieldEmbeddingSkipped, field.TypeBool, value)
+	}
 	if value, ok := muo.mutation.HasMedia(); ok {
 		_spec.SetField(message.FieldHasMedia, field.TypeBool, value)
 	}
@@ -505,6 +2122,18 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.SentAt(); ok {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := muo.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
 	if muo.mutation.DialogCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -534,6 +2163,215 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedChunksIDs(); len(nodes) > 0 && !muo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ChunksTable,
+			Columns: []string{message.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.NextEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedNextEmbeddingsIDs(); len(nodes) > 0 && !muo.mutation.NextEmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.NextEmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingsTable,
+			Columns: []string{message.NextEmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.NextEmbeddingFailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedNextEmbeddingFailuresIDs(); len(nodes) > 0 && !muo.mutation.NextEmbeddingFailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.NextEmbeddingFailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.NextEmbeddingFailuresTable,
+			Columns: []string{message.NextEmbeddingFailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(muo.modifiers...)
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+)
+
+// MessageChunk is the model entity for the MessageChunk schema.
+type MessageChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// Index holds the value of the "index" field.
+	Index int `json:"index,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// TextEmbedding holds the value of the "text_embedding" field.
+	TextEmbedding pgvector.Vector `json:"text_embedding,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageChunkQuery when eager-loading is set.
+	Edges        MessageChunkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageChunkEdges holds the relations/edges for other nodes in the graph.
+type MessageChunkEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageChunkEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagechunk.FieldTextEmbedding:
+			values[i] = new(pgvector.Vector)
+		case messagechunk.FieldIndex:
+			values[i] = new(sql.NullInt64)
+		case messagechunk.FieldText:
+			values[i] = new(sql.NullString)
+		case messagechunk.FieldID, messagechunk.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageChunk fields.
+func (mc *MessageChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagechunk.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mc.ID = *value
+			}
+		case messagechunk.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				mc.MessageID = *value
+			}
+		case messagechunk.FieldIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field index", values[i])
+			} else if value.Valid {
+				mc.Index = int(value.Int64)
+			}
+		case messagechunk.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				mc.Text = value.String
+			}
+		case messagechunk.FieldTextEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field text_embedding", values[i])
+			} else if value != nil {
+				mc.TextEmbedding = *value
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageChunk.
+// This includes values selected through modifiers, order, etc.
+func (mc *MessageChunk) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageChunk entity.
+func (mc *MessageChunk) QueryMessage() *MessageQuery {
+	return NewMessageChunkClient(mc.config).QueryMessage(mc)
+}
+
+// Update returns a builder for updating this MessageChunk.
+// Note that you need to call MessageChunk.Unwrap() before calling this method if this MessageChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *MessageChunk) Update() *MessageChunkUpdateOne {
+	return NewMessageChunkClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the MessageChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *MessageChunk) Unwrap() *MessageChunk {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageChunk is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *MessageChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("index=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Index))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(mc.Text)
+	builder.WriteString(", ")
+	builder.WriteString("text_embedding=")
+	builder.WriteString(fmt.Sprintf("%v", mc.TextEmbedding))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageChunks is a parsable slice of MessageChunk.
+type MessageChunks []*MessageChunk
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagechunk
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldMessageID, v))
+}
+
+// Index applies equality check predicate on the "index" field. It's identical to IndexEQ.
+func Index(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldIndex, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldText, v))
+}
+
+// TextEmbedding applies equality check predicate on the "text_embedding" field. It's identical to TextEmbeddingEQ.
+func TextEmbedding(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldTextEmbedding, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...uuid.UUID) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// IndexEQ applies the EQ predicate on the "index" field.
+func IndexEQ(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldIndex, v))
+}
+
+// IndexNEQ applies the NEQ predicate on the "index" field.
+func IndexNEQ(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNEQ(FieldIndex, v))
+}
+
+// IndexIn applies the In predicate on the "index" field.
+func IndexIn(vs ...int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldIn(FieldIndex, vs...))
+}
+
+// IndexNotIn applies the NotIn predicate on the "index" field.
+func IndexNotIn(vs ...int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNotIn(FieldIndex, vs...))
+}
+
+// IndexGT applies the GT predicate on the "index" field.
+func IndexGT(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGT(FieldIndex, v))
+}
+
+// IndexGTE applies the GTE predicate on the "index" field.
+func IndexGTE(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGTE(FieldIndex, v))
+}
+
+// IndexLT applies the LT predicate on the "index" field.
+func IndexLT(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLT(FieldIndex, v))
+}
+
+// IndexLTE applies the LTE predicate on the "index" field.
+func IndexLTE(v int) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLTE(FieldIndex, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldContainsFold(FieldText, v))
+}
+
+// TextEmbeddingEQ applies the EQ predicate on the "text_embedding" field.
+func TextEmbeddingEQ(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldEQ(FieldTextEmbedding, v))
+}
+
+// TextEmbeddingNEQ applies the NEQ predicate on the "text_embedding" field.
+func TextEmbeddingNEQ(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNEQ(FieldTextEmbedding, v))
+}
+
+// TextEmbeddingIn applies the In predicate on the "text_embedding" field.
+func TextEmbeddingIn(vs ...pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldIn(FieldTextEmbedding, vs...))
+}
+
+// TextEmbeddingNotIn applies the NotIn predicate on the "text_embedding" field.
+func TextEmbeddingNotIn(vs ...pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldNotIn(FieldTextEmbedding, vs...))
+}
+
+// TextEmbeddingGT applies the GT predicate on the "text_embedding" field.
+func TextEmbeddingGT(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGT(FieldTextEmbedding, v))
+}
+
+// TextEmbeddingGTE applies the GTE predicate on the "text_embedding" field.
+func TextEmbeddingGTE(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldGTE(FieldTextEmbedding, v))
+}
+
+// TextEmbeddingLT applies the LT predicate on the "text_embedding" field.
+func TextEmbeddingLT(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLT(FieldTextEmbedding, v))
+}
+
+// TextEmbeddingLTE applies the LTE predicate on the "text_embedding" field.
+func TextEmbeddingLTE(v pgvector.Vector) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.FieldLTE(FieldTextEmbedding, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageChunk {
+	return predicate.MessageChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageChunk {
+	return predicate.MessageChunk(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageChunk) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageChunk) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageChunk) predicate.MessageChunk {
+	return predicate.MessageChunk(sql.NotPredicates(p))
+}
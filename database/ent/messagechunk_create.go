@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+)
+
+// MessageChunkCreate is the builder for creating a MessageChunk entity.
+type MessageChunkCreate struct {
+	config
+	mutation *MessageChunkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (mcc *MessageChunkCreate) SetMessageID(u uuid.UUID) *MessageChunkCreate {
+	mcc.mutation.SetMessageID(u)
+	return mcc
+}
+
+// SetIndex sets the "index" field.
+func (mcc *MessageChunkCreate) SetIndex(i int) *MessageChunkCreate {
+	mcc.mutation.SetIndex(i)
+	return mcc
+}
+
+// SetText sets the "text" field.
+func (mcc *MessageChunkCreate) SetText(s string) *MessageChunkCreate {
+	mcc.mutation.SetText(s)
+	return mcc
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (mcc *MessageChunkCreate) SetTextEmbedding(pg pgvector.Vector) *MessageChunkCreate {
+	mcc.mutation.SetTextEmbedding(pg)
+	return mcc
+}
+
+// SetID sets the "id" field.
+func (mcc *MessageChunkCreate) SetID(u uuid.UUID) *MessageChunkCreate {
+	mcc.mutation.SetID(u)
+	return mcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mcc *MessageChunkCreate) SetNillableID(u *uuid.UUID) *MessageChunkCreate {
+	if u != nil {
+		mcc.SetID(*u)
+	}
+	return mcc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mcc *MessageChunkCreate) SetMessage(m *Message) *MessageChunkCreate {
+	return mcc.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageChunkMutation object of the builder.
+func (mcc *MessageChunkCreate) Mutation() *MessageChunkMutation {
+	return mcc.mutation
+}
+
+// Save creates the MessageChunk in the database.
+func (mcc *MessageChunkCreate) Save(ctx context.Context) (*MessageChunk, error) {
+	mcc.defaults()
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *MessageChunkCreate) SaveX(ctx context.Context) *MessageChunk {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *MessageChunkCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *MessageChunkCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *MessageChunkCreate) defaults() {
+	if _, ok := mcc.mutation.ID(); !ok {
+		v := messagechunk.DefaultID()
+		mcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *MessageChunkCreate) check() error {
+	if _, ok := mcc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageChunk.message_id"`)}
+	}
+	if _, ok := mcc.mutation.Index(); !ok {
+		return &ValidationError{Name: "index", err: errors.New(`ent: missing required field "MessageChunk.index"`)}
+	}
+	if _, ok := mcc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "MessageChunk.text"`)}
+	}
+	if _, ok := mcc.mutation.TextEmbedding(); !ok {
+		return &ValidationError{Name: "text_embedding", err: errors.New(`ent: missing required field "MessageChunk.text_embedding"`)}
+	}
+	if len(mcc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageChunk.message"`)}
+	}
+	return nil
+}
+
+func (mcc *MessageChunkCreate) sqlSave(ctx context.Context) (*MessageChunk, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *MessageChunkCreate) createSpec() (*MessageChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageChunk{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(messagechunk.Table, sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mcc.conflict
+	if id, ok := mcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mcc.mutation.Index(); ok {
+		_spec.SetField(messagechunk.FieldIndex, field.TypeInt, value)
+		_node.Index = value
+	}
+	if value, ok := mcc.mutation.Text(); ok {
+		_spec.SetField(messagechunk.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := mcc.mutation.TextEmbedding(); ok {
+		_spec.SetField(messagechunk.FieldTextEmbedding, field.TypeOther, value)
+		_node.TextEmbedding = value
+	}
+	if nodes := mcc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagechunk.MessageTable,
+			Columns: []string{messagechunk.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageChunk.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageChunkUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcc *MessageChunkCreate) OnConflict(opts ...sql.ConflictOption) *MessageChunkUpsertOne {
+	mcc.conflict = opts
+	return &MessageChunkUpsertOne{
+		create: mcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcc *MessageChunkCreate) OnConflictColumns(columns ...string) *MessageChunkUpsertOne {
+	mcc.conflict = append(mcc.conflict, sql.ConflictColumns(columns...))
+	return &MessageChunkUpsertOne{
+		create: mcc,
+	}
+}
+
+type (
+	// MessageChunkUpsertOne is the builder for "upsert"-ing
+	//  one MessageChunk node.
+	MessageChunkUpsertOne struct {
+		create *MessageChunkCreate
+	}
+
+	// MessageChunkUpsert is the "OnConflict" setter.
+	MessageChunkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageChunkUpsert) SetMessageID(v uuid.UUID) *MessageChunkUpsert {
+	u.Set(messagechunk.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageChunkUpsert) UpdateMessageID() *MessageChunkUpsert {
+	u.SetExcluded(messagechunk.FieldMessageID)
+	return u
+}
+
+// SetIndex sets the "index" field.
+func (u *MessageChunkUpsert) SetIndex(v int) *MessageChunkUpsert {
+	u.Set(messagechunk.FieldIndex, v)
+	return u
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *MessageChunkUpsert) UpdateIndex() *MessageChunkUpsert {
+	u.SetExcluded(messagechunk.FieldIndex)
+	return u
+}
+
+// AddIndex adds v to the "index" field.
+func (u *MessageChunkUpsert) AddIndex(v int) *MessageChunkUpsert {
+	u.Add(messagechunk.FieldIndex, v)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *MessageChunkUpsert) SetText(v string) *MessageChunkUpsert {
+	u.Set(messagechunk.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageChunkUpsert) UpdateText() *MessageChunkUpsert {
+	u.SetExcluded(messagechunk.FieldText)
+	return u
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageChunkUpsert) SetTextEmbedding(v pgvector.Vector) *MessageChunkUpsert {
+	u.Set(messagechunk.FieldTextEmbedding, v)
+	return u
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageChunkUpsert) UpdateTextEmbedding() *MessageChunkUpsert {
+	u.SetExcluded(messagechunk.FieldTextEmbedding)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageChunkUpsertOne) UpdateNewValues() *MessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagechunk.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageChunkUpsertOne) Ignore() *MessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageChunkUpsertOne) DoNothing() *MessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageChunkCreate.OnConflict
+// documentation for more info.
+func (u *MessageChunkUpsertOne) Update(set func(*MessageChunkUpsert)) *MessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageChunkUpsertOne) SetMessageID(v uuid.UUID) *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageChunkUpsertOne) UpdateMessageID() *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *MessageChunkUpsertOne) SetIndex(v int) *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *MessageChunkUpsertOne) AddIndex(v int) *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *MessageChunkUpsertOne) UpdateIndex() *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *MessageChunkUpsertOne) SetText(v string) *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageChunkUpsertOne) UpdateText() *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageChunkUpsertOne) SetTextEmbedding(v pgvector.Vector) *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetTextEmbedding(v)
+	})
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageChunkUpsertOne) UpdateTextEmbedding() *MessageChunkUpsertOne {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateTextEmbedding()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageChunkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageChunkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageChunkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageChunkUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MessageChunkUpsertOne.ID is not supported by MySQL driver. Use MessageChunkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageChunkUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageChunkCreateBulk is the builder for creating many MessageChunk entities in bulk.
+type MessageChunkCreateBulk struct {
+	config
+	err      error
+	builders []*MessageChunkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageChunk entities in the database.
+func (mccb *MessageChunkCreateBulk) Save(ctx context.Context) ([]*MessageChunk, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*MessageChunk, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *MessageChunkCreateBulk) SaveX(ctx context.Context) []*MessageChunk {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *MessageChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *MessageChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageChunk.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageChunkUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (mccb *MessageChunkCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageChunkUpsertBulk {
+	mccb.conflict = opts
+	return &MessageChunkUpsertBulk{
+		create: mccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mccb *MessageChunkCreateBulk) OnConflictColumns(columns ...string) *MessageChunkUpsertBulk {
+	mccb.conflict = append(mccb.conflict, sql.ConflictColumns(columns...))
+	return &MessageChunkUpsertBulk{
+		create: mccb,
+	}
+}
+
+// MessageChunkUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageChunk nodes.
+type MessageChunkUpsertBulk struct {
+	create *MessageChunkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageChunkUpsertBulk) UpdateNewValues() *MessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagechunk.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageChunk.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageChunkUpsertBulk) Ignore() *MessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageChunkUpsertBulk) DoNothing() *MessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageChunkCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageChunkUpsertBulk) Update(set func(*MessageChunkUpsert)) *MessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageChunkUpsertBulk) SetMessageID(v uuid.UUID) *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageChunkUpsertBulk) UpdateMessageID() *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *MessageChunkUpsertBulk) SetIndex(v int) *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *MessageChunkUpsertBulk) AddIndex(v int) *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *MessageChunkUpsertBulk) UpdateIndex() *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *MessageChunkUpsertBulk) SetText(v string) *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *MessageChunkUpsertBulk) UpdateText() *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (u *MessageChunkUpsertBulk) SetTextEmbedding(v pgvector.Vector) *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.SetTextEmbedding(v)
+	})
+}
+
+// UpdateTextEmbedding sets the "text_embedding" field to the value that was provided on create.
+func (u *MessageChunkUpsertBulk) UpdateTextEmbedding() *MessageChunkUpsertBulk {
+	return u.Update(func(s *MessageChunkUpsert) {
+		s.UpdateTextEmbedding()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageChunkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageChunkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageChunkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageChunkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// MessageChunkDelete is the builder for deleting a MessageChunk entity.
+type MessageChunkDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageChunkMutation
+}
+
+// Where appends a list predicates to the MessageChunkDelete builder.
+func (mcd *MessageChunkDelete) Where(ps ...predicate.MessageChunk) *MessageChunkDelete {
+	mcd.mutation.Where(ps...)
+	return mcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mcd *MessageChunkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcd.sqlExec, mcd.mutation, mcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcd *MessageChunkDelete) ExecX(ctx context.Context) int {
+	n, err := mcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mcd *MessageChunkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagechunk.Table, sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID))
+	if ps := mcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mcd.mutation.done = true
+	return affected, err
+}
+
+// MessageChunkDeleteOne is the builder for deleting a single MessageChunk entity.
+type MessageChunkDeleteOne struct {
+	mcd *MessageChunkDelete
+}
+
+// Where appends a list predicates to the MessageChunkDelete builder.
+func (mcdo *MessageChunkDeleteOne) Where(ps ...predicate.MessageChunk) *MessageChunkDeleteOne {
+	mcdo.mcd.mutation.Where(ps...)
+	return mcdo
+}
+
+// Exec executes the deletion query.
+func (mcdo *MessageChunkDeleteOne) Exec(ctx context.Context) error {
+	n, err := mcdo.mcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagechunk.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcdo *MessageChunkDeleteOne) ExecX(ctx context.Context) {
+	if err := mcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
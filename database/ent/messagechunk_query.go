@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// MessageChunkQuery is the builder for querying MessageChunk entities.
+type MessageChunkQuery struct {
+	config
+	ctx         *QueryContext
+	order       []messagechunk.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MessageChunk
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageChunkQuery builder.
+func (mcq *MessageChunkQuery) Where(ps ...predicate.MessageChunk) *MessageChunkQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mcq *MessageChunkQuery) Limit(limit int) *MessageChunkQuery {
+	mcq.ctx.Limit = &limit
+	return mcq
+}
+
+// Offset to start from.
+func (mcq *MessageChunkQuery) Offset(offset int) *MessageChunkQuery {
+	mcq.ctx.Offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *MessageChunkQuery) Unique(unique bool) *MessageChunkQuery {
+	mcq.ctx.Unique = &unique
+	return mcq
+}
+
+// Order specifies how the records should be ordered.
+func (mcq *MessageChunkQuery) Order(o ...messagechunk.OrderOption) *MessageChunkQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (mcq *MessageChunkQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: mcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagechunk.Table, messagechunk.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagechunk.MessageTable, messagechunk.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MessageChunk entity from the query.
+// Returns a *NotFoundError when no MessageChunk was found.
+func (mcq *MessageChunkQuery) First(ctx context.Context) (*MessageChunk, error) {
+	nodes, err := mcq.Limit(1).All(setContextOp(ctx, mcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagechunk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *MessageChunkQuery) FirstX(ctx context.Context) *MessageChunk {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageChunk ID from the query.
+// Returns a *NotFoundError when no MessageChunk ID was found.
+func (mcq *MessageChunkQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mcq.Limit(1).IDs(setContextOp(ctx, mcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagechunk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *MessageChunkQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageChunk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageChunk entity is found.
+// Returns a *NotFoundError when no MessageChunk entities are found.
+func (mcq *MessageChunkQuery) Only(ctx context.Context) (*MessageChunk, error) {
+	nodes, err := mcq.Limit(2).All(setContextOp(ctx, mcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagechunk.Label}
+	default:
+		return nil, &NotSingularError{messagechunk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *MessageChunkQuery) OnlyX(ctx context.Context) *MessageChunk {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageChunk ID in the query.
+// Returns a *NotSingularError when more than one MessageChunk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *MessageChunkQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mcq.Limit(2).IDs(setContextOp(ctx, mcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagechunk.Label}
+	default:
+		err = &NotSingularError{messagechunk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *MessageChunkQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageChunks.
+func (mcq *MessageChunkQuery) All(ctx context.Context) ([]*MessageChunk, error) {
+	ctx = setContextOp(ctx, mcq.ctx, ent.OpQueryAll)
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageChunk, *MessageChunkQuery]()
+	return withInterceptors[[]*MessageChunk](ctx, mcq, qr, mcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *MessageChunkQuery) AllX(ctx context.Context) []*MessageChunk {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageChunk IDs.
+func (mcq *MessageChunkQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mcq.ctx.Unique == nil && mcq.path != nil {
+		mcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mcq.ctx, ent.OpQueryIDs)
+	if err = mcq.Select(messagechunk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *MessageChunkQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *MessageChunkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mcq.ctx, ent.OpQueryCount)
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mcq, querierCount[*MessageChunkQuery](), mcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *MessageChunkQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *MessageChunkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mcq.ctx, ent.OpQueryExist)
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *MessageChunkQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageChunkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *MessageChunkQuery) Clone() *MessageChunkQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &MessageChunkQuery{
+		config:      mcq.config,
+		ctx:         mcq.ctx.Clone(),
+		order:       append([]messagechunk.OrderOption{}, mcq.order...),
+		inters:      append([]Interceptor{}, mcq.inters...),
+		predicates:  append([]predicate.MessageChunk{}, mcq.predicates...),
+		withMessage: mcq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:       mcq.sql.Clone(),
+		path:      mcq.path,
+		modifiers: append([]func(*sql.Selector){}, mcq.modifiers...),
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (mcq *MessageChunkQuery) WithMessage(opts ...func(*MessageQuery)) *MessageChunkQuery {
+	query := (&MessageClient{config: mcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mcq.withMessage = query
+	return mcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageChunk.Query().
+//		GroupBy(messagechunk.FieldMessageID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mcq *MessageChunkQuery) GroupBy(field string, fields ...string) *MessageChunkGroupBy {
+	mcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageChunkGroupBy{build: mcq}
+	grbuild.flds = &mcq.ctx.Fields
+	grbuild.label = messagechunk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//	}
+//
+//	client.MessageChunk.Query().
+//		Select(messagechunk.FieldMessageID).
+//		Scan(ctx, &v)
+func (mcq *MessageChunkQuery) Select(fields ...string) *MessageChunkSelect {
+	mcq.ctx.Fields = append(mcq.ctx.Fields, fields...)
+	sbuild := &MessageChunkSelect{MessageChunkQuery: mcq}
+	sbuild.label = messagechunk.Label
+	sbuild.flds, sbuild.scan = &mcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageChunkSelect configured with the given aggregations.
+func (mcq *MessageChunkQuery) Aggregate(fns ...AggregateFunc) *MessageChunkSelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *MessageChunkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mcq.ctx.Fields {
+		if !messagechunk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	return nil
+}
+
+func (mcq *MessageChunkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageChunk, error) {
+	var (
+		nodes       = []*MessageChunk{}
+		_spec       = mcq.querySpec()
+		loadedTypes = [1]bool{
+			mcq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageChunk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageChunk{config: mcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mcq.withMessage; query != nil {
+		if err := mcq.loadMessage(ctx, query, nodes, nil,
+			func(n *MessageChunk, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mcq *MessageChunkQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*MessageChunk, init func(*MessageChunk), assign func(*MessageChunk, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MessageChunk)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mcq *MessageChunkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	if len(mcq.modifiers) > 0 {
+		_spec.Modifiers = mcq.modifiers
+	}
+	_spec.Node.Columns = mcq.ctx.Fields
+	if len(mcq.ctx.Fields) > 0 {
+		_spec.Unique = mcq.ctx.Unique != nil && *mcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *MessageChunkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messagechunk.Table, messagechunk.Columns, sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID))
+	_spec.From = mcq.sql
+	if unique := mcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagechunk.FieldID)
+		for i := range fields {
+			if fields[i] != messagechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mcq.withMessage != nil {
+			_spec.Node.AddColumnOnce(messagechunk.FieldMessageID)
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *MessageChunkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(messagechunk.Table)
+	columns := mcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messagechunk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.ctx.Unique != nil && *mcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mcq.modifiers {
+		m(selector)
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mcq *MessageChunkQuery) Modify(modifiers ...func(s *sql.Selector)) *MessageChunkSelect {
+	mcq.modifiers = append(mcq.modifiers, modifiers...)
+	return mcq.Select()
+}
+
+// MessageChunkGroupBy is the group-by builder for MessageChunk entities.
+type MessageChunkGroupBy struct {
+	selector
+	build *MessageChunkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *MessageChunkGroupBy) Aggregate(fns ...AggregateFunc) *MessageChunkGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcgb *MessageChunkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageChunkQuery, *MessageChunkGroupBy](ctx, mcgb.build, mcgb, mcgb.build.inters, v)
+}
+
+func (mcgb *MessageChunkGroupBy) sqlScan(ctx context.Context, root *MessageChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mcgb.flds)+len(mcgb.fns))
+		for _, f := range *mcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageChunkSelect is the builder for selecting fields of MessageChunk entities.
+type MessageChunkSelect struct {
+	*MessageChunkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *MessageChunkSelect) Aggregate(fns ...AggregateFunc) *MessageChunkSelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *MessageChunkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcs.ctx, ent.OpQuerySelect)
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageChunkQuery, *MessageChunkSelect](ctx, mcs.MessageChunkQuery, mcs, mcs.inters, v)
+}
+
+func (mcs *MessageChunkSelect) sqlScan(ctx context.Context, root *MessageChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mcs *MessageChunkSelect) Modify(modifiers ...func(s *sql.Selector)) *MessageChunkSelect {
+	mcs.modifiers = append(mcs.modifiers, modifiers...)
+	return mcs
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// MessageChunkUpdate is the builder for updating MessageChunk entities.
+type MessageChunkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MessageChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MessageChunkUpdate builder.
+func (mcu *MessageChunkUpdate) Where(ps ...predicate.MessageChunk) *MessageChunkUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetMessageID sets the "message_id" field.
+func (mcu *MessageChunkUpdate) SetMessageID(u uuid.UUID) *MessageChunkUpdate {
+	mcu.mutation.SetMessageID(u)
+	return mcu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mcu *MessageChunkUpdate) SetNillableMessageID(u *uuid.UUID) *MessageChunkUpdate {
+	if u != nil {
+		mcu.SetMessageID(*u)
+	}
+	return mcu
+}
+
+// SetIndex sets the "index" field.
+func (mcu *MessageChunkUpdate) SetIndex(i int) *MessageChunkUpdate {
+	mcu.mutation.ResetIndex()
+	mcu.mutation.SetIndex(i)
+	return mcu
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (mcu *MessageChunkUpdate) SetNillableIndex(i *int) *MessageChunkUpdate {
+	if i != nil {
+		mcu.SetIndex(*i)
+	}
+	return mcu
+}
+
+// AddIndex adds i to the "index" field.
+func (mcu *MessageChunkUpdate) AddIndex(i int) *MessageChunkUpdate {
+	mcu.mutation.AddIndex(i)
+	return mcu
+}
+
+// SetText sets the "text" field.
+func (mcu *MessageChunkUpdate) SetText(s string) *MessageChunkUpdate {
+	mcu.mutation.SetText(s)
+	return mcu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (mcu *MessageChunkUpdate) SetNillableText(s *string) *MessageChunkUpdate {
+	if s != nil {
+		mcu.SetText(*s)
+	}
+	return mcu
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (mcu *MessageChunkUpdate) SetTextEmbedding(pg pgvector.Vector) *MessageChunkUpdate {
+	mcu.mutation.SetTextEmbedding(pg)
+	return mcu
+}
+
+// SetNillableTextEmbedding sets the "text_embedding" field if the given value is not nil.
+func (mcu *MessageChunkUpdate) SetNillableTextEmbedding(pg *pgvector.Vector) *MessageChunkUpdate {
+	if pg != nil {
+		mcu.SetTextEmbedding(*pg)
+	}
+	return mcu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mcu *MessageChunkUpdate) SetMessage(m *Message) *MessageChunkUpdate {
+	return mcu.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageChunkMutation object of the builder.
+func (mcu *MessageChunkUpdate) Mutation() *MessageChunkMutation {
+	return mcu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mcu *MessageChunkUpdate) ClearMessage() *MessageChunkUpdate {
+	mcu.mutation.ClearMessage()
+	return mcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *MessageChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *MessageChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *MessageChunkUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *MessageChunkUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcu *MessageChunkUpdate) check() error {
+	if mcu.mutation.MessageCleared() && len(mcu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageChunk.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mcu *MessageChunkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageChunkUpdate {
+	mcu.modifiers = append(mcu.modifiers, modifiers...)
+	return mcu
+}
+
+func (mcu *MessageChunkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagechunk.Table, messagechunk.Columns, sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.Index(); ok {
+		_spec.SetField(messagechunk.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedIndex(); ok {
+		_spec.AddField(messagechunk.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.Text(); ok {
+		_spec.SetField(messagechunk.FieldText, field.TypeString, value)
+	}
+	if value, ok := mcu.mutation.TextEmbedding(); ok {
+		_spec.SetField(messagechunk.FieldTextEmbedding, field.TypeOther, value)
+	}
+	if mcu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagechunk.MessageTable,
+			Columns: []string{messagechunk.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagechunk.MessageTable,
+			Columns: []string{messagechunk.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// MessageChunkUpdateOne is the builder for updating a single MessageChunk entity.
+type MessageChunkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageChunkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMessageID sets the "message_id" field.
+func (mcuo *MessageChunkUpdateOne) SetMessageID(u uuid.UUID) *MessageChunkUpdateOne {
+	mcuo.mutation.SetMessageID(u)
+	return mcuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mcuo *MessageChunkUpdateOne) SetNillableMessageID(u *uuid.UUID) *MessageChunkUpdateOne {
+	if u != nil {
+		mcuo.SetMessageID(*u)
+	}
+	return mcuo
+}
+
+// SetIndex sets the "index" field.
+func (mcuo *MessageChunkUpdateOne) SetIndex(i int) *MessageChunkUpdateOne {
+	mcuo.mutation.ResetIndex()
+	mcuo.mutation.SetIndex(i)
+	return mcuo
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (mcuo *MessageChunkUpdateOne) SetNillableIndex(i *int) *MessageChunkUpdateOne {
+	if i != nil {
+		mcuo.SetIndex(*i)
+	}
+	return mcuo
+}
+
+// AddIndex adds i to the "index" field.
+func (mcuo *MessageChunkUpdateOne) AddIndex(i int) *MessageChunkUpdateOne {
+	mcuo.mutation.AddIndex(i)
+	return mcuo
+}
+
+// SetText sets the "text" field.
+func (mcuo *MessageChunkUpdateOne) SetText(s string) *MessageChunkUpdateOne {
+	mcuo.mutation.SetText(s)
+	return mcuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (mcuo *MessageChunkUpdateOne) SetNillableText(s *string) *MessageChunkUpdateOne {
+	if s != nil {
+		mcuo.SetText(*s)
+	}
+	return mcuo
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (mcuo *MessageChunkUpdateOne) SetTextEmbedding(pg pgvector.Vector) *MessageChunkUpdateOne {
+	mcuo.mutation.SetTextEmbedding(pg)
+	return mcuo
+}
+
+// SetNillableTextEmbedding sets the "text_embedding" field if the given value is not nil.
+func (mcuo *MessageChunkUpdateOne) SetNillableTextEmbedding(pg *pgvector.Vector) *MessageChunkUpdateOne {
+	if pg != nil {
+		mcuo.SetTextEmbedding(*pg)
+	}
+	return mcuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mcuo *MessageChunkUpdateOne) SetMessage(m *Message) *MessageChunkUpdateOne {
+	return mcuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageChunkMutation object of the builder.
+func (mcuo *MessageChunkUpdateOne) Mutation() *MessageChunkMutation {
+	return mcuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mcuo *MessageChunkUpdateOne) ClearMessage() *MessageChunkUpdateOne {
+	mcuo.mutation.ClearMessage()
+	return mcuo
+}
+
+// Where appends a list predicates to the MessageChunkUpdate builder.
+func (mcuo *MessageChunkUpdateOne) Where(ps ...predicate.MessageChunk) *MessageChunkUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *MessageChunkUpdateOne) Select(field string, fields ...string) *MessageChunkUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated MessageChunk entity.
+func (mcuo *MessageChunkUpdateOne) Save(ctx context.Context) (*MessageChunk, error) {
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *MessageChunkUpdateOne) SaveX(ctx context.Context) *MessageChunk {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *MessageChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *MessageChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcuo *MessageChunkUpdateOne) check() error {
+	if mcuo.mutation.MessageCleared() && len(mcuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageChunk.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mcuo *MessageChunkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageChunkUpdateOne {
+	mcuo.modifiers = append(mcuo.modifiers, modifiers...)
+	return mcuo
+}
+
+func (mcuo *MessageChunkUpdateOne) sqlSave(ctx context.Context) (_node *MessageChunk, err error) {
+	if err := mcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagechunk.Table, messagechunk.Columns, sqlgraph.NewFieldSpec(messagechunk.FieldID, field.TypeUUID))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagechunk.FieldID)
+		for _, f := range fields {
+			if !messagechunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.Index(); ok {
+		_spec.SetField(messagechunk.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedIndex(); ok {
+		_spec.AddField(messagechunk.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.Text(); ok {
+		_spec.SetField(messagechunk.FieldText, field.TypeString, value)
+	}
+	if value, ok := mcuo.mutation.TextEmbedding(); ok {
+		_spec.SetField(messagechunk.FieldTextEmbedding, field.TypeOther, value)
+	}
+	if mcuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagechunk.MessageTable,
+			Columns: []string{messagechunk.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagechunk.MessageTable,
+			Columns: []string{messagechunk.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mcuo.modifiers...)
+	_node = &MessageChunk{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+)
+
+// MessageRevision is the model entity for the MessageRevision schema.
+type MessageRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt time.Time `json:"edited_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageRevisionQuery when eager-loading is set.
+	Edges        MessageRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageRevisionEdges holds the relations/edges for other nodes in the graph.
+type MessageRevisionEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageRevisionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldText:
+			values[i] = new(sql.NullString)
+		case messagerevision.FieldEditedAt, messagerevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case messagerevision.FieldID, messagerevision.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageRevision fields.
+func (mr *MessageRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagerevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mr.ID = *value
+			}
+		case messagerevision.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				mr.MessageID = *value
+			}
+		case messagerevision.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				mr.Text = value.String
+			}
+		case messagerevision.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				mr.EditedAt = value.Time
+			}
+		case messagerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mr.CreatedAt = value.Time
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageRevision.
+// This includes values selected through modifiers, order, etc.
+func (mr *MessageRevision) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageRevision entity.
+func (mr *MessageRevision) QueryMessage() *MessageQuery {
+	return NewMessageRevisionClient(mr.config).QueryMessage(mr)
+}
+
+// Update returns a builder for updating this MessageRevision.
+// Note that you need to call MessageRevision.Unwrap() before calling this method if this MessageRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MessageRevision) Update() *MessageRevisionUpdateOne {
+	return NewMessageRevisionClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MessageRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MessageRevision) Unwrap() *MessageRevision {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageRevision is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MessageRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", mr.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(mr.Text)
+	builder.WriteString(", ")
+	builder.WriteString("edited_at=")
+	builder.WriteString(mr.EditedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageRevisions is a parsable slice of MessageRevision.
+type MessageRevisions []*MessageRevision
@@ -15,6 +15,10 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"user", "group", "channel"}},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "backfill_offset_id", Type: field.TypeInt, Nullable: true},
+		{Name: "backfill_completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_msg_id", Type: field.TypeInt, Nullable: true},
+		{Name: "migrated_to_id", Type: field.TypeInt64, Nullable: true},
 	}
 	// DialogsTable holds the schema information for the "dialogs" table.
 	DialogsTable = &schema.Table{
@@ -22,16 +26,79 @@ var (
 		Columns:    DialogsColumns,
 		PrimaryKey: []*schema.Column{DialogsColumns[0]},
 	}
+	// EmbeddingCachesColumns holds the columns for the "embedding_caches" table.
+	EmbeddingCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "hash", Type: field.TypeBytes},
+		{Name: "model", Type: field.TypeString},
+		{Name: "embedding", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "hits", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EmbeddingCachesTable holds the schema information for the "embedding_caches" table.
+	EmbeddingCachesTable = &schema.Table{
+		Name:       "embedding_caches",
+		Columns:    EmbeddingCachesColumns,
+		PrimaryKey: []*schema.Column{EmbeddingCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "embeddingcache_model_hash",
+				Unique:  true,
+				Columns: []*schema.Column{EmbeddingCachesColumns[2], EmbeddingCachesColumns[1]},
+			},
+		},
+	}
+	// EmbeddingSpendsColumns holds the columns for the "embedding_spends" table.
+	EmbeddingSpendsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "spent", Type: field.TypeFloat64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmbeddingSpendsTable holds the schema information for the "embedding_spends" table.
+	EmbeddingSpendsTable = &schema.Table{
+		Name:       "embedding_spends",
+		Columns:    EmbeddingSpendsColumns,
+		PrimaryKey: []*schema.Column{EmbeddingSpendsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "embeddingspend_day_provider",
+				Unique:  true,
+				Columns: []*schema.Column{EmbeddingSpendsColumns[1], EmbeddingSpendsColumns[2]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "msg_id", Type: field.TypeInt},
+		{Name: "reply_to_msg_id", Type: field.TypeInt, Nullable: true},
+		{Name: "top_msg_id", Type: field.TypeInt, Nullable: true},
+		{Name: "topic_id", Type: field.TypeInt, Nullable: true},
+		{Name: "fwd_from_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "fwd_from_name", Type: field.TypeString, Nullable: true},
+		{Name: "fwd_from_msg_id", Type: field.TypeInt, Nullable: true},
+		{Name: "fwd_from_date", Type: field.TypeTime, Nullable: true},
+		{Name: "fwd_post_author", Type: field.TypeString, Nullable: true},
 		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "derived_text", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "text_embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(%d)"}},
+		{Name: "embedding_strategy", Type: field.TypeEnum, Nullable: true, Enums: []string{"message", "preceding", "reply"}},
+		{Name: "embedding_model", Type: field.TypeString, Nullable: true},
+		{Name: "next_embedding_model", Type: field.TypeString, Nullable: true},
+		{Name: "embedding_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "embedding_error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "embedding_retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "embedding_failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "embedding_skipped", Type: field.TypeBool, Default: false},
 		{Name: "has_media", Type: field.TypeBool},
 		{Name: "media_info", Type: field.TypeJSON},
 		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "dialog_id", Type: field.TypeInt64},
+		{Name: "sender_id", Type: field.TypeInt64, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
@@ -41,21 +108,40 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_dialogs_messages",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[26]},
 				RefColumns: []*schema.Column{DialogsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "messages_senders_messages",
+				Columns:    []*schema.Column{MessagesColumns[27]},
+				RefColumns: []*schema.Column{SendersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "message_msg_id_dialog_id",
 				Unique:  true,
-				Columns: []*schema.Column{MessagesColumns[1], MessagesColumns[7]},
+				Columns: []*schema.Column{MessagesColumns[1], MessagesColumns[26]},
+			},
+			{
+				Name:    "message_dialog_id_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[26], MessagesColumns[1]},
 			},
 			{
 				Name:    "message_text",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[2]},
+				Columns: []*schema.Column{MessagesColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "pgroonga",
+				},
+			},
+			{
+				Name:    "message_derived_text",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Type: "pgroonga",
 				},
@@ -63,7 +149,7 @@ var (
 			{
 				Name:    "message_text_embedding",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[3]},
+				Columns: []*schema.Column{MessagesColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_cosine_ops",
 					Type:    "vchordrq",
@@ -72,17 +158,232 @@ var (
 			{
 				Name:    "message_sent_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[6]},
+				Columns: []*schema.Column{MessagesColumns[23]},
+			},
+			{
+				Name:    "message_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[25]},
+			},
+			{
+				Name:    "message_sender_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[27]},
+			},
+			{
+				Name:    "message_dialog_id_reply_to_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[26], MessagesColumns[2]},
+			},
+			{
+				Name:    "message_dialog_id_topic_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[26], MessagesColumns[4]},
+			},
+			{
+				Name:    "message_fwd_from_id_fwd_from_msg_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[5], MessagesColumns[7]},
+			},
+		},
+	}
+	// MessageChunksColumns holds the columns for the "message_chunks" table.
+	MessageChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "index", Type: field.TypeInt},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "text_embedding", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector(%d)"}},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageChunksTable holds the schema information for the "message_chunks" table.
+	MessageChunksTable = &schema.Table{
+		Name:       "message_chunks",
+		Columns:    MessageChunksColumns,
+		PrimaryKey: []*schema.Column{MessageChunksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_chunks_messages_chunks",
+				Columns:    []*schema.Column{MessageChunksColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagechunk_message_id_index",
+				Unique:  true,
+				Columns: []*schema.Column{MessageChunksColumns[4], MessageChunksColumns[1]},
+			},
+			{
+				Name:    "messagechunk_text_embedding",
+				Unique:  false,
+				Columns: []*schema.Column{MessageChunksColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "vchordrq",
+				},
+			},
+		},
+	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "edited_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_revisions",
+				Columns:    []*schema.Column{MessageRevisionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[4]},
+			},
+			{
+				Name:    "messagerevision_text",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "pgroonga",
+				},
+			},
+		},
+	}
+	// NextEmbeddingsColumns holds the columns for the "next_embeddings" table.
+	NextEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "index", Type: field.TypeInt},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "embedding", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "strategy", Type: field.TypeEnum, Enums: []string{"message", "preceding", "reply"}},
+		{Name: "model", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// NextEmbeddingsTable holds the schema information for the "next_embeddings" table.
+	NextEmbeddingsTable = &schema.Table{
+		Name:       "next_embeddings",
+		Columns:    NextEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{NextEmbeddingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "next_embeddings_messages_next_embeddings",
+				Columns:    []*schema.Column{NextEmbeddingsColumns[6]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nextembedding_message_id_index",
+				Unique:  true,
+				Columns: []*schema.Column{NextEmbeddingsColumns[6], NextEmbeddingsColumns[1]},
+			},
+		},
+	}
+	// NextEmbeddingFailuresColumns holds the columns for the "next_embedding_failures" table.
+	NextEmbeddingFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "model", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// NextEmbeddingFailuresTable holds the schema information for the "next_embedding_failures" table.
+	NextEmbeddingFailuresTable = &schema.Table{
+		Name:       "next_embedding_failures",
+		Columns:    NextEmbeddingFailuresColumns,
+		PrimaryKey: []*schema.Column{NextEmbeddingFailuresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "next_embedding_failures_messages_next_embedding_failures",
+				Columns:    []*schema.Column{NextEmbeddingFailuresColumns[6]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nextembeddingfailure_message_id_model",
+				Unique:  true,
+				Columns: []*schema.Column{NextEmbeddingFailuresColumns[6], NextEmbeddingFailuresColumns[1]},
+			},
+		},
+	}
+	// ObservationStatesColumns holds the columns for the "observation_states" table.
+	ObservationStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "embedding_enabled", Type: field.TypeBool, Default: true},
+		{Name: "retention", Type: field.TypeInt64, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ObservationStatesTable holds the schema information for the "observation_states" table.
+	ObservationStatesTable = &schema.Table{
+		Name:       "observation_states",
+		Columns:    ObservationStatesColumns,
+		PrimaryKey: []*schema.Column{ObservationStatesColumns[0]},
+	}
+	// SendersColumns holds the columns for the "senders" table.
+	SendersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"user", "group", "channel"}},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SendersTable holds the schema information for the "senders" table.
+	SendersTable = &schema.Table{
+		Name:       "senders",
+		Columns:    SendersColumns,
+		PrimaryKey: []*schema.Column{SendersColumns[0]},
+	}
+	// SettingsColumns holds the columns for the "settings" table.
+	SettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SettingsTable holds the schema information for the "settings" table.
+	SettingsTable = &schema.Table{
+		Name:       "settings",
+		Columns:    SettingsColumns,
+		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		DialogsTable,
+		EmbeddingCachesTable,
+		EmbeddingSpendsTable,
 		MessagesTable,
+		MessageChunksTable,
+		MessageRevisionsTable,
+		NextEmbeddingsTable,
+		NextEmbeddingFailuresTable,
+		ObservationStatesTable,
+		SendersTable,
+		SettingsTable,
 	}
 )
 
 func init() {
 	MessagesTable.ForeignKeys[0].RefTable = DialogsTable
+	MessagesTable.ForeignKeys[1].RefTable = SendersTable
+	MessageChunksTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	NextEmbeddingsTable.ForeignKeys[0].RefTable = MessagesTable
+	NextEmbeddingFailuresTable.ForeignKeys[0].RefTable = MessagesTable
 }
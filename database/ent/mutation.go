@@ -14,8 +14,17 @@ import (
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
 	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/database/ent/setting"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -28,26 +37,42 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDialog  = "Dialog"
-	TypeMessage = "Message"
+	TypeDialog               = "Dialog"
+	TypeEmbeddingCache       = "EmbeddingCache"
+	TypeEmbeddingSpend       = "EmbeddingSpend"
+	TypeMessage              = "Message"
+	TypeMessageChunk         = "MessageChunk"
+	TypeMessageRevision      = "MessageRevision"
+	TypeNextEmbedding        = "NextEmbedding"
+	TypeNextEmbeddingFailure = "NextEmbeddingFailure"
+	TypeObservationState     = "ObservationState"
+	TypeSender               = "Sender"
+	TypeSetting              = "Setting"
 )
 
 // DialogMutation represents an operation that mutates the Dialog nodes in the graph.
 type DialogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int64
-	title           *string
-	_type           *types.DialogType
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	messages        map[uuid.UUID]struct{}
-	removedmessages map[uuid.UUID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Dialog, error)
-	predicates      []predicate.Dialog
+	op                    Op
+	typ                   string
+	id                    *int64
+	title                 *string
+	_type                 *types.DialogType
+	updated_at            *time.Time
+	backfill_offset_id    *int
+	addbackfill_offset_id *int
+	backfill_completed_at *time.Time
+	last_msg_id           *int
+	addlast_msg_id        *int
+	migrated_to_id        *int64
+	addmigrated_to_id     *int64
+	clearedFields         map[string]struct{}
+	messages              map[uuid.UUID]struct{}
+	removedmessages       map[uuid.UUID]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Dialog, error)
+	predicates            []predicate.Dialog
 }
 
 var _ ent.Mutation = (*DialogMutation)(nil)
@@ -262,6 +287,265 @@ func (m *DialogMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetBackfillOffsetID sets the "backfill_offset_id" field.
+func (m *DialogMutation) SetBackfillOffsetID(i int) {
+	m.backfill_offset_id = &i
+	m.addbackfill_offset_id = nil
+}
+
+// BackfillOffsetID returns the value of the "backfill_offset_id" field in the mutation.
+func (m *DialogMutation) BackfillOffsetID() (r int, exists bool) {
+	v := m.backfill_offset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackfillOffsetID returns the old "backfill_offset_id" field's value of the Dialog entity.
+// If the Dialog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DialogMutation) OldBackfillOffsetID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackfillOffsetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackfillOffsetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackfillOffsetID: %w", err)
+	}
+	return oldValue.BackfillOffsetID, nil
+}
+
+// AddBackfillOffsetID adds i to the "backfill_offset_id" field.
+func (m *DialogMutation) AddBackfillOffsetID(i int) {
+	if m.addbackfill_offset_id != nil {
+		*m.addbackfill_offset_id += i
+	} else {
+		m.addbackfill_offset_id = &i
+	}
+}
+
+// AddedBackfillOffsetID returns the value that was added to the "backfill_offset_id" field in this mutation.
+func (m *DialogMutation) AddedBackfillOffsetID() (r int, exists bool) {
+	v := m.addbackfill_offset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBackfillOffsetID clears the value of the "backfill_offset_id" field.
+func (m *DialogMutation) ClearBackfillOffsetID() {
+	m.backfill_offset_id = nil
+	m.addbackfill_offset_id = nil
+	m.clearedFields[dialog.FieldBackfillOffsetID] = struct{}{}
+}
+
+// BackfillOffsetIDCleared returns if the "backfill_offset_id" field was cleared in this mutation.
+func (m *DialogMutation) BackfillOffsetIDCleared() bool {
+	_, ok := m.clearedFields[dialog.FieldBackfillOffsetID]
+	return ok
+}
+
+// ResetBackfillOffsetID resets all changes to the "backfill_offset_id" field.
+func (m *DialogMutation) ResetBackfillOffsetID() {
+	m.backfill_offset_id = nil
+	m.addbackfill_offset_id = nil
+	delete(m.clearedFields, dialog.FieldBackfillOffsetID)
+}
+
+// SetBackfillCompletedAt sets the "backfill_completed_at" field.
+func (m *DialogMutation) SetBackfillCompletedAt(t time.Time) {
+	m.backfill_completed_at = &t
+}
+
+// BackfillCompletedAt returns the value of the "backfill_completed_at" field in the mutation.
+func (m *DialogMutation) BackfillCompletedAt() (r time.Time, exists bool) {
+	v := m.backfill_completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackfillCompletedAt returns the old "backfill_completed_at" field's value of the Dialog entity.
+// If the Dialog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DialogMutation) OldBackfillCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackfillCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackfillCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackfillCompletedAt: %w", err)
+	}
+	return oldValue.BackfillCompletedAt, nil
+}
+
+// ClearBackfillCompletedAt clears the value of the "backfill_completed_at" field.
+func (m *DialogMutation) ClearBackfillCompletedAt() {
+	m.backfill_completed_at = nil
+	m.clearedFields[dialog.FieldBackfillCompletedAt] = struct{}{}
+}
+
+// BackfillCompletedAtCleared returns if the "backfill_completed_at" field was cleared in this mutation.
+func (m *DialogMutation) BackfillCompletedAtCleared() bool {
+	_, ok := m.clearedFields[dialog.FieldBackfillCompletedAt]
+	return ok
+}
+
+// ResetBackfillCompletedAt resets all changes to the "backfill_completed_at" field.
+func (m *DialogMutation) ResetBackfillCompletedAt() {
+	m.backfill_completed_at = nil
+	delete(m.clearedFields, dialog.FieldBackfillCompletedAt)
+}
+
+// SetLastMsgID sets the "last_msg_id" field.
+func (m *DialogMutation) SetLastMsgID(i int) {
+	m.last_msg_id = &i
+	m.addlast_msg_id = nil
+}
+
+// LastMsgID returns the value of the "last_msg_id" field in the mutation.
+func (m *DialogMutation) LastMsgID() (r int, exists bool) {
+	v := m.last_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastMsgID returns the old "last_msg_id" field's value of the Dialog entity.
+// If the Dialog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DialogMutation) OldLastMsgID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastMsgID: %w", err)
+	}
+	return oldValue.LastMsgID, nil
+}
+
+// AddLastMsgID adds i to the "last_msg_id" field.
+func (m *DialogMutation) AddLastMsgID(i int) {
+	if m.addlast_msg_id != nil {
+		*m.addlast_msg_id += i
+	} else {
+		m.addlast_msg_id = &i
+	}
+}
+
+// AddedLastMsgID returns the value that was added to the "last_msg_id" field in this mutation.
+func (m *DialogMutation) AddedLastMsgID() (r int, exists bool) {
+	v := m.addlast_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastMsgID clears the value of the "last_msg_id" field.
+func (m *DialogMutation) ClearLastMsgID() {
+	m.last_msg_id = nil
+	m.addlast_msg_id = nil
+	m.clearedFields[dialog.FieldLastMsgID] = struct{}{}
+}
+
+// LastMsgIDCleared returns if the "last_msg_id" field was cleared in this mutation.
+func (m *DialogMutation) LastMsgIDCleared() bool {
+	_, ok := m.clearedFields[dialog.FieldLastMsgID]
+	return ok
+}
+
+// ResetLastMsgID resets all changes to the "last_msg_id" field.
+func (m *DialogMutation) ResetLastMsgID() {
+	m.last_msg_id = nil
+	m.addlast_msg_id = nil
+	delete(m.clearedFields, dialog.FieldLastMsgID)
+}
+
+// SetMigratedToID sets the "migrated_to_id" field.
+func (m *DialogMutation) SetMigratedToID(i int64) {
+	m.migrated_to_id = &i
+	m.addmigrated_to_id = nil
+}
+
+// MigratedToID returns the value of the "migrated_to_id" field in the mutation.
+func (m *DialogMutation) MigratedToID() (r int64, exists bool) {
+	v := m.migrated_to_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMigratedToID returns the old "migrated_to_id" field's value of the Dialog entity.
+// If the Dialog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DialogMutation) OldMigratedToID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMigratedToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMigratedToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMigratedToID: %w", err)
+	}
+	return oldValue.MigratedToID, nil
+}
+
+// AddMigratedToID adds i to the "migrated_to_id" field.
+func (m *DialogMutation) AddMigratedToID(i int64) {
+	if m.addmigrated_to_id != nil {
+		*m.addmigrated_to_id += i
+	} else {
+		m.addmigrated_to_id = &i
+	}
+}
+
+// AddedMigratedToID returns the value that was added to the "migrated_to_id" field in this mutation.
+func (m *DialogMutation) AddedMigratedToID() (r int64, exists bool) {
+	v := m.addmigrated_to_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMigratedToID clears the value of the "migrated_to_id" field.
+func (m *DialogMutation) ClearMigratedToID() {
+	m.migrated_to_id = nil
+	m.addmigrated_to_id = nil
+	m.clearedFields[dialog.FieldMigratedToID] = struct{}{}
+}
+
+// MigratedToIDCleared returns if the "migrated_to_id" field was cleared in this mutation.
+func (m *DialogMutation) MigratedToIDCleared() bool {
+	_, ok := m.clearedFields[dialog.FieldMigratedToID]
+	return ok
+}
+
+// ResetMigratedToID resets all changes to the "migrated_to_id" field.
+func (m *DialogMutation) ResetMigratedToID() {
+	m.migrated_to_id = nil
+	m.addmigrated_to_id = nil
+	delete(m.clearedFields, dialog.FieldMigratedToID)
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by ids.
 func (m *DialogMutation) AddMessageIDs(ids ...uuid.UUID) {
 	if m.messages == nil {
@@ -350,7 +634,7 @@ func (m *DialogMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DialogMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 7)
 	if m.title != nil {
 		fields = append(fields, dialog.FieldTitle)
 	}
@@ -360,6 +644,18 @@ func (m *DialogMutation) Fields() []string {
 	if m.updated_at != nil {
 		fields = append(fields, dialog.FieldUpdatedAt)
 	}
+	if m.backfill_offset_id != nil {
+		fields = append(fields, dialog.FieldBackfillOffsetID)
+	}
+	if m.backfill_completed_at != nil {
+		fields = append(fields, dialog.FieldBackfillCompletedAt)
+	}
+	if m.last_msg_id != nil {
+		fields = append(fields, dialog.FieldLastMsgID)
+	}
+	if m.migrated_to_id != nil {
+		fields = append(fields, dialog.FieldMigratedToID)
+	}
 	return fields
 }
 
@@ -374,6 +670,14 @@ func (m *DialogMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case dialog.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case dialog.FieldBackfillOffsetID:
+		return m.BackfillOffsetID()
+	case dialog.FieldBackfillCompletedAt:
+		return m.BackfillCompletedAt()
+	case dialog.FieldLastMsgID:
+		return m.LastMsgID()
+	case dialog.FieldMigratedToID:
+		return m.MigratedToID()
 	}
 	return nil, false
 }
@@ -389,6 +693,14 @@ func (m *DialogMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldType(ctx)
 	case dialog.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case dialog.FieldBackfillOffsetID:
+		return m.OldBackfillOffsetID(ctx)
+	case dialog.FieldBackfillCompletedAt:
+		return m.OldBackfillCompletedAt(ctx)
+	case dialog.FieldLastMsgID:
+		return m.OldLastMsgID(ctx)
+	case dialog.FieldMigratedToID:
+		return m.OldMigratedToID(ctx)
 	}
 	return nil, fmt.Errorf("unknown Dialog field %s", name)
 }
@@ -419,6 +731,34 @@ func (m *DialogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case dialog.FieldBackfillOffsetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackfillOffsetID(v)
+		return nil
+	case dialog.FieldBackfillCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackfillCompletedAt(v)
+		return nil
+	case dialog.FieldLastMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastMsgID(v)
+		return nil
+	case dialog.FieldMigratedToID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMigratedToID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Dialog field %s", name)
 }
@@ -426,13 +766,31 @@ func (m *DialogMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *DialogMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addbackfill_offset_id != nil {
+		fields = append(fields, dialog.FieldBackfillOffsetID)
+	}
+	if m.addlast_msg_id != nil {
+		fields = append(fields, dialog.FieldLastMsgID)
+	}
+	if m.addmigrated_to_id != nil {
+		fields = append(fields, dialog.FieldMigratedToID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *DialogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dialog.FieldBackfillOffsetID:
+		return m.AddedBackfillOffsetID()
+	case dialog.FieldLastMsgID:
+		return m.AddedLastMsgID()
+	case dialog.FieldMigratedToID:
+		return m.AddedMigratedToID()
+	}
 	return nil, false
 }
 
@@ -441,6 +799,27 @@ func (m *DialogMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *DialogMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case dialog.FieldBackfillOffsetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBackfillOffsetID(v)
+		return nil
+	case dialog.FieldLastMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastMsgID(v)
+		return nil
+	case dialog.FieldMigratedToID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMigratedToID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Dialog numeric field %s", name)
 }
@@ -448,7 +827,20 @@ func (m *DialogMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *DialogMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(dialog.FieldBackfillOffsetID) {
+		fields = append(fields, dialog.FieldBackfillOffsetID)
+	}
+	if m.FieldCleared(dialog.FieldBackfillCompletedAt) {
+		fields = append(fields, dialog.FieldBackfillCompletedAt)
+	}
+	if m.FieldCleared(dialog.FieldLastMsgID) {
+		fields = append(fields, dialog.FieldLastMsgID)
+	}
+	if m.FieldCleared(dialog.FieldMigratedToID) {
+		fields = append(fields, dialog.FieldMigratedToID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -461,6 +853,20 @@ func (m *DialogMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *DialogMutation) ClearField(name string) error {
+	switch name {
+	case dialog.FieldBackfillOffsetID:
+		m.ClearBackfillOffsetID()
+		return nil
+	case dialog.FieldBackfillCompletedAt:
+		m.ClearBackfillCompletedAt()
+		return nil
+	case dialog.FieldLastMsgID:
+		m.ClearLastMsgID()
+		return nil
+	case dialog.FieldMigratedToID:
+		m.ClearMigratedToID()
+		return nil
+	}
 	return fmt.Errorf("unknown Dialog nullable field %s", name)
 }
 
@@ -477,6 +883,18 @@ func (m *DialogMutation) ResetField(name string) error {
 	case dialog.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case dialog.FieldBackfillOffsetID:
+		m.ResetBackfillOffsetID()
+		return nil
+	case dialog.FieldBackfillCompletedAt:
+		m.ResetBackfillCompletedAt()
+		return nil
+	case dialog.FieldLastMsgID:
+		m.ResetLastMsgID()
+		return nil
+	case dialog.FieldMigratedToID:
+		m.ResetMigratedToID()
+		return nil
 	}
 	return fmt.Errorf("unknown Dialog field %s", name)
 }
@@ -565,38 +983,35 @@ func (m *DialogMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Dialog edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// EmbeddingCacheMutation represents an operation that mutates the EmbeddingCache nodes in the graph.
+type EmbeddingCacheMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	msg_id         *int
-	addmsg_id      *int
-	text           *string
-	text_embedding *pgvector.Vector
-	has_media      *bool
-	media_info     **types.MediaInfo
-	sent_at        *time.Time
-	clearedFields  map[string]struct{}
-	dialog         *int64
-	cleareddialog  bool
-	done           bool
-	oldValue       func(context.Context) (*Message, error)
-	predicates     []predicate.Message
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	hash          *[]byte
+	model         *string
+	embedding     *pgvector.Vector
+	hits          *int
+	addhits       *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmbeddingCache, error)
+	predicates    []predicate.EmbeddingCache
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*EmbeddingCacheMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// embeddingcacheOption allows management of the mutation configuration using functional options.
+type embeddingcacheOption func(*EmbeddingCacheMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newEmbeddingCacheMutation creates new mutation for the EmbeddingCache entity.
+func newEmbeddingCacheMutation(c config, op Op, opts ...embeddingcacheOption) *EmbeddingCacheMutation {
+	m := &EmbeddingCacheMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeEmbeddingCache,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -605,20 +1020,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withEmbeddingCacheID sets the ID field of the mutation.
+func withEmbeddingCacheID(id uuid.UUID) embeddingcacheOption {
+	return func(m *EmbeddingCacheMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *EmbeddingCache
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*EmbeddingCache, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().EmbeddingCache.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -627,10 +1042,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withEmbeddingCache sets the old EmbeddingCache of the mutation.
+func withEmbeddingCache(node *EmbeddingCache) embeddingcacheOption {
+	return func(m *EmbeddingCacheMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingCache, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -639,7 +1054,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m EmbeddingCacheMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -647,7 +1062,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m EmbeddingCacheMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -657,14 +1072,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EmbeddingCache entities.
+func (m *EmbeddingCacheMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EmbeddingCacheMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -675,7 +1090,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EmbeddingCacheMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -684,333 +1099,7600 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmbeddingCache.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMsgID sets the "msg_id" field.
-func (m *MessageMutation) SetMsgID(i int) {
-	m.msg_id = &i
-	m.addmsg_id = nil
+// SetHash sets the "hash" field.
+func (m *EmbeddingCacheMutation) SetHash(b []byte) {
+	m.hash = &b
 }
 
-// MsgID returns the value of the "msg_id" field in the mutation.
-func (m *MessageMutation) MsgID() (r int, exists bool) {
-	v := m.msg_id
+// Hash returns the value of the "hash" field in the mutation.
+func (m *EmbeddingCacheMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMsgID returns the old "msg_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the EmbeddingCache entity.
+// If the EmbeddingCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMsgID(ctx context.Context) (v int, err error) {
+func (m *EmbeddingCacheMutation) OldHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsgID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsgID requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsgID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.MsgID, nil
-}
-
-// AddMsgID adds i to the "msg_id" field.
-func (m *MessageMutation) AddMsgID(i int) {
-	if m.addmsg_id != nil {
-		*m.addmsg_id += i
-	} else {
-		m.addmsg_id = &i
-	}
-}
-
-// AddedMsgID returns the value that was added to the "msg_id" field in this mutation.
-func (m *MessageMutation) AddedMsgID() (r int, exists bool) {
-	v := m.addmsg_id
-	if v == nil {
-		return
-	}
-	return *v, true
+	return oldValue.Hash, nil
 }
 
-// ResetMsgID resets all changes to the "msg_id" field.
-func (m *MessageMutation) ResetMsgID() {
-	m.msg_id = nil
-	m.addmsg_id = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *EmbeddingCacheMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetDialogID sets the "dialog_id" field.
-func (m *MessageMutation) SetDialogID(i int64) {
-	m.dialog = &i
+// SetModel sets the "model" field.
+func (m *EmbeddingCacheMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// DialogID returns the value of the "dialog_id" field in the mutation.
-func (m *MessageMutation) DialogID() (r int64, exists bool) {
-	v := m.dialog
+// Model returns the value of the "model" field in the mutation.
+func (m *EmbeddingCacheMutation) Model() (r string, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDialogID returns the old "dialog_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the EmbeddingCache entity.
+// If the EmbeddingCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDialogID(ctx context.Context) (v int64, err error) {
+func (m *EmbeddingCacheMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDialogID is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDialogID requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDialogID: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.DialogID, nil
+	return oldValue.Model, nil
 }
 
-// ResetDialogID resets all changes to the "dialog_id" field.
-func (m *MessageMutation) ResetDialogID() {
-	m.dialog = nil
+// ResetModel resets all changes to the "model" field.
+func (m *EmbeddingCacheMutation) ResetModel() {
+	m.model = nil
 }
 
-// SetText sets the "text" field.
-func (m *MessageMutation) SetText(s string) {
-	m.text = &s
+// SetEmbedding sets the "embedding" field.
+func (m *EmbeddingCacheMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *MessageMutation) Text() (r string, exists bool) {
-	v := m.text
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *EmbeddingCacheMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldEmbedding returns the old "embedding" field's value of the EmbeddingCache entity.
+// If the EmbeddingCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *EmbeddingCacheMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Embedding, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *MessageMutation) ResetText() {
-	m.text = nil
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *EmbeddingCacheMutation) ResetEmbedding() {
+	m.embedding = nil
 }
 
-// SetTextEmbedding sets the "text_embedding" field.
-func (m *MessageMutation) SetTextEmbedding(pg pgvector.Vector) {
-	m.text_embedding = &pg
+// SetHits sets the "hits" field.
+func (m *EmbeddingCacheMutation) SetHits(i int) {
+	m.hits = &i
+	m.addhits = nil
 }
 
-// TextEmbedding returns the value of the "text_embedding" field in the mutation.
-func (m *MessageMutation) TextEmbedding() (r pgvector.Vector, exists bool) {
-	v := m.text_embedding
+// Hits returns the value of the "hits" field in the mutation.
+func (m *EmbeddingCacheMutation) Hits() (r int, exists bool) {
+	v := m.hits
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTextEmbedding returns the old "text_embedding" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldHits returns the old "hits" field's value of the EmbeddingCache entity.
+// If the EmbeddingCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldTextEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+func (m *EmbeddingCacheMutation) OldHits(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTextEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldHits is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTextEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldHits requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTextEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldHits: %w", err)
 	}
-	return oldValue.TextEmbedding, nil
+	return oldValue.Hits, nil
 }
 
-// ClearTextEmbedding clears the value of the "text_embedding" field.
-func (m *MessageMutation) ClearTextEmbedding() {
-	m.text_embedding = nil
-	m.clearedFields[message.FieldTextEmbedding] = struct{}{}
+// AddHits adds i to the "hits" field.
+func (m *EmbeddingCacheMutation) AddHits(i int) {
+	if m.addhits != nil {
+		*m.addhits += i
+	} else {
+		m.addhits = &i
+	}
 }
 
-// TextEmbeddingCleared returns if the "text_embedding" field was cleared in this mutation.
-func (m *MessageMutation) TextEmbeddingCleared() bool {
-	_, ok := m.clearedFields[message.FieldTextEmbedding]
-	return ok
+// AddedHits returns the value that was added to the "hits" field in this mutation.
+func (m *EmbeddingCacheMutation) AddedHits() (r int, exists bool) {
+	v := m.addhits
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTextEmbedding resets all changes to the "text_embedding" field.
-func (m *MessageMutation) ResetTextEmbedding() {
-	m.text_embedding = nil
-	delete(m.clearedFields, message.FieldTextEmbedding)
+// ResetHits resets all changes to the "hits" field.
+func (m *EmbeddingCacheMutation) ResetHits() {
+	m.hits = nil
+	m.addhits = nil
 }
 
-// SetHasMedia sets the "has_media" field.
-func (m *MessageMutation) SetHasMedia(b bool) {
-	m.has_media = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *EmbeddingCacheMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// HasMedia returns the value of the "has_media" field in the mutation.
-func (m *MessageMutation) HasMedia() (r bool, exists bool) {
-	v := m.has_media
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmbeddingCacheMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHasMedia returns the old "has_media" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EmbeddingCache entity.
+// If the EmbeddingCache object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldHasMedia(ctx context.Context) (v bool, err error) {
+func (m *EmbeddingCacheMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasMedia is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasMedia requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasMedia: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.HasMedia, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetHasMedia resets all changes to the "has_media" field.
-func (m *MessageMutation) ResetHasMedia() {
-	m.has_media = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmbeddingCacheMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetMediaInfo sets the "media_info" field.
-func (m *MessageMutation) SetMediaInfo(ti *types.MediaInfo) {
-	m.media_info = &ti
+// Where appends a list predicates to the EmbeddingCacheMutation builder.
+func (m *EmbeddingCacheMutation) Where(ps ...predicate.EmbeddingCache) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// MediaInfo returns the value of the "media_info" field in the mutation.
-func (m *MessageMutation) MediaInfo() (r *types.MediaInfo, exists bool) {
-	v := m.media_info
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the EmbeddingCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldMediaInfo returns the old "media_info" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMediaInfo(ctx context.Context) (v *types.MediaInfo, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaInfo is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *EmbeddingCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmbeddingCache).
+func (m *EmbeddingCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingCacheMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hash != nil {
+		fields = append(fields, embeddingcache.FieldHash)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaInfo requires an ID field in the mutation")
+	if m.model != nil {
+		fields = append(fields, embeddingcache.FieldModel)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaInfo: %w", err)
+	if m.embedding != nil {
+		fields = append(fields, embeddingcache.FieldEmbedding)
 	}
-	return oldValue.MediaInfo, nil
+	if m.hits != nil {
+		fields = append(fields, embeddingcache.FieldHits)
+	}
+	if m.created_at != nil {
+		fields = append(fields, embeddingcache.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ResetMediaInfo resets all changes to the "media_info" field.
-func (m *MessageMutation) ResetMediaInfo() {
-	m.media_info = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingcache.FieldHash:
+		return m.Hash()
+	case embeddingcache.FieldModel:
+		return m.Model()
+	case embeddingcache.FieldEmbedding:
+		return m.Embedding()
+	case embeddingcache.FieldHits:
+		return m.Hits()
+	case embeddingcache.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// SetSentAt sets the "sent_at" field.
-func (m *MessageMutation) SetSentAt(t time.Time) {
-	m.sent_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingcache.FieldHash:
+		return m.OldHash(ctx)
+	case embeddingcache.FieldModel:
+		return m.OldModel(ctx)
+	case embeddingcache.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case embeddingcache.FieldHits:
+		return m.OldHits(ctx)
+	case embeddingcache.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmbeddingCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingcache.FieldHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case embeddingcache.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case embeddingcache.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case embeddingcache.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHits(v)
+		return nil
+	case embeddingcache.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingCacheMutation) AddedFields() []string {
+	var fields []string
+	if m.addhits != nil {
+		fields = append(fields, embeddingcache.FieldHits)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingCacheMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingcache.FieldHits:
+		return m.AddedHits()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingcache.FieldHits:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHits(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmbeddingCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingCacheMutation) ResetField(name string) error {
+	switch name {
+	case embeddingcache.FieldHash:
+		m.ResetHash()
+		return nil
+	case embeddingcache.FieldModel:
+		m.ResetModel()
+		return nil
+	case embeddingcache.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case embeddingcache.FieldHits:
+		m.ResetHits()
+		return nil
+	case embeddingcache.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingCache edge %s", name)
+}
+
+// EmbeddingSpendMutation represents an operation that mutates the EmbeddingSpend nodes in the graph.
+type EmbeddingSpendMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	day           *time.Time
+	provider      *string
+	spent         *float64
+	addspent      *float64
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmbeddingSpend, error)
+	predicates    []predicate.EmbeddingSpend
+}
+
+var _ ent.Mutation = (*EmbeddingSpendMutation)(nil)
+
+// embeddingspendOption allows management of the mutation configuration using functional options.
+type embeddingspendOption func(*EmbeddingSpendMutation)
+
+// newEmbeddingSpendMutation creates new mutation for the EmbeddingSpend entity.
+func newEmbeddingSpendMutation(c config, op Op, opts ...embeddingspendOption) *EmbeddingSpendMutation {
+	m := &EmbeddingSpendMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmbeddingSpend,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmbeddingSpendID sets the ID field of the mutation.
+func withEmbeddingSpendID(id uuid.UUID) embeddingspendOption {
+	return func(m *EmbeddingSpendMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmbeddingSpend
+		)
+		m.oldValue = func(ctx context.Context) (*EmbeddingSpend, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmbeddingSpend.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmbeddingSpend sets the old EmbeddingSpend of the mutation.
+func withEmbeddingSpend(node *EmbeddingSpend) embeddingspendOption {
+	return func(m *EmbeddingSpendMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingSpend, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmbeddingSpendMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmbeddingSpendMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmbeddingSpend entities.
+func (m *EmbeddingSpendMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmbeddingSpendMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmbeddingSpendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmbeddingSpend.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDay sets the "day" field.
+func (m *EmbeddingSpendMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *EmbeddingSpendMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the EmbeddingSpend entity.
+// If the EmbeddingSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingSpendMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *EmbeddingSpendMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *EmbeddingSpendMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *EmbeddingSpendMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the EmbeddingSpend entity.
+// If the EmbeddingSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingSpendMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *EmbeddingSpendMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSpent sets the "spent" field.
+func (m *EmbeddingSpendMutation) SetSpent(f float64) {
+	m.spent = &f
+	m.addspent = nil
+}
+
+// Spent returns the value of the "spent" field in the mutation.
+func (m *EmbeddingSpendMutation) Spent() (r float64, exists bool) {
+	v := m.spent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpent returns the old "spent" field's value of the EmbeddingSpend entity.
+// If the EmbeddingSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingSpendMutation) OldSpent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpent: %w", err)
+	}
+	return oldValue.Spent, nil
+}
+
+// AddSpent adds f to the "spent" field.
+func (m *EmbeddingSpendMutation) AddSpent(f float64) {
+	if m.addspent != nil {
+		*m.addspent += f
+	} else {
+		m.addspent = &f
+	}
+}
+
+// AddedSpent returns the value that was added to the "spent" field in this mutation.
+func (m *EmbeddingSpendMutation) AddedSpent() (r float64, exists bool) {
+	v := m.addspent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpent resets all changes to the "spent" field.
+func (m *EmbeddingSpendMutation) ResetSpent() {
+	m.spent = nil
+	m.addspent = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EmbeddingSpendMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EmbeddingSpendMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EmbeddingSpend entity.
+// If the EmbeddingSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingSpendMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EmbeddingSpendMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the EmbeddingSpendMutation builder.
+func (m *EmbeddingSpendMutation) Where(ps ...predicate.EmbeddingSpend) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmbeddingSpendMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingSpendMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingSpend, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmbeddingSpendMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingSpendMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmbeddingSpend).
+func (m *EmbeddingSpendMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingSpendMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.day != nil {
+		fields = append(fields, embeddingspend.FieldDay)
+	}
+	if m.provider != nil {
+		fields = append(fields, embeddingspend.FieldProvider)
+	}
+	if m.spent != nil {
+		fields = append(fields, embeddingspend.FieldSpent)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, embeddingspend.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingSpendMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingspend.FieldDay:
+		return m.Day()
+	case embeddingspend.FieldProvider:
+		return m.Provider()
+	case embeddingspend.FieldSpent:
+		return m.Spent()
+	case embeddingspend.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingSpendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingspend.FieldDay:
+		return m.OldDay(ctx)
+	case embeddingspend.FieldProvider:
+		return m.OldProvider(ctx)
+	case embeddingspend.FieldSpent:
+		return m.OldSpent(ctx)
+	case embeddingspend.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmbeddingSpend field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingSpendMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingspend.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case embeddingspend.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case embeddingspend.FieldSpent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpent(v)
+		return nil
+	case embeddingspend.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingSpend field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingSpendMutation) AddedFields() []string {
+	var fields []string
+	if m.addspent != nil {
+		fields = append(fields, embeddingspend.FieldSpent)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingSpendMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingspend.FieldSpent:
+		return m.AddedSpent()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingSpendMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingspend.FieldSpent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingSpend numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingSpendMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingSpendMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingSpendMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmbeddingSpend nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingSpendMutation) ResetField(name string) error {
+	switch name {
+	case embeddingspend.FieldDay:
+		m.ResetDay()
+		return nil
+	case embeddingspend.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case embeddingspend.FieldSpent:
+		m.ResetSpent()
+		return nil
+	case embeddingspend.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingSpend field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingSpendMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingSpendMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingSpendMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingSpendMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingSpendMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingSpendMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingSpendMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingSpend unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingSpendMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingSpend edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	msg_id                         *int
+	addmsg_id                      *int
+	reply_to_msg_id                *int
+	addreply_to_msg_id             *int
+	top_msg_id                     *int
+	addtop_msg_id                  *int
+	topic_id                       *int
+	addtopic_id                    *int
+	fwd_from_id                    *int64
+	addfwd_from_id                 *int64
+	fwd_from_name                  *string
+	fwd_from_msg_id                *int
+	addfwd_from_msg_id             *int
+	fwd_from_date                  *time.Time
+	fwd_post_author                *string
+	text                           *string
+	derived_text                   *string
+	text_embedding                 *pgvector.Vector
+	embedding_strategy             *types.EmbeddingStrategy
+	embedding_model                *string
+	next_embedding_model           *string
+	embedding_attempts             *int
+	addembedding_attempts          *int
+	embedding_error                *string
+	embedding_retry_at             *time.Time
+	embedding_failed_at            *time.Time
+	embedding_skipped              *bool
+	has_media                      *bool
+	media_info                     **types.MediaInfo
+	sent_at                        *time.Time
+	edited_at                      *time.Time
+	deleted_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	dialog                         *int64
+	cleareddialog                  bool
+	sender                         *int64
+	clearedsender                  bool
+	revisions                      map[uuid.UUID]struct{}
+	removedrevisions               map[uuid.UUID]struct{}
+	clearedrevisions               bool
+	chunks                         map[uuid.UUID]struct{}
+	removedchunks                  map[uuid.UUID]struct{}
+	clearedchunks                  bool
+	next_embeddings                map[uuid.UUID]struct{}
+	removednext_embeddings         map[uuid.UUID]struct{}
+	clearednext_embeddings         bool
+	next_embedding_failures        map[uuid.UUID]struct{}
+	removednext_embedding_failures map[uuid.UUID]struct{}
+	clearednext_embedding_failures bool
+	done                           bool
+	oldValue                       func(context.Context) (*Message, error)
+	predicates                     []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMsgID sets the "msg_id" field.
+func (m *MessageMutation) SetMsgID(i int) {
+	m.msg_id = &i
+	m.addmsg_id = nil
+}
+
+// MsgID returns the value of the "msg_id" field in the mutation.
+func (m *MessageMutation) MsgID() (r int, exists bool) {
+	v := m.msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMsgID returns the old "msg_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMsgID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMsgID: %w", err)
+	}
+	return oldValue.MsgID, nil
+}
+
+// AddMsgID adds i to the "msg_id" field.
+func (m *MessageMutation) AddMsgID(i int) {
+	if m.addmsg_id != nil {
+		*m.addmsg_id += i
+	} else {
+		m.addmsg_id = &i
+	}
+}
+
+// AddedMsgID returns the value that was added to the "msg_id" field in this mutation.
+func (m *MessageMutation) AddedMsgID() (r int, exists bool) {
+	v := m.addmsg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMsgID resets all changes to the "msg_id" field.
+func (m *MessageMutation) ResetMsgID() {
+	m.msg_id = nil
+	m.addmsg_id = nil
+}
+
+// SetDialogID sets the "dialog_id" field.
+func (m *MessageMutation) SetDialogID(i int64) {
+	m.dialog = &i
+}
+
+// DialogID returns the value of the "dialog_id" field in the mutation.
+func (m *MessageMutation) DialogID() (r int64, exists bool) {
+	v := m.dialog
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDialogID returns the old "dialog_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDialogID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDialogID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDialogID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDialogID: %w", err)
+	}
+	return oldValue.DialogID, nil
+}
+
+// ResetDialogID resets all changes to the "dialog_id" field.
+func (m *MessageMutation) ResetDialogID() {
+	m.dialog = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *MessageMutation) SetSenderID(i int64) {
+	m.sender = &i
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *MessageMutation) SenderID() (r int64, exists bool) {
+	v := m.sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (m *MessageMutation) ClearSenderID() {
+	m.sender = nil
+	m.clearedFields[message.FieldSenderID] = struct{}{}
+}
+
+// SenderIDCleared returns if the "sender_id" field was cleared in this mutation.
+func (m *MessageMutation) SenderIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldSenderID]
+	return ok
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *MessageMutation) ResetSenderID() {
+	m.sender = nil
+	delete(m.clearedFields, message.FieldSenderID)
+}
+
+// SetReplyToMsgID sets the "reply_to_msg_id" field.
+func (m *MessageMutation) SetReplyToMsgID(i int) {
+	m.reply_to_msg_id = &i
+	m.addreply_to_msg_id = nil
+}
+
+// ReplyToMsgID returns the value of the "reply_to_msg_id" field in the mutation.
+func (m *MessageMutation) ReplyToMsgID() (r int, exists bool) {
+	v := m.reply_to_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToMsgID returns the old "reply_to_msg_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToMsgID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToMsgID: %w", err)
+	}
+	return oldValue.ReplyToMsgID, nil
+}
+
+// AddReplyToMsgID adds i to the "reply_to_msg_id" field.
+func (m *MessageMutation) AddReplyToMsgID(i int) {
+	if m.addreply_to_msg_id != nil {
+		*m.addreply_to_msg_id += i
+	} else {
+		m.addreply_to_msg_id = &i
+	}
+}
+
+// AddedReplyToMsgID returns the value that was added to the "reply_to_msg_id" field in this mutation.
+func (m *MessageMutation) AddedReplyToMsgID() (r int, exists bool) {
+	v := m.addreply_to_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReplyToMsgID clears the value of the "reply_to_msg_id" field.
+func (m *MessageMutation) ClearReplyToMsgID() {
+	m.reply_to_msg_id = nil
+	m.addreply_to_msg_id = nil
+	m.clearedFields[message.FieldReplyToMsgID] = struct{}{}
+}
+
+// ReplyToMsgIDCleared returns if the "reply_to_msg_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToMsgIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToMsgID]
+	return ok
+}
+
+// ResetReplyToMsgID resets all changes to the "reply_to_msg_id" field.
+func (m *MessageMutation) ResetReplyToMsgID() {
+	m.reply_to_msg_id = nil
+	m.addreply_to_msg_id = nil
+	delete(m.clearedFields, message.FieldReplyToMsgID)
+}
+
+// SetTopMsgID sets the "top_msg_id" field.
+func (m *MessageMutation) SetTopMsgID(i int) {
+	m.top_msg_id = &i
+	m.addtop_msg_id = nil
+}
+
+// TopMsgID returns the value of the "top_msg_id" field in the mutation.
+func (m *MessageMutation) TopMsgID() (r int, exists bool) {
+	v := m.top_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopMsgID returns the old "top_msg_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTopMsgID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopMsgID: %w", err)
+	}
+	return oldValue.TopMsgID, nil
+}
+
+// AddTopMsgID adds i to the "top_msg_id" field.
+func (m *MessageMutation) AddTopMsgID(i int) {
+	if m.addtop_msg_id != nil {
+		*m.addtop_msg_id += i
+	} else {
+		m.addtop_msg_id = &i
+	}
+}
+
+// AddedTopMsgID returns the value that was added to the "top_msg_id" field in this mutation.
+func (m *MessageMutation) AddedTopMsgID() (r int, exists bool) {
+	v := m.addtop_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTopMsgID clears the value of the "top_msg_id" field.
+func (m *MessageMutation) ClearTopMsgID() {
+	m.top_msg_id = nil
+	m.addtop_msg_id = nil
+	m.clearedFields[message.FieldTopMsgID] = struct{}{}
+}
+
+// TopMsgIDCleared returns if the "top_msg_id" field was cleared in this mutation.
+func (m *MessageMutation) TopMsgIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldTopMsgID]
+	return ok
+}
+
+// ResetTopMsgID resets all changes to the "top_msg_id" field.
+func (m *MessageMutation) ResetTopMsgID() {
+	m.top_msg_id = nil
+	m.addtop_msg_id = nil
+	delete(m.clearedFields, message.FieldTopMsgID)
+}
+
+// SetTopicID sets the "topic_id" field.
+func (m *MessageMutation) SetTopicID(i int) {
+	m.topic_id = &i
+	m.addtopic_id = nil
+}
+
+// TopicID returns the value of the "topic_id" field in the mutation.
+func (m *MessageMutation) TopicID() (r int, exists bool) {
+	v := m.topic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopicID returns the old "topic_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTopicID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopicID: %w", err)
+	}
+	return oldValue.TopicID, nil
+}
+
+// AddTopicID adds i to the "topic_id" field.
+func (m *MessageMutation) AddTopicID(i int) {
+	if m.addtopic_id != nil {
+		*m.addtopic_id += i
+	} else {
+		m.addtopic_id = &i
+	}
+}
+
+// AddedTopicID returns the value that was added to the "topic_id" field in this mutation.
+func (m *MessageMutation) AddedTopicID() (r int, exists bool) {
+	v := m.addtopic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTopicID clears the value of the "topic_id" field.
+func (m *MessageMutation) ClearTopicID() {
+	m.topic_id = nil
+	m.addtopic_id = nil
+	m.clearedFields[message.FieldTopicID] = struct{}{}
+}
+
+// TopicIDCleared returns if the "topic_id" field was cleared in this mutation.
+func (m *MessageMutation) TopicIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldTopicID]
+	return ok
+}
+
+// ResetTopicID resets all changes to the "topic_id" field.
+func (m *MessageMutation) ResetTopicID() {
+	m.topic_id = nil
+	m.addtopic_id = nil
+	delete(m.clearedFields, message.FieldTopicID)
+}
+
+// SetFwdFromID sets the "fwd_from_id" field.
+func (m *MessageMutation) SetFwdFromID(i int64) {
+	m.fwd_from_id = &i
+	m.addfwd_from_id = nil
+}
+
+// FwdFromID returns the value of the "fwd_from_id" field in the mutation.
+func (m *MessageMutation) FwdFromID() (r int64, exists bool) {
+	v := m.fwd_from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFwdFromID returns the old "fwd_from_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldFwdFromID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFwdFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFwdFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFwdFromID: %w", err)
+	}
+	return oldValue.FwdFromID, nil
+}
+
+// AddFwdFromID adds i to the "fwd_from_id" field.
+func (m *MessageMutation) AddFwdFromID(i int64) {
+	if m.addfwd_from_id != nil {
+		*m.addfwd_from_id += i
+	} else {
+		m.addfwd_from_id = &i
+	}
+}
+
+// AddedFwdFromID returns the value that was added to the "fwd_from_id" field in this mutation.
+func (m *MessageMutation) AddedFwdFromID() (r int64, exists bool) {
+	v := m.addfwd_from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFwdFromID clears the value of the "fwd_from_id" field.
+func (m *MessageMutation) ClearFwdFromID() {
+	m.fwd_from_id = nil
+	m.addfwd_from_id = nil
+	m.clearedFields[message.FieldFwdFromID] = struct{}{}
+}
+
+// FwdFromIDCleared returns if the "fwd_from_id" field was cleared in this mutation.
+func (m *MessageMutation) FwdFromIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldFwdFromID]
+	return ok
+}
+
+// ResetFwdFromID resets all changes to the "fwd_from_id" field.
+func (m *MessageMutation) ResetFwdFromID() {
+	m.fwd_from_id = nil
+	m.addfwd_from_id = nil
+	delete(m.clearedFields, message.FieldFwdFromID)
+}
+
+// SetFwdFromName sets the "fwd_from_name" field.
+func (m *MessageMutation) SetFwdFromName(s string) {
+	m.fwd_from_name = &s
+}
+
+// FwdFromName returns the value of the "fwd_from_name" field in the mutation.
+func (m *MessageMutation) FwdFromName() (r string, exists bool) {
+	v := m.fwd_from_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFwdFromName returns the old "fwd_from_name" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldFwdFromName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFwdFromName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFwdFromName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFwdFromName: %w", err)
+	}
+	return oldValue.FwdFromName, nil
+}
+
+// ClearFwdFromName clears the value of the "fwd_from_name" field.
+func (m *MessageMutation) ClearFwdFromName() {
+	m.fwd_from_name = nil
+	m.clearedFields[message.FieldFwdFromName] = struct{}{}
+}
+
+// FwdFromNameCleared returns if the "fwd_from_name" field was cleared in this mutation.
+func (m *MessageMutation) FwdFromNameCleared() bool {
+	_, ok := m.clearedFields[message.FieldFwdFromName]
+	return ok
+}
+
+// ResetFwdFromName resets all changes to the "fwd_from_name" field.
+func (m *MessageMutation) ResetFwdFromName() {
+	m.fwd_from_name = nil
+	delete(m.clearedFields, message.FieldFwdFromName)
+}
+
+// SetFwdFromMsgID sets the "fwd_from_msg_id" field.
+func (m *MessageMutation) SetFwdFromMsgID(i int) {
+	m.fwd_from_msg_id = &i
+	m.addfwd_from_msg_id = nil
+}
+
+// FwdFromMsgID returns the value of the "fwd_from_msg_id" field in the mutation.
+func (m *MessageMutation) FwdFromMsgID() (r int, exists bool) {
+	v := m.fwd_from_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFwdFromMsgID returns the old "fwd_from_msg_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldFwdFromMsgID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFwdFromMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFwdFromMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFwdFromMsgID: %w", err)
+	}
+	return oldValue.FwdFromMsgID, nil
+}
+
+// AddFwdFromMsgID adds i to the "fwd_from_msg_id" field.
+func (m *MessageMutation) AddFwdFromMsgID(i int) {
+	if m.addfwd_from_msg_id != nil {
+		*m.addfwd_from_msg_id += i
+	} else {
+		m.addfwd_from_msg_id = &i
+	}
+}
+
+// AddedFwdFromMsgID returns the value that was added to the "fwd_from_msg_id" field in this mutation.
+func (m *MessageMutation) AddedFwdFromMsgID() (r int, exists bool) {
+	v := m.addfwd_from_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFwdFromMsgID clears the value of the "fwd_from_msg_id" field.
+func (m *MessageMutation) ClearFwdFromMsgID() {
+	m.fwd_from_msg_id = nil
+	m.addfwd_from_msg_id = nil
+	m.clearedFields[message.FieldFwdFromMsgID] = struct{}{}
+}
+
+// FwdFromMsgIDCleared returns if the "fwd_from_msg_id" field was cleared in this mutation.
+func (m *MessageMutation) FwdFromMsgIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldFwdFromMsgID]
+	return ok
+}
+
+// ResetFwdFromMsgID resets all changes to the "fwd_from_msg_id" field.
+func (m *MessageMutation) ResetFwdFromMsgID() {
+	m.fwd_from_msg_id = nil
+	m.addfwd_from_msg_id = nil
+	delete(m.clearedFields, message.FieldFwdFromMsgID)
+}
+
+// SetFwdFromDate sets the "fwd_from_date" field.
+func (m *MessageMutation) SetFwdFromDate(t time.Time) {
+	m.fwd_from_date = &t
+}
+
+// FwdFromDate returns the value of the "fwd_from_date" field in the mutation.
+func (m *MessageMutation) FwdFromDate() (r time.Time, exists bool) {
+	v := m.fwd_from_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFwdFromDate returns the old "fwd_from_date" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldFwdFromDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFwdFromDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFwdFromDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFwdFromDate: %w", err)
+	}
+	return oldValue.FwdFromDate, nil
+}
+
+// ClearFwdFromDate clears the value of the "fwd_from_date" field.
+func (m *MessageMutation) ClearFwdFromDate() {
+	m.fwd_from_date = nil
+	m.clearedFields[message.FieldFwdFromDate] = struct{}{}
+}
+
+// FwdFromDateCleared returns if the "fwd_from_date" field was cleared in this mutation.
+func (m *MessageMutation) FwdFromDateCleared() bool {
+	_, ok := m.clearedFields[message.FieldFwdFromDate]
+	return ok
+}
+
+// ResetFwdFromDate resets all changes to the "fwd_from_date" field.
+func (m *MessageMutation) ResetFwdFromDate() {
+	m.fwd_from_date = nil
+	delete(m.clearedFields, message.FieldFwdFromDate)
+}
+
+// SetFwdPostAuthor sets the "fwd_post_author" field.
+func (m *MessageMutation) SetFwdPostAuthor(s string) {
+	m.fwd_post_author = &s
+}
+
+// FwdPostAuthor returns the value of the "fwd_post_author" field in the mutation.
+func (m *MessageMutation) FwdPostAuthor() (r string, exists bool) {
+	v := m.fwd_post_author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFwdPostAuthor returns the old "fwd_post_author" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldFwdPostAuthor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFwdPostAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFwdPostAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFwdPostAuthor: %w", err)
+	}
+	return oldValue.FwdPostAuthor, nil
+}
+
+// ClearFwdPostAuthor clears the value of the "fwd_post_author" field.
+func (m *MessageMutation) ClearFwdPostAuthor() {
+	m.fwd_post_author = nil
+	m.clearedFields[message.FieldFwdPostAuthor] = struct{}{}
+}
+
+// FwdPostAuthorCleared returns if the "fwd_post_author" field was cleared in this mutation.
+func (m *MessageMutation) FwdPostAuthorCleared() bool {
+	_, ok := m.clearedFields[message.FieldFwdPostAuthor]
+	return ok
+}
+
+// ResetFwdPostAuthor resets all changes to the "fwd_post_author" field.
+func (m *MessageMutation) ResetFwdPostAuthor() {
+	m.fwd_post_author = nil
+	delete(m.clearedFields, message.FieldFwdPostAuthor)
+}
+
+// SetText sets the "text" field.
+func (m *MessageMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *MessageMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *MessageMutation) ResetText() {
+	m.text = nil
+}
+
+// SetDerivedText sets the "derived_text" field.
+func (m *MessageMutation) SetDerivedText(s string) {
+	m.derived_text = &s
+}
+
+// DerivedText returns the value of the "derived_text" field in the mutation.
+func (m *MessageMutation) DerivedText() (r string, exists bool) {
+	v := m.derived_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDerivedText returns the old "derived_text" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDerivedText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDerivedText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDerivedText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDerivedText: %w", err)
+	}
+	return oldValue.DerivedText, nil
+}
+
+// ResetDerivedText resets all changes to the "derived_text" field.
+func (m *MessageMutation) ResetDerivedText() {
+	m.derived_text = nil
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (m *MessageMutation) SetTextEmbedding(pg pgvector.Vector) {
+	m.text_embedding = &pg
+}
+
+// TextEmbedding returns the value of the "text_embedding" field in the mutation.
+func (m *MessageMutation) TextEmbedding() (r pgvector.Vector, exists bool) {
+	v := m.text_embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextEmbedding returns the old "text_embedding" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTextEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextEmbedding: %w", err)
+	}
+	return oldValue.TextEmbedding, nil
+}
+
+// ClearTextEmbedding clears the value of the "text_embedding" field.
+func (m *MessageMutation) ClearTextEmbedding() {
+	m.text_embedding = nil
+	m.clearedFields[message.FieldTextEmbedding] = struct{}{}
+}
+
+// TextEmbeddingCleared returns if the "text_embedding" field was cleared in this mutation.
+func (m *MessageMutation) TextEmbeddingCleared() bool {
+	_, ok := m.clearedFields[message.FieldTextEmbedding]
+	return ok
+}
+
+// ResetTextEmbedding resets all changes to the "text_embedding" field.
+func (m *MessageMutation) ResetTextEmbedding() {
+	m.text_embedding = nil
+	delete(m.clearedFields, message.FieldTextEmbedding)
+}
+
+// SetEmbeddingStrategy sets the "embedding_strategy" field.
+func (m *MessageMutation) SetEmbeddingStrategy(ts types.EmbeddingStrategy) {
+	m.embedding_strategy = &ts
+}
+
+// EmbeddingStrategy returns the value of the "embedding_strategy" field in the mutation.
+func (m *MessageMutation) EmbeddingStrategy() (r types.EmbeddingStrategy, exists bool) {
+	v := m.embedding_strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingStrategy returns the old "embedding_strategy" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingStrategy(ctx context.Context) (v *types.EmbeddingStrategy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingStrategy: %w", err)
+	}
+	return oldValue.EmbeddingStrategy, nil
+}
+
+// ClearEmbeddingStrategy clears the value of the "embedding_strategy" field.
+func (m *MessageMutation) ClearEmbeddingStrategy() {
+	m.embedding_strategy = nil
+	m.clearedFields[message.FieldEmbeddingStrategy] = struct{}{}
+}
+
+// EmbeddingStrategyCleared returns if the "embedding_strategy" field was cleared in this mutation.
+func (m *MessageMutation) EmbeddingStrategyCleared() bool {
+	_, ok := m.clearedFields[message.FieldEmbeddingStrategy]
+	return ok
+}
+
+// ResetEmbeddingStrategy resets all changes to the "embedding_strategy" field.
+func (m *MessageMutation) ResetEmbeddingStrategy() {
+	m.embedding_strategy = nil
+	delete(m.clearedFields, message.FieldEmbeddingStrategy)
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *MessageMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *MessageMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (m *MessageMutation) ClearEmbeddingModel() {
+	m.embedding_model = nil
+	m.clearedFields[message.FieldEmbeddingModel] = struct{}{}
+}
+
+// EmbeddingModelCleared returns if the "embedding_model" field was cleared in this mutation.
+func (m *MessageMutation) EmbeddingModelCleared() bool {
+	_, ok := m.clearedFields[message.FieldEmbeddingModel]
+	return ok
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *MessageMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+	delete(m.clearedFields, message.FieldEmbeddingModel)
+}
+
+// SetNextEmbeddingModel sets the "next_embedding_model" field.
+func (m *MessageMutation) SetNextEmbeddingModel(s string) {
+	m.next_embedding_model = &s
+}
+
+// NextEmbeddingModel returns the value of the "next_embedding_model" field in the mutation.
+func (m *MessageMutation) NextEmbeddingModel() (r string, exists bool) {
+	v := m.next_embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextEmbeddingModel returns the old "next_embedding_model" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldNextEmbeddingModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextEmbeddingModel: %w", err)
+	}
+	return oldValue.NextEmbeddingModel, nil
+}
+
+// ClearNextEmbeddingModel clears the value of the "next_embedding_model" field.
+func (m *MessageMutation) ClearNextEmbeddingModel() {
+	m.next_embedding_model = nil
+	m.clearedFields[message.FieldNextEmbeddingModel] = struct{}{}
+}
+
+// NextEmbeddingModelCleared returns if the "next_embedding_model" field was cleared in this mutation.
+func (m *MessageMutation) NextEmbeddingModelCleared() bool {
+	_, ok := m.clearedFields[message.FieldNextEmbeddingModel]
+	return ok
+}
+
+// ResetNextEmbeddingModel resets all changes to the "next_embedding_model" field.
+func (m *MessageMutation) ResetNextEmbeddingModel() {
+	m.next_embedding_model = nil
+	delete(m.clearedFields, message.FieldNextEmbeddingModel)
+}
+
+// SetEmbeddingAttempts sets the "embedding_attempts" field.
+func (m *MessageMutation) SetEmbeddingAttempts(i int) {
+	m.embedding_attempts = &i
+	m.addembedding_attempts = nil
+}
+
+// EmbeddingAttempts returns the value of the "embedding_attempts" field in the mutation.
+func (m *MessageMutation) EmbeddingAttempts() (r int, exists bool) {
+	v := m.embedding_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingAttempts returns the old "embedding_attempts" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingAttempts: %w", err)
+	}
+	return oldValue.EmbeddingAttempts, nil
+}
+
+// AddEmbeddingAttempts adds i to the "embedding_attempts" field.
+func (m *MessageMutation) AddEmbeddingAttempts(i int) {
+	if m.addembedding_attempts != nil {
+		*m.addembedding_attempts += i
+	} else {
+		m.addembedding_attempts = &i
+	}
+}
+
+// AddedEmbeddingAttempts returns the value that was added to the "embedding_attempts" field in this mutation.
+func (m *MessageMutation) AddedEmbeddingAttempts() (r int, exists bool) {
+	v := m.addembedding_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEmbeddingAttempts resets all changes to the "embedding_attempts" field.
+func (m *MessageMutation) ResetEmbeddingAttempts() {
+	m.embedding_attempts = nil
+	m.addembedding_attempts = nil
+}
+
+// SetEmbeddingError sets the "embedding_error" field.
+func (m *MessageMutation) SetEmbeddingError(s string) {
+	m.embedding_error = &s
+}
+
+// EmbeddingError returns the value of the "embedding_error" field in the mutation.
+func (m *MessageMutation) EmbeddingError() (r string, exists bool) {
+	v := m.embedding_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingError returns the old "embedding_error" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingError: %w", err)
+	}
+	return oldValue.EmbeddingError, nil
+}
+
+// ClearEmbeddingError clears the value of the "embedding_error" field.
+func (m *MessageMutation) ClearEmbeddingError() {
+	m.embedding_error = nil
+	m.clearedFields[message.FieldEmbeddingError] = struct{}{}
+}
+
+// EmbeddingErrorCleared returns if the "embedding_error" field was cleared in this mutation.
+func (m *MessageMutation) EmbeddingErrorCleared() bool {
+	_, ok := m.clearedFields[message.FieldEmbeddingError]
+	return ok
+}
+
+// ResetEmbeddingError resets all changes to the "embedding_error" field.
+func (m *MessageMutation) ResetEmbeddingError() {
+	m.embedding_error = nil
+	delete(m.clearedFields, message.FieldEmbeddingError)
+}
+
+// SetEmbeddingRetryAt sets the "embedding_retry_at" field.
+func (m *MessageMutation) SetEmbeddingRetryAt(t time.Time) {
+	m.embedding_retry_at = &t
+}
+
+// EmbeddingRetryAt returns the value of the "embedding_retry_at" field in the mutation.
+func (m *MessageMutation) EmbeddingRetryAt() (r time.Time, exists bool) {
+	v := m.embedding_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingRetryAt returns the old "embedding_retry_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingRetryAt: %w", err)
+	}
+	return oldValue.EmbeddingRetryAt, nil
+}
+
+// ClearEmbeddingRetryAt clears the value of the "embedding_retry_at" field.
+func (m *MessageMutation) ClearEmbeddingRetryAt() {
+	m.embedding_retry_at = nil
+	m.clearedFields[message.FieldEmbeddingRetryAt] = struct{}{}
+}
+
+// EmbeddingRetryAtCleared returns if the "embedding_retry_at" field was cleared in this mutation.
+func (m *MessageMutation) EmbeddingRetryAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEmbeddingRetryAt]
+	return ok
+}
+
+// ResetEmbeddingRetryAt resets all changes to the "embedding_retry_at" field.
+func (m *MessageMutation) ResetEmbeddingRetryAt() {
+	m.embedding_retry_at = nil
+	delete(m.clearedFields, message.FieldEmbeddingRetryAt)
+}
+
+// SetEmbeddingFailedAt sets the "embedding_failed_at" field.
+func (m *MessageMutation) SetEmbeddingFailedAt(t time.Time) {
+	m.embedding_failed_at = &t
+}
+
+// EmbeddingFailedAt returns the value of the "embedding_failed_at" field in the mutation.
+func (m *MessageMutation) EmbeddingFailedAt() (r time.Time, exists bool) {
+	v := m.embedding_failed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingFailedAt returns the old "embedding_failed_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingFailedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingFailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingFailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingFailedAt: %w", err)
+	}
+	return oldValue.EmbeddingFailedAt, nil
+}
+
+// ClearEmbeddingFailedAt clears the value of the "embedding_failed_at" field.
+func (m *MessageMutation) ClearEmbeddingFailedAt() {
+	m.embedding_failed_at = nil
+	m.clearedFields[message.FieldEmbeddingFailedAt] = struct{}{}
+}
+
+// EmbeddingFailedAtCleared returns if the "embedding_failed_at" field was cleared in this mutation.
+func (m *MessageMutation) EmbeddingFailedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEmbeddingFailedAt]
+	return ok
+}
+
+// ResetEmbeddingFailedAt resets all changes to the "embedding_failed_at" field.
+func (m *MessageMutation) ResetEmbeddingFailedAt() {
+	m.embedding_failed_at = nil
+	delete(m.clearedFields, message.FieldEmbeddingFailedAt)
+}
+
+// SetEmbeddingSkipped sets the "embedding_skipped" field.
+func (m *MessageMutation) SetEmbeddingSkipped(b bool) {
+	m.embedding_skipped = &b
+}
+
+// EmbeddingSkipped returns the value of the "embedding_skipped" field in the mutation.
+func (m *MessageMutation) EmbeddingSkipped() (r bool, exists bool) {
+	v := m.embedding_skipped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingSkipped returns the old "embedding_skipped" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEmbeddingSkipped(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingSkipped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingSkipped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingSkipped: %w", err)
+	}
+	return oldValue.EmbeddingSkipped, nil
+}
+
+// ResetEmbeddingSkipped resets all changes to the "embedding_skipped" field.
+func (m *MessageMutation) ResetEmbeddingSkipped() {
+	m.embedding_skipped = nil
+}
+
+// SetHasMedia sets the "has_media" field.
+func (m *MessageMutation) SetHasMedia(b bool) {
+	m.has_media = &b
+}
+
+// HasMedia returns the value of the "has_media" field in the mutation.
+func (m *MessageMutation) HasMedia() (r bool, exists bool) {
+	v := m.has_media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasMedia returns the old "has_media" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldHasMedia(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasMedia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasMedia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasMedia: %w", err)
+	}
+	return oldValue.HasMedia, nil
+}
+
+// ResetHasMedia resets all changes to the "has_media" field.
+func (m *MessageMutation) ResetHasMedia() {
+	m.has_media = nil
+}
+
+// SetMediaInfo sets the "media_info" field.
+func (m *MessageMutation) SetMediaInfo(ti *types.MediaInfo) {
+	m.media_info = &ti
+}
+
+// MediaInfo returns the value of the "media_info" field in the mutation.
+func (m *MessageMutation) MediaInfo() (r *types.MediaInfo, exists bool) {
+	v := m.media_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaInfo returns the old "media_info" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMediaInfo(ctx context.Context) (v *types.MediaInfo, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaInfo: %w", err)
+	}
+	return oldValue.MediaInfo, nil
+}
+
+// ResetMediaInfo resets all changes to the "media_info" field.
+func (m *MessageMutation) ResetMediaInfo() {
+	m.media_info = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *MessageMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *MessageMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *MessageMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// ClearDialog clears the "dialog" edge to the Dialog entity.
+func (m *MessageMutation) ClearDialog() {
+	m.cleareddialog = true
+	m.clearedFields[message.FieldDialogID] = struct{}{}
+}
+
+// DialogCleared reports if the "dialog" edge to the Dialog entity was cleared.
+func (m *MessageMutation) DialogCleared() bool {
+	return m.cleareddialog
+}
+
+// DialogIDs returns the "dialog" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DialogID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) DialogIDs() (ids []int64) {
+	if id := m.dialog; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDialog resets all changes to the "dialog" edge.
+func (m *MessageMutation) ResetDialog() {
+	m.dialog = nil
+	m.cleareddialog = false
+}
+
+// ClearSender clears the "sender" edge to the Sender entity.
+func (m *MessageMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[message.FieldSenderID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the Sender entity was cleared.
+func (m *MessageMutation) SenderCleared() bool {
+	return m.SenderIDCleared() || m.clearedsender
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SenderIDs() (ids []int64) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by ids.
+func (m *MessageMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MessageRevision entity was cleared.
+func (m *MessageMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MessageRevision entity by IDs.
+func (m *MessageMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MessageMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MessageMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddChunkIDs adds the "chunks" edge to the MessageChunk entity by ids.
+func (m *MessageMutation) AddChunkIDs(ids ...uuid.UUID) {
+	if m.chunks == nil {
+		m.chunks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.chunks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChunks clears the "chunks" edge to the MessageChunk entity.
+func (m *MessageMutation) ClearChunks() {
+	m.clearedchunks = true
+}
+
+// ChunksCleared reports if the "chunks" edge to the MessageChunk entity was cleared.
+func (m *MessageMutation) ChunksCleared() bool {
+	return m.clearedchunks
+}
+
+// RemoveChunkIDs removes the "chunks" edge to the MessageChunk entity by IDs.
+func (m *MessageMutation) RemoveChunkIDs(ids ...uuid.UUID) {
+	if m.removedchunks == nil {
+		m.removedchunks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.chunks, ids[i])
+		m.removedchunks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChunks returns the removed IDs of the "chunks" edge to the MessageChunk entity.
+func (m *MessageMutation) RemovedChunksIDs() (ids []uuid.UUID) {
+	for id := range m.removedchunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChunksIDs returns the "chunks" edge IDs in the mutation.
+func (m *MessageMutation) ChunksIDs() (ids []uuid.UUID) {
+	for id := range m.chunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChunks resets all changes to the "chunks" edge.
+func (m *MessageMutation) ResetChunks() {
+	m.chunks = nil
+	m.clearedchunks = false
+	m.removedchunks = nil
+}
+
+// AddNextEmbeddingIDs adds the "next_embeddings" edge to the NextEmbedding entity by ids.
+func (m *MessageMutation) AddNextEmbeddingIDs(ids ...uuid.UUID) {
+	if m.next_embeddings == nil {
+		m.next_embeddings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.next_embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNextEmbeddings clears the "next_embeddings" edge to the NextEmbedding entity.
+func (m *MessageMutation) ClearNextEmbeddings() {
+	m.clearednext_embeddings = true
+}
+
+// NextEmbeddingsCleared reports if the "next_embeddings" edge to the NextEmbedding entity was cleared.
+func (m *MessageMutation) NextEmbeddingsCleared() bool {
+	return m.clearednext_embeddings
+}
+
+// RemoveNextEmbeddingIDs removes the "next_embeddings" edge to the NextEmbedding entity by IDs.
+func (m *MessageMutation) RemoveNextEmbeddingIDs(ids ...uuid.UUID) {
+	if m.removednext_embeddings == nil {
+		m.removednext_embeddings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.next_embeddings, ids[i])
+		m.removednext_embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNextEmbeddings returns the removed IDs of the "next_embeddings" edge to the NextEmbedding entity.
+func (m *MessageMutation) RemovedNextEmbeddingsIDs() (ids []uuid.UUID) {
+	for id := range m.removednext_embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NextEmbeddingsIDs returns the "next_embeddings" edge IDs in the mutation.
+func (m *MessageMutation) NextEmbeddingsIDs() (ids []uuid.UUID) {
+	for id := range m.next_embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNextEmbeddings resets all changes to the "next_embeddings" edge.
+func (m *MessageMutation) ResetNextEmbeddings() {
+	m.next_embeddings = nil
+	m.clearednext_embeddings = false
+	m.removednext_embeddings = nil
+}
+
+// AddNextEmbeddingFailureIDs adds the "next_embedding_failures" edge to the NextEmbeddingFailure entity by ids.
+func (m *MessageMutation) AddNextEmbeddingFailureIDs(ids ...uuid.UUID) {
+	if m.next_embedding_failures == nil {
+		m.next_embedding_failures = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.next_embedding_failures[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNextEmbeddingFailures clears the "next_embedding_failures" edge to the NextEmbeddingFailure entity.
+func (m *MessageMutation) ClearNextEmbeddingFailures() {
+	m.clearednext_embedding_failures = true
+}
+
+// NextEmbeddingFailuresCleared reports if the "next_embedding_failures" edge to the NextEmbeddingFailure entity was cleared.
+func (m *MessageMutation) NextEmbeddingFailuresCleared() bool {
+	return m.clearednext_embedding_failures
+}
+
+// RemoveNextEmbeddingFailureIDs removes the "next_embedding_failures" edge to the NextEmbeddingFailure entity by IDs.
+func (m *MessageMutation) RemoveNextEmbeddingFailureIDs(ids ...uuid.UUID) {
+	if m.removednext_embedding_failures == nil {
+		m.removednext_embedding_failures = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.next_embedding_failures, ids[i])
+		m.removednext_embedding_failures[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNextEmbeddingFailures returns the removed IDs of the "next_embedding_failures" edge to the NextEmbeddingFailure entity.
+func (m *MessageMutation) RemovedNextEmbeddingFailuresIDs() (ids []uuid.UUID) {
+	for id := range m.removednext_embedding_failures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NextEmbeddingFailuresIDs returns the "next_embedding_failures" edge IDs in the mutation.
+func (m *MessageMutation) NextEmbeddingFailuresIDs() (ids []uuid.UUID) {
+	for id := range m.next_embedding_failures {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNextEmbeddingFailures resets all changes to the "next_embedding_failures" edge.
+func (m *MessageMutation) ResetNextEmbeddingFailures() {
+	m.next_embedding_failures = nil
+	m.clearednext_embedding_failures = false
+	m.removednext_embedding_failures = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 27)
+	if m.msg_id != nil {
+		fields = append(fields, message.FieldMsgID)
+	}
+	if m.dialog != nil {
+		fields = append(fields, message.FieldDialogID)
+	}
+	if m.sender != nil {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.reply_to_msg_id != nil {
+		fields = append(fields, message.FieldReplyToMsgID)
+	}
+	if m.top_msg_id != nil {
+		fields = append(fields, message.FieldTopMsgID)
+	}
+	if m.topic_id != nil {
+		fields = append(fields, message.FieldTopicID)
+	}
+	if m.fwd_from_id != nil {
+		fields = append(fields, message.FieldFwdFromID)
+	}
+	if m.fwd_from_name != nil {
+		fields = append(fields, message.FieldFwdFromName)
+	}
+	if m.fwd_from_msg_id != nil {
+		fields = append(fields, message.FieldFwdFromMsgID)
+	}
+	if m.fwd_from_date != nil {
+		fields = append(fields, message.FieldFwdFromDate)
+	}
+	if m.fwd_post_author != nil {
+		fields = append(fields, message.FieldFwdPostAuthor)
+	}
+	if m.text != nil {
+		fields = append(fields, message.FieldText)
+	}
+	if m.derived_text != nil {
+		fields = append(fields, message.FieldDerivedText)
+	}
+	if m.text_embedding != nil {
+		fields = append(fields, message.FieldTextEmbedding)
+	}
+	if m.embedding_strategy != nil {
+		fields = append(fields, message.FieldEmbeddingStrategy)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, message.FieldEmbeddingModel)
+	}
+	if m.next_embedding_model != nil {
+		fields = append(fields, message.FieldNextEmbeddingModel)
+	}
+	if m.embedding_attempts != nil {
+		fields = append(fields, message.FieldEmbeddingAttempts)
+	}
+	if m.embedding_error != nil {
+		fields = append(fields, message.FieldEmbeddingError)
+	}
+	if m.embedding_retry_at != nil {
+		fields = append(fields, message.FieldEmbeddingRetryAt)
+	}
+	if m.embedding_failed_at != nil {
+		fields = append(fields, message.FieldEmbeddingFailedAt)
+	}
+	if m.embedding_skipped != nil {
+		fields = append(fields, message.FieldEmbeddingSkipped)
+	}
+	if m.has_media != nil {
+		fields = append(fields, message.FieldHasMedia)
+	}
+	if m.media_info != nil {
+		fields = append(fields, message.FieldMediaInfo)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, message.FieldSentAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldMsgID:
+		return m.MsgID()
+	case message.FieldDialogID:
+		return m.DialogID()
+	case message.FieldSenderID:
+		return m.SenderID()
+	case message.FieldReplyToMsgID:
+		return m.ReplyToMsgID()
+	case message.FieldTopMsgID:
+		return m.TopMsgID()
+	case message.FieldTopicID:
+		return m.TopicID()
+	case message.FieldFwdFromID:
+		return m.FwdFromID()
+	case message.FieldFwdFromName:
+		return m.FwdFromName()
+	case message.FieldFwdFromMsgID:
+		return m.FwdFromMsgID()
+	case message.FieldFwdFromDate:
+		return m.FwdFromDate()
+	case message.FieldFwdPostAuthor:
+		return m.FwdPostAuthor()
+	case message.FieldText:
+		return m.Text()
+	case message.FieldDerivedText:
+		return m.DerivedText()
+	case message.FieldTextEmbedding:
+		return m.TextEmbedding()
+	case message.FieldEmbeddingStrategy:
+		return m.EmbeddingStrategy()
+	case message.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	case message.FieldNextEmbeddingModel:
+		return m.NextEmbeddingModel()
+	case message.FieldEmbeddingAttempts:
+		return m.EmbeddingAttempts()
+	case message.FieldEmbeddingError:
+		return m.EmbeddingError()
+	case message.FieldEmbeddingRetryAt:
+		return m.EmbeddingRetryAt()
+	case message.FieldEmbeddingFailedAt:
+		return m.EmbeddingFailedAt()
+	case message.FieldEmbeddingSkipped:
+		return m.EmbeddingSkipped()
+	case message.FieldHasMedia:
+		return m.HasMedia()
+	case message.FieldMediaInfo:
+		return m.MediaInfo()
+	case message.FieldSentAt:
+		return m.SentAt()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldMsgID:
+		return m.OldMsgID(ctx)
+	case message.FieldDialogID:
+		return m.OldDialogID(ctx)
+	case message.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case message.FieldReplyToMsgID:
+		return m.OldReplyToMsgID(ctx)
+	case message.FieldTopMsgID:
+		return m.OldTopMsgID(ctx)
+	case message.FieldTopicID:
+		return m.OldTopicID(ctx)
+	case message.FieldFwdFromID:
+		return m.OldFwdFromID(ctx)
+	case message.FieldFwdFromName:
+		return m.OldFwdFromName(ctx)
+	case message.FieldFwdFromMsgID:
+		return m.OldFwdFromMsgID(ctx)
+	case message.FieldFwdFromDate:
+		return m.OldFwdFromDate(ctx)
+	case message.FieldFwdPostAuthor:
+		return m.OldFwdPostAuthor(ctx)
+	case message.FieldText:
+		return m.OldText(ctx)
+	case message.FieldDerivedText:
+		return m.OldDerivedText(ctx)
+	case message.FieldTextEmbedding:
+		return m.OldTextEmbedding(ctx)
+	case message.FieldEmbeddingStrategy:
+		return m.OldEmbeddingStrategy(ctx)
+	case message.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	case message.FieldNextEmbeddingModel:
+		return m.OldNextEmbeddingModel(ctx)
+	case message.FieldEmbeddingAttempts:
+		return m.OldEmbeddingAttempts(ctx)
+	case message.FieldEmbeddingError:
+		return m.OldEmbeddingError(ctx)
+	case message.FieldEmbeddingRetryAt:
+		return m.OldEmbeddingRetryAt(ctx)
+	case message.FieldEmbeddingFailedAt:
+		return m.OldEmbeddingFailedAt(ctx)
+	case message.FieldEmbeddingSkipped:
+		return m.OldEmbeddingSkipped(ctx)
+	case message.FieldHasMedia:
+		return m.OldHasMedia(ctx)
+	case message.FieldMediaInfo:
+		return m.OldMediaInfo(ctx)
+	case message.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMsgID(v)
+		return nil
+	case message.FieldDialogID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDialogID(v)
+		return nil
+	case message.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case message.FieldReplyToMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToMsgID(v)
+		return nil
+	case message.FieldTopMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopMsgID(v)
+		return nil
+	case message.FieldTopicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopicID(v)
+		return nil
+	case message.FieldFwdFromID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFwdFromID(v)
+		return nil
+	case message.FieldFwdFromName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFwdFromName(v)
+		return nil
+	case message.FieldFwdFromMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFwdFromMsgID(v)
+		return nil
+	case message.FieldFwdFromDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFwdFromDate(v)
+		return nil
+	case message.FieldFwdPostAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFwdPostAuthor(v)
+		return nil
+	case message.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case message.FieldDerivedText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDerivedText(v)
+		return nil
+	case message.FieldTextEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextEmbedding(v)
+		return nil
+	case message.FieldEmbeddingStrategy:
+		v, ok := value.(types.EmbeddingStrategy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingStrategy(v)
+		return nil
+	case message.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	case message.FieldNextEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextEmbeddingModel(v)
+		return nil
+	case message.FieldEmbeddingAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingAttempts(v)
+		return nil
+	case message.FieldEmbeddingError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingError(v)
+		return nil
+	case message.FieldEmbeddingRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingRetryAt(v)
+		return nil
+	case message.FieldEmbeddingFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingFailedAt(v)
+		return nil
+	case message.FieldEmbeddingSkipped:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingSkipped(v)
+		return nil
+	case message.FieldHasMedia:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasMedia(v)
+		return nil
+	case message.FieldMediaInfo:
+		v, ok := value.(*types.MediaInfo)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaInfo(v)
+		return nil
+	case message.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addmsg_id != nil {
+		fields = append(fields, message.FieldMsgID)
+	}
+	if m.addreply_to_msg_id != nil {
+		fields = append(fields, message.FieldReplyToMsgID)
+	}
+	if m.addtop_msg_id != nil {
+		fields = append(fields, message.FieldTopMsgID)
+	}
+	if m.addtopic_id != nil {
+		fields = append(fields, message.FieldTopicID)
+	}
+	if m.addfwd_from_id != nil {
+		fields = append(fields, message.FieldFwdFromID)
+	}
+	if m.addfwd_from_msg_id != nil {
+		fields = append(fields, message.FieldFwdFromMsgID)
+	}
+	if m.addembedding_attempts != nil {
+		fields = append(fields, message.FieldEmbeddingAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldMsgID:
+		return m.AddedMsgID()
+	case message.FieldReplyToMsgID:
+		return m.AddedReplyToMsgID()
+	case message.FieldTopMsgID:
+		return m.AddedTopMsgID()
+	case message.FieldTopicID:
+		return m.AddedTopicID()
+	case message.FieldFwdFromID:
+		return m.AddedFwdFromID()
+	case message.FieldFwdFromMsgID:
+		return m.AddedFwdFromMsgID()
+	case message.FieldEmbeddingAttempts:
+		return m.AddedEmbeddingAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMsgID(v)
+		return nil
+	case message.FieldReplyToMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplyToMsgID(v)
+		return nil
+	case message.FieldTopMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopMsgID(v)
+		return nil
+	case message.FieldTopicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopicID(v)
+		return nil
+	case message.FieldFwdFromID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFwdFromID(v)
+		return nil
+	case message.FieldFwdFromMsgID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFwdFromMsgID(v)
+		return nil
+	case message.FieldEmbeddingAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEmbeddingAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldSenderID) {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.FieldCleared(message.FieldReplyToMsgID) {
+		fields = append(fields, message.FieldReplyToMsgID)
+	}
+	if m.FieldCleared(message.FieldTopMsgID) {
+		fields = append(fields, message.FieldTopMsgID)
+	}
+	if m.FieldCleared(message.FieldTopicID) {
+		fields = append(fields, message.FieldTopicID)
+	}
+	if m.FieldCleared(message.FieldFwdFromID) {
+		fields = append(fields, message.FieldFwdFromID)
+	}
+	if m.FieldCleared(message.FieldFwdFromName) {
+		fields = append(fields, message.FieldFwdFromName)
+	}
+	if m.FieldCleared(message.FieldFwdFromMsgID) {
+		fields = append(fields, message.FieldFwdFromMsgID)
+	}
+	if m.FieldCleared(message.FieldFwdFromDate) {
+		fields = append(fields, message.FieldFwdFromDate)
+	}
+	if m.FieldCleared(message.FieldFwdPostAuthor) {
+		fields = append(fields, message.FieldFwdPostAuthor)
+	}
+	if m.FieldCleared(message.FieldTextEmbedding) {
+		fields = append(fields, message.FieldTextEmbedding)
+	}
+	if m.FieldCleared(message.FieldEmbeddingStrategy) {
+		fields = append(fields, message.FieldEmbeddingStrategy)
+	}
+	if m.FieldCleared(message.FieldEmbeddingModel) {
+		fields = append(fields, message.FieldEmbeddingModel)
+	}
+	if m.FieldCleared(message.FieldNextEmbeddingModel) {
+		fields = append(fields, message.FieldNextEmbeddingModel)
+	}
+	if m.FieldCleared(message.FieldEmbeddingError) {
+		fields = append(fields, message.FieldEmbeddingError)
+	}
+	if m.FieldCleared(message.FieldEmbeddingRetryAt) {
+		fields = append(fields, message.FieldEmbeddingRetryAt)
+	}
+	if m.FieldCleared(message.FieldEmbeddingFailedAt) {
+		fields = append(fields, message.FieldEmbeddingFailedAt)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldSenderID:
+		m.ClearSenderID()
+		return nil
+	case message.FieldReplyToMsgID:
+		m.ClearReplyToMsgID()
+		return nil
+	case message.FieldTopMsgID:
+		m.ClearTopMsgID()
+		return nil
+	case message.FieldTopicID:
+		m.ClearTopicID()
+		return nil
+	case message.FieldFwdFromID:
+		m.ClearFwdFromID()
+		return nil
+	case message.FieldFwdFromName:
+		m.ClearFwdFromName()
+		return nil
+	case message.FieldFwdFromMsgID:
+		m.ClearFwdFromMsgID()
+		return nil
+	case message.FieldFwdFromDate:
+		m.ClearFwdFromDate()
+		return nil
+	case message.FieldFwdPostAuthor:
+		m.ClearFwdPostAuthor()
+		return nil
+	case message.FieldTextEmbedding:
+		m.ClearTextEmbedding()
+		return nil
+	case message.FieldEmbeddingStrategy:
+		m.ClearEmbeddingStrategy()
+		return nil
+	case message.FieldEmbeddingModel:
+		m.ClearEmbeddingModel()
+		return nil
+	case message.FieldNextEmbeddingModel:
+		m.ClearNextEmbeddingModel()
+		return nil
+	case message.FieldEmbeddingError:
+		m.ClearEmbeddingError()
+		return nil
+	case message.FieldEmbeddingRetryAt:
+		m.ClearEmbeddingRetryAt()
+		return nil
+	case message.FieldEmbeddingFailedAt:
+		m.ClearEmbeddingFailedAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldMsgID:
+		m.ResetMsgID()
+		return nil
+	case message.FieldDialogID:
+		m.ResetDialogID()
+		return nil
+	case message.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case message.FieldReplyToMsgID:
+		m.ResetReplyToMsgID()
+		return nil
+	case message.FieldTopMsgID:
+		m.ResetTopMsgID()
+		return nil
+	case message.FieldTopicID:
+		m.ResetTopicID()
+		return nil
+	case message.FieldFwdFromID:
+		m.ResetFwdFromID()
+		return nil
+	case message.FieldFwdFromName:
+		m.ResetFwdFromName()
+		return nil
+	case message.FieldFwdFromMsgID:
+		m.ResetFwdFromMsgID()
+		return nil
+	case message.FieldFwdFromDate:
+		m.ResetFwdFromDate()
+		return nil
+	case message.FieldFwdPostAuthor:
+		m.ResetFwdPostAuthor()
+		return nil
+	case message.FieldText:
+		m.ResetText()
+		return nil
+	case message.FieldDerivedText:
+		m.ResetDerivedText()
+		return nil
+	case message.FieldTextEmbedding:
+		m.ResetTextEmbedding()
+		return nil
+	case message.FieldEmbeddingStrategy:
+		m.ResetEmbeddingStrategy()
+		return nil
+	case message.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	case message.FieldNextEmbeddingModel:
+		m.ResetNextEmbeddingModel()
+		return nil
+	case message.FieldEmbeddingAttempts:
+		m.ResetEmbeddingAttempts()
+		return nil
+	case message.FieldEmbeddingError:
+		m.ResetEmbeddingError()
+		return nil
+	case message.FieldEmbeddingRetryAt:
+		m.ResetEmbeddingRetryAt()
+		return nil
+	case message.FieldEmbeddingFailedAt:
+		m.ResetEmbeddingFailedAt()
+		return nil
+	case message.FieldEmbeddingSkipped:
+		m.ResetEmbeddingSkipped()
+		return nil
+	case message.FieldHasMedia:
+		m.ResetHasMedia()
+		return nil
+	case message.FieldMediaInfo:
+		m.ResetMediaInfo()
+		return nil
+	case message.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.dialog != nil {
+		edges = append(edges, message.EdgeDialog)
+	}
+	if m.sender != nil {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.revisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.chunks != nil {
+		edges = append(edges, message.EdgeChunks)
+	}
+	if m.next_embeddings != nil {
+		edges = append(edges, message.EdgeNextEmbeddings)
+	}
+	if m.next_embedding_failures != nil {
+		edges = append(edges, message.EdgeNextEmbeddingFailures)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeDialog:
+		if id := m.dialog; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.chunks))
+		for id := range m.chunks {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeNextEmbeddings:
+		ids := make([]ent.Value, 0, len(m.next_embeddings))
+		for id := range m.next_embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeNextEmbeddingFailures:
+		ids := make([]ent.Value, 0, len(m.next_embedding_failures))
+		for id := range m.next_embedding_failures {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedrevisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.removedchunks != nil {
+		edges = append(edges, message.EdgeChunks)
+	}
+	if m.removednext_embeddings != nil {
+		edges = append(edges, message.EdgeNextEmbeddings)
+	}
+	if m.removednext_embedding_failures != nil {
+		edges = append(edges, message.EdgeNextEmbeddingFailures)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.removedchunks))
+		for id := range m.removedchunks {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeNextEmbeddings:
+		ids := make([]ent.Value, 0, len(m.removednext_embeddings))
+		for id := range m.removednext_embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeNextEmbeddingFailures:
+		ids := make([]ent.Value, 0, len(m.removednext_embedding_failures))
+		for id := range m.removednext_embedding_failures {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.cleareddialog {
+		edges = append(edges, message.EdgeDialog)
+	}
+	if m.clearedsender {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.clearedchunks {
+		edges = append(edges, message.EdgeChunks)
+	}
+	if m.clearednext_embeddings {
+		edges = append(edges, message.EdgeNextEmbeddings)
+	}
+	if m.clearednext_embedding_failures {
+		edges = append(edges, message.EdgeNextEmbeddingFailures)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeDialog:
+		return m.cleareddialog
+	case message.EdgeSender:
+		return m.clearedsender
+	case message.EdgeRevisions:
+		return m.clearedrevisions
+	case message.EdgeChunks:
+		return m.clearedchunks
+	case message.EdgeNextEmbeddings:
+		return m.clearednext_embeddings
+	case message.EdgeNextEmbeddingFailures:
+		return m.clearednext_embedding_failures
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeDialog:
+		m.ClearDialog()
+		return nil
+	case message.EdgeSender:
+		m.ClearSender()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeDialog:
+		m.ResetDialog()
+		return nil
+	case message.EdgeSender:
+		m.ResetSender()
+		return nil
+	case message.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case message.EdgeChunks:
+		m.ResetChunks()
+		return nil
+	case message.EdgeNextEmbeddings:
+		m.ResetNextEmbeddings()
+		return nil
+	case message.EdgeNextEmbeddingFailures:
+		m.ResetNextEmbeddingFailures()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageChunkMutation represents an operation that mutates the MessageChunk nodes in the graph.
+type MessageChunkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	index          *int
+	addindex       *int
+	text           *string
+	text_embedding *pgvector.Vector
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageChunk, error)
+	predicates     []predicate.MessageChunk
+}
+
+var _ ent.Mutation = (*MessageChunkMutation)(nil)
+
+// messagechunkOption allows management of the mutation configuration using functional options.
+type messagechunkOption func(*MessageChunkMutation)
+
+// newMessageChunkMutation creates new mutation for the MessageChunk entity.
+func newMessageChunkMutation(c config, op Op, opts ...messagechunkOption) *MessageChunkMutation {
+	m := &MessageChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageChunkID sets the ID field of the mutation.
+func withMessageChunkID(id uuid.UUID) messagechunkOption {
+	return func(m *MessageChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageChunk
+		)
+		m.oldValue = func(ctx context.Context) (*MessageChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageChunk sets the old MessageChunk of the mutation.
+func withMessageChunk(node *MessageChunk) messagechunkOption {
+	return func(m *MessageChunkMutation) {
+		m.oldValue = func(context.Context) (*MessageChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageChunk entities.
+func (m *MessageChunkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageChunkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageChunkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageChunkMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageChunkMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageChunk entity.
+// If the MessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageChunkMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageChunkMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetIndex sets the "index" field.
+func (m *MessageChunkMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *MessageChunkMutation) Index() (r int, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the MessageChunk entity.
+// If the MessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageChunkMutation) OldIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *MessageChunkMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *MessageChunkMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *MessageChunkMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetText sets the "text" field.
+func (m *MessageChunkMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *MessageChunkMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the MessageChunk entity.
+// If the MessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageChunkMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *MessageChunkMutation) ResetText() {
+	m.text = nil
+}
+
+// SetTextEmbedding sets the "text_embedding" field.
+func (m *MessageChunkMutation) SetTextEmbedding(pg pgvector.Vector) {
+	m.text_embedding = &pg
+}
+
+// TextEmbedding returns the value of the "text_embedding" field in the mutation.
+func (m *MessageChunkMutation) TextEmbedding() (r pgvector.Vector, exists bool) {
+	v := m.text_embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextEmbedding returns the old "text_embedding" field's value of the MessageChunk entity.
+// If the MessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageChunkMutation) OldTextEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextEmbedding: %w", err)
+	}
+	return oldValue.TextEmbedding, nil
+}
+
+// ResetTextEmbedding resets all changes to the "text_embedding" field.
+func (m *MessageChunkMutation) ResetTextEmbedding() {
+	m.text_embedding = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageChunkMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagechunk.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageChunkMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageChunkMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageChunkMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageChunkMutation builder.
+func (m *MessageChunkMutation) Where(ps ...predicate.MessageChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageChunk).
+func (m *MessageChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageChunkMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagechunk.FieldMessageID)
+	}
+	if m.index != nil {
+		fields = append(fields, messagechunk.FieldIndex)
+	}
+	if m.text != nil {
+		fields = append(fields, messagechunk.FieldText)
+	}
+	if m.text_embedding != nil {
+		fields = append(fields, messagechunk.FieldTextEmbedding)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagechunk.FieldMessageID:
+		return m.MessageID()
+	case messagechunk.FieldIndex:
+		return m.Index()
+	case messagechunk.FieldText:
+		return m.Text()
+	case messagechunk.FieldTextEmbedding:
+		return m.TextEmbedding()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagechunk.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagechunk.FieldIndex:
+		return m.OldIndex(ctx)
+	case messagechunk.FieldText:
+		return m.OldText(ctx)
+	case messagechunk.FieldTextEmbedding:
+		return m.OldTextEmbedding(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagechunk.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagechunk.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case messagechunk.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case messagechunk.FieldTextEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextEmbedding(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageChunkMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, messagechunk.FieldIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageChunkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagechunk.FieldIndex:
+		return m.AddedIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagechunk.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageChunkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageChunkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageChunkMutation) ResetField(name string) error {
+	switch name {
+	case messagechunk.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagechunk.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case messagechunk.FieldText:
+		m.ResetText()
+		return nil
+	case messagechunk.FieldTextEmbedding:
+		m.ResetTextEmbedding()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagechunk.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageChunkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagechunk.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageChunkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagechunk.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageChunkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagechunk.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageChunkMutation) ClearEdge(name string) error {
+	switch name {
+	case messagechunk.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageChunkMutation) ResetEdge(name string) error {
+	switch name {
+	case messagechunk.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageChunk edge %s", name)
+}
+
+// MessageRevisionMutation represents an operation that mutates the MessageRevision nodes in the graph.
+type MessageRevisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	text           *string
+	edited_at      *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageRevision, error)
+	predicates     []predicate.MessageRevision
+}
+
+var _ ent.Mutation = (*MessageRevisionMutation)(nil)
+
+// messagerevisionOption allows management of the mutation configuration using functional options.
+type messagerevisionOption func(*MessageRevisionMutation)
+
+// newMessageRevisionMutation creates new mutation for the MessageRevision entity.
+func newMessageRevisionMutation(c config, op Op, opts ...messagerevisionOption) *MessageRevisionMutation {
+	m := &MessageRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageRevisionID sets the ID field of the mutation.
+func withMessageRevisionID(id uuid.UUID) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MessageRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageRevision sets the old MessageRevision of the mutation.
+func withMessageRevision(node *MessageRevision) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		m.oldValue = func(context.Context) (*MessageRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageRevision entities.
+func (m *MessageRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageRevisionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageRevisionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageRevisionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetText sets the "text" field.
+func (m *MessageRevisionMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *MessageRevisionMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *MessageRevisionMutation) ResetText() {
+	m.text = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageRevisionMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageRevisionMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageRevisionMutation) ResetEditedAt() {
+	m.edited_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageRevisionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagerevision.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageRevisionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageRevisionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageRevisionMutation builder.
+func (m *MessageRevisionMutation) Where(ps ...predicate.MessageRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageRevision).
+func (m *MessageRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, messagerevision.FieldMessageID)
+	}
+	if m.text != nil {
+		fields = append(fields, messagerevision.FieldText)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, messagerevision.FieldEditedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagerevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagerevision.FieldMessageID:
+		return m.MessageID()
+	case messagerevision.FieldText:
+		return m.Text()
+	case messagerevision.FieldEditedAt:
+		return m.EditedAt()
+	case messagerevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagerevision.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagerevision.FieldText:
+		return m.OldText(ctx)
+	case messagerevision.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case messagerevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagerevision.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagerevision.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case messagerevision.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case messagerevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ResetField(name string) error {
+	switch name {
+	case messagerevision.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagerevision.FieldText:
+		m.ResetText()
+		return nil
+	case messagerevision.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case messagerevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagerevision.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision edge %s", name)
+}
+
+// NextEmbeddingMutation represents an operation that mutates the NextEmbedding nodes in the graph.
+type NextEmbeddingMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	index          *int
+	addindex       *int
+	text           *string
+	embedding      *pgvector.Vector
+	strategy       *types.EmbeddingStrategy
+	model          *string
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*NextEmbedding, error)
+	predicates     []predicate.NextEmbedding
+}
+
+var _ ent.Mutation = (*NextEmbeddingMutation)(nil)
+
+// nextembeddingOption allows management of the mutation configuration using functional options.
+type nextembeddingOption func(*NextEmbeddingMutation)
+
+// newNextEmbeddingMutation creates new mutation for the NextEmbedding entity.
+func newNextEmbeddingMutation(c config, op Op, opts ...nextembeddingOption) *NextEmbeddingMutation {
+	m := &NextEmbeddingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNextEmbedding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNextEmbeddingID sets the ID field of the mutation.
+func withNextEmbeddingID(id uuid.UUID) nextembeddingOption {
+	return func(m *NextEmbeddingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NextEmbedding
+		)
+		m.oldValue = func(ctx context.Context) (*NextEmbedding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NextEmbedding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNextEmbedding sets the old NextEmbedding of the mutation.
+func withNextEmbedding(node *NextEmbedding) nextembeddingOption {
+	return func(m *NextEmbeddingMutation) {
+		m.oldValue = func(context.Context) (*NextEmbedding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NextEmbeddingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NextEmbeddingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NextEmbedding entities.
+func (m *NextEmbeddingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NextEmbeddingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NextEmbeddingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NextEmbedding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *NextEmbeddingMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *NextEmbeddingMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *NextEmbeddingMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetIndex sets the "index" field.
+func (m *NextEmbeddingMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *NextEmbeddingMutation) Index() (r int, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *NextEmbeddingMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *NextEmbeddingMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *NextEmbeddingMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetText sets the "text" field.
+func (m *NextEmbeddingMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *NextEmbeddingMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *NextEmbeddingMutation) ResetText() {
+	m.text = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *NextEmbeddingMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *NextEmbeddingMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *NextEmbeddingMutation) ResetEmbedding() {
+	m.embedding = nil
+}
+
+// SetStrategy sets the "strategy" field.
+func (m *NextEmbeddingMutation) SetStrategy(ts types.EmbeddingStrategy) {
+	m.strategy = &ts
+}
+
+// Strategy returns the value of the "strategy" field in the mutation.
+func (m *NextEmbeddingMutation) Strategy() (r types.EmbeddingStrategy, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategy returns the old "strategy" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldStrategy(ctx context.Context) (v types.EmbeddingStrategy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategy: %w", err)
+	}
+	return oldValue.Strategy, nil
+}
+
+// ResetStrategy resets all changes to the "strategy" field.
+func (m *NextEmbeddingMutation) ResetStrategy() {
+	m.strategy = nil
+}
+
+// SetModel sets the "model" field.
+func (m *NextEmbeddingMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *NextEmbeddingMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the NextEmbedding entity.
+// If the NextEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *NextEmbeddingMutation) ResetModel() {
+	m.model = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *NextEmbeddingMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[nextembedding.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *NextEmbeddingMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *NextEmbeddingMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *NextEmbeddingMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the NextEmbeddingMutation builder.
+func (m *NextEmbeddingMutation) Where(ps ...predicate.NextEmbedding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NextEmbeddingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NextEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NextEmbedding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NextEmbeddingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NextEmbeddingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NextEmbedding).
+func (m *NextEmbeddingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NextEmbeddingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.message != nil {
+		fields = append(fields, nextembedding.FieldMessageID)
+	}
+	if m.index != nil {
+		fields = append(fields, nextembedding.FieldIndex)
+	}
+	if m.text != nil {
+		fields = append(fields, nextembedding.FieldText)
+	}
+	if m.embedding != nil {
+		fields = append(fields, nextembedding.FieldEmbedding)
+	}
+	if m.strategy != nil {
+		fields = append(fields, nextembedding.FieldStrategy)
+	}
+	if m.model != nil {
+		fields = append(fields, nextembedding.FieldModel)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NextEmbeddingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nextembedding.FieldMessageID:
+		return m.MessageID()
+	case nextembedding.FieldIndex:
+		return m.Index()
+	case nextembedding.FieldText:
+		return m.Text()
+	case nextembedding.FieldEmbedding:
+		return m.Embedding()
+	case nextembedding.FieldStrategy:
+		return m.Strategy()
+	case nextembedding.FieldModel:
+		return m.Model()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NextEmbeddingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nextembedding.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case nextembedding.FieldIndex:
+		return m.OldIndex(ctx)
+	case nextembedding.FieldText:
+		return m.OldText(ctx)
+	case nextembedding.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case nextembedding.FieldStrategy:
+		return m.OldStrategy(ctx)
+	case nextembedding.FieldModel:
+		return m.OldModel(ctx)
+	}
+	return nil, fmt.Errorf("unknown NextEmbedding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NextEmbeddingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nextembedding.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case nextembedding.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case nextembedding.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case nextembedding.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case nextembedding.FieldStrategy:
+		v, ok := value.(types.EmbeddingStrategy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategy(v)
+		return nil
+	case nextembedding.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbedding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NextEmbeddingMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, nextembedding.FieldIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NextEmbeddingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nextembedding.FieldIndex:
+		return m.AddedIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NextEmbeddingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nextembedding.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbedding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NextEmbeddingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NextEmbeddingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NextEmbeddingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NextEmbedding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NextEmbeddingMutation) ResetField(name string) error {
+	switch name {
+	case nextembedding.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case nextembedding.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case nextembedding.FieldText:
+		m.ResetText()
+		return nil
+	case nextembedding.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case nextembedding.FieldStrategy:
+		m.ResetStrategy()
+		return nil
+	case nextembedding.FieldModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbedding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NextEmbeddingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, nextembedding.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NextEmbeddingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nextembedding.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NextEmbeddingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NextEmbeddingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NextEmbeddingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, nextembedding.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NextEmbeddingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nextembedding.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NextEmbeddingMutation) ClearEdge(name string) error {
+	switch name {
+	case nextembedding.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbedding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NextEmbeddingMutation) ResetEdge(name string) error {
+	switch name {
+	case nextembedding.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbedding edge %s", name)
+}
+
+// NextEmbeddingFailureMutation represents an operation that mutates the NextEmbeddingFailure nodes in the graph.
+type NextEmbeddingFailureMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	model          *string
+	attempts       *int
+	addattempts    *int
+	error          *string
+	retry_at       *time.Time
+	failed_at      *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*NextEmbeddingFailure, error)
+	predicates     []predicate.NextEmbeddingFailure
+}
+
+var _ ent.Mutation = (*NextEmbeddingFailureMutation)(nil)
+
+// nextembeddingfailureOption allows management of the mutation configuration using functional options.
+type nextembeddingfailureOption func(*NextEmbeddingFailureMutation)
+
+// newNextEmbeddingFailureMutation creates new mutation for the NextEmbeddingFailure entity.
+func newNextEmbeddingFailureMutation(c config, op Op, opts ...nextembeddingfailureOption) *NextEmbeddingFailureMutation {
+	m := &NextEmbeddingFailureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNextEmbeddingFailure,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNextEmbeddingFailureID sets the ID field of the mutation.
+func withNextEmbeddingFailureID(id uuid.UUID) nextembeddingfailureOption {
+	return func(m *NextEmbeddingFailureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NextEmbeddingFailure
+		)
+		m.oldValue = func(ctx context.Context) (*NextEmbeddingFailure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NextEmbeddingFailure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNextEmbeddingFailure sets the old NextEmbeddingFailure of the mutation.
+func withNextEmbeddingFailure(node *NextEmbeddingFailure) nextembeddingfailureOption {
+	return func(m *NextEmbeddingFailureMutation) {
+		m.oldValue = func(context.Context) (*NextEmbeddingFailure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NextEmbeddingFailureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NextEmbeddingFailureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NextEmbeddingFailure entities.
+func (m *NextEmbeddingFailureMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NextEmbeddingFailureMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NextEmbeddingFailureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NextEmbeddingFailure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *NextEmbeddingFailureMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *NextEmbeddingFailureMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *NextEmbeddingFailureMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetModel sets the "model" field.
+func (m *NextEmbeddingFailureMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *NextEmbeddingFailureMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *NextEmbeddingFailureMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *NextEmbeddingFailureMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *NextEmbeddingFailureMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *NextEmbeddingFailureMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *NextEmbeddingFailureMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *NextEmbeddingFailureMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetError sets the "error" field.
+func (m *NextEmbeddingFailureMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *NextEmbeddingFailureMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *NextEmbeddingFailureMutation) ResetError() {
+	m.error = nil
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (m *NextEmbeddingFailureMutation) SetRetryAt(t time.Time) {
+	m.retry_at = &t
+}
+
+// RetryAt returns the value of the "retry_at" field in the mutation.
+func (m *NextEmbeddingFailureMutation) RetryAt() (r time.Time, exists bool) {
+	v := m.retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryAt returns the old "retry_at" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryAt: %w", err)
+	}
+	return oldValue.RetryAt, nil
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (m *NextEmbeddingFailureMutation) ClearRetryAt() {
+	m.retry_at = nil
+	m.clearedFields[nextembeddingfailure.FieldRetryAt] = struct{}{}
+}
+
+// RetryAtCleared returns if the "retry_at" field was cleared in this mutation.
+func (m *NextEmbeddingFailureMutation) RetryAtCleared() bool {
+	_, ok := m.clearedFields[nextembeddingfailure.FieldRetryAt]
+	return ok
+}
+
+// ResetRetryAt resets all changes to the "retry_at" field.
+func (m *NextEmbeddingFailureMutation) ResetRetryAt() {
+	m.retry_at = nil
+	delete(m.clearedFields, nextembeddingfailure.FieldRetryAt)
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (m *NextEmbeddingFailureMutation) SetFailedAt(t time.Time) {
+	m.failed_at = &t
+}
+
+// FailedAt returns the value of the "failed_at" field in the mutation.
+func (m *NextEmbeddingFailureMutation) FailedAt() (r time.Time, exists bool) {
+	v := m.failed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedAt returns the old "failed_at" field's value of the NextEmbeddingFailure entity.
+// If the NextEmbeddingFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NextEmbeddingFailureMutation) OldFailedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedAt: %w", err)
+	}
+	return oldValue.FailedAt, nil
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (m *NextEmbeddingFailureMutation) ClearFailedAt() {
+	m.failed_at = nil
+	m.clearedFields[nextembeddingfailure.FieldFailedAt] = struct{}{}
+}
+
+// FailedAtCleared returns if the "failed_at" field was cleared in this mutation.
+func (m *NextEmbeddingFailureMutation) FailedAtCleared() bool {
+	_, ok := m.clearedFields[nextembeddingfailure.FieldFailedAt]
+	return ok
+}
+
+// ResetFailedAt resets all changes to the "failed_at" field.
+func (m *NextEmbeddingFailureMutation) ResetFailedAt() {
+	m.failed_at = nil
+	delete(m.clearedFields, nextembeddingfailure.FieldFailedAt)
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *NextEmbeddingFailureMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[nextembeddingfailure.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *NextEmbeddingFailureMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *NextEmbeddingFailureMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *NextEmbeddingFailureMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the NextEmbeddingFailureMutation builder.
+func (m *NextEmbeddingFailureMutation) Where(ps ...predicate.NextEmbeddingFailure) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NextEmbeddingFailureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NextEmbeddingFailureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NextEmbeddingFailure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NextEmbeddingFailureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NextEmbeddingFailureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NextEmbeddingFailure).
+func (m *NextEmbeddingFailureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NextEmbeddingFailureMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.message != nil {
+		fields = append(fields, nextembeddingfailure.FieldMessageID)
+	}
+	if m.model != nil {
+		fields = append(fields, nextembeddingfailure.FieldModel)
+	}
+	if m.attempts != nil {
+		fields = append(fields, nextembeddingfailure.FieldAttempts)
+	}
+	if m.error != nil {
+		fields = append(fields, nextembeddingfailure.FieldError)
+	}
+	if m.retry_at != nil {
+		fields = append(fields, nextembeddingfailure.FieldRetryAt)
+	}
+	if m.failed_at != nil {
+		fields = append(fields, nextembeddingfailure.FieldFailedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NextEmbeddingFailureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nextembeddingfailure.FieldMessageID:
+		return m.MessageID()
+	case nextembeddingfailure.FieldModel:
+		return m.Model()
+	case nextembeddingfailure.FieldAttempts:
+		return m.Attempts()
+	case nextembeddingfailure.FieldError:
+		return m.Error()
+	case nextembeddingfailure.FieldRetryAt:
+		return m.RetryAt()
+	case nextembeddingfailure.FieldFailedAt:
+		return m.FailedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NextEmbeddingFailureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nextembeddingfailure.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case nextembeddingfailure.FieldModel:
+		return m.OldModel(ctx)
+	case nextembeddingfailure.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case nextembeddingfailure.FieldError:
+		return m.OldError(ctx)
+	case nextembeddingfailure.FieldRetryAt:
+		return m.OldRetryAt(ctx)
+	case nextembeddingfailure.FieldFailedAt:
+		return m.OldFailedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NextEmbeddingFailure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NextEmbeddingFailureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nextembeddingfailure.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case nextembeddingfailure.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case nextembeddingfailure.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case nextembeddingfailure.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case nextembeddingfailure.FieldRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryAt(v)
+		return nil
+	case nextembeddingfailure.FieldFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NextEmbeddingFailureMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, nextembeddingfailure.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NextEmbeddingFailureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nextembeddingfailure.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NextEmbeddingFailureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nextembeddingfailure.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NextEmbeddingFailureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nextembeddingfailure.FieldRetryAt) {
+		fields = append(fields, nextembeddingfailure.FieldRetryAt)
+	}
+	if m.FieldCleared(nextembeddingfailure.FieldFailedAt) {
+		fields = append(fields, nextembeddingfailure.FieldFailedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NextEmbeddingFailureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NextEmbeddingFailureMutation) ClearField(name string) error {
+	switch name {
+	case nextembeddingfailure.FieldRetryAt:
+		m.ClearRetryAt()
+		return nil
+	case nextembeddingfailure.FieldFailedAt:
+		m.ClearFailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NextEmbeddingFailureMutation) ResetField(name string) error {
+	switch name {
+	case nextembeddingfailure.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case nextembeddingfailure.FieldModel:
+		m.ResetModel()
+		return nil
+	case nextembeddingfailure.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case nextembeddingfailure.FieldError:
+		m.ResetError()
+		return nil
+	case nextembeddingfailure.FieldRetryAt:
+		m.ResetRetryAt()
+		return nil
+	case nextembeddingfailure.FieldFailedAt:
+		m.ResetFailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NextEmbeddingFailureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, nextembeddingfailure.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NextEmbeddingFailureMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nextembeddingfailure.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NextEmbeddingFailureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NextEmbeddingFailureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NextEmbeddingFailureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, nextembeddingfailure.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NextEmbeddingFailureMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nextembeddingfailure.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NextEmbeddingFailureMutation) ClearEdge(name string) error {
+	switch name {
+	case nextembeddingfailure.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NextEmbeddingFailureMutation) ResetEdge(name string) error {
+	switch name {
+	case nextembeddingfailure.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown NextEmbeddingFailure edge %s", name)
+}
+
+// ObservationStateMutation represents an operation that mutates the ObservationState nodes in the graph.
+type ObservationStateMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	paused            *bool
+	embedding_enabled *bool
+	retention         *time.Duration
+	addretention      *time.Duration
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*ObservationState, error)
+	predicates        []predicate.ObservationState
+}
+
+var _ ent.Mutation = (*ObservationStateMutation)(nil)
+
+// observationstateOption allows management of the mutation configuration using functional options.
+type observationstateOption func(*ObservationStateMutation)
+
+// newObservationStateMutation creates new mutation for the ObservationState entity.
+func newObservationStateMutation(c config, op Op, opts ...observationstateOption) *ObservationStateMutation {
+	m := &ObservationStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeObservationState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withObservationStateID sets the ID field of the mutation.
+func withObservationStateID(id int64) observationstateOption {
+	return func(m *ObservationStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ObservationState
+		)
+		m.oldValue = func(ctx context.Context) (*ObservationState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ObservationState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withObservationState sets the old ObservationState of the mutation.
+func withObservationState(node *ObservationState) observationstateOption {
+	return func(m *ObservationStateMutation) {
+		m.oldValue = func(context.Context) (*ObservationState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObservationStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObservationStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ObservationState entities.
+func (m *ObservationStateMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObservationStateMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObservationStateMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ObservationState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPaused sets the "paused" field.
+func (m *ObservationStateMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *ObservationStateMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaused returns the old "paused" field's value of the ObservationState entity.
+// If the ObservationState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObservationStateMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
+}
+
+// ResetPaused resets all changes to the "paused" field.
+func (m *ObservationStateMutation) ResetPaused() {
+	m.paused = nil
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (m *ObservationStateMutation) SetEmbeddingEnabled(b bool) {
+	m.embedding_enabled = &b
+}
+
+// EmbeddingEnabled returns the value of the "embedding_enabled" field in the mutation.
+func (m *ObservationStateMutation) EmbeddingEnabled() (r bool, exists bool) {
+	v := m.embedding_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingEnabled returns the old "embedding_enabled" field's value of the ObservationState entity.
+// If the ObservationState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObservationStateMutation) OldEmbeddingEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingEnabled: %w", err)
+	}
+	return oldValue.EmbeddingEnabled, nil
+}
+
+// ResetEmbeddingEnabled resets all changes to the "embedding_enabled" field.
+func (m *ObservationStateMutation) ResetEmbeddingEnabled() {
+	m.embedding_enabled = nil
+}
+
+// SetRetention sets the "retention" field.
+func (m *ObservationStateMutation) SetRetention(t time.Duration) {
+	m.retention = &t
+	m.addretention = nil
+}
+
+// Retention returns the value of the "retention" field in the mutation.
+func (m *ObservationStateMutation) Retention() (r time.Duration, exists bool) {
+	v := m.retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetention returns the old "retention" field's value of the ObservationState entity.
+// If the ObservationState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObservationStateMutation) OldRetention(ctx context.Context) (v *time.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetention is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetention requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetention: %w", err)
+	}
+	return oldValue.Retention, nil
+}
+
+// AddRetention adds t to the "retention" field.
+func (m *ObservationStateMutation) AddRetention(t time.Duration) {
+	if m.addretention != nil {
+		*m.addretention += t
+	} else {
+		m.addretention = &t
+	}
+}
+
+// AddedRetention returns the value that was added to the "retention" field in this mutation.
+func (m *ObservationStateMutation) AddedRetention() (r time.Duration, exists bool) {
+	v := m.addretention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (m *ObservationStateMutation) ClearRetention() {
+	m.retention = nil
+	m.addretention = nil
+	m.clearedFields[observationstate.FieldRetention] = struct{}{}
+}
+
+// RetentionCleared returns if the "retention" field was cleared in this mutation.
+func (m *ObservationStateMutation) RetentionCleared() bool {
+	_, ok := m.clearedFields[observationstate.FieldRetention]
+	return ok
+}
+
+// ResetRetention resets all changes to the "retention" field.
+func (m *ObservationStateMutation) ResetRetention() {
+	m.retention = nil
+	m.addretention = nil
+	delete(m.clearedFields, observationstate.FieldRetention)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ObservationStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ObservationStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ObservationState entity.
+// If the ObservationState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ObservationStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ObservationStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ObservationStateMutation builder.
+func (m *ObservationStateMutation) Where(ps ...predicate.ObservationState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ObservationStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ObservationStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ObservationState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ObservationStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ObservationStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ObservationState).
+func (m *ObservationStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ObservationStateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.paused != nil {
+		fields = append(fields, observationstate.FieldPaused)
+	}
+	if m.embedding_enabled != nil {
+		fields = append(fields, observationstate.FieldEmbeddingEnabled)
+	}
+	if m.retention != nil {
+		fields = append(fields, observationstate.FieldRetention)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, observationstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ObservationStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case observationstate.FieldPaused:
+		return m.Paused()
+	case observationstate.FieldEmbeddingEnabled:
+		return m.EmbeddingEnabled()
+	case observationstate.FieldRetention:
+		return m.Retention()
+	case observationstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ObservationStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case observationstate.FieldPaused:
+		return m.OldPaused(ctx)
+	case observationstate.FieldEmbeddingEnabled:
+		return m.OldEmbeddingEnabled(ctx)
+	case observationstate.FieldRetention:
+		return m.OldRetention(ctx)
+	case observationstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ObservationState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObservationStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case observationstate.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case observationstate.FieldEmbeddingEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingEnabled(v)
+		return nil
+	case observationstate.FieldRetention:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetention(v)
+		return nil
+	case observationstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObservationState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObservationStateMutation) AddedFields() []string {
+	var fields []string
+	if m.addretention != nil {
+		fields = append(fields, observationstate.FieldRetention)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObservationStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case observationstate.FieldRetention:
+		return m.AddedRetention()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObservationStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case observationstate.FieldRetention:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetention(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ObservationState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObservationStateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(observationstate.FieldRetention) {
+		fields = append(fields, observationstate.FieldRetention)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObservationStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObservationStateMutation) ClearField(name string) error {
+	switch name {
+	case observationstate.FieldRetention:
+		m.ClearRetention()
+		return nil
+	}
+	return fmt.Errorf("unknown ObservationState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObservationStateMutation) ResetField(name string) error {
+	switch name {
+	case observationstate.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case observationstate.FieldEmbeddingEnabled:
+		m.ResetEmbeddingEnabled()
+		return nil
+	case observationstate.FieldRetention:
+		m.ResetRetention()
+		return nil
+	case observationstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ObservationState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObservationStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObservationStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObservationStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObservationStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObservationStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObservationStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObservationStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ObservationState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObservationStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ObservationState edge %s", name)
+}
+
+// SenderMutation represents an operation that mutates the Sender nodes in the graph.
+type SenderMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	name            *string
+	_type           *types.DialogType
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	messages        map[uuid.UUID]struct{}
+	removedmessages map[uuid.UUID]struct{}
+	clearedmessages bool
+	done            bool
+	oldValue        func(context.Context) (*Sender, error)
+	predicates      []predicate.Sender
+}
+
+var _ ent.Mutation = (*SenderMutation)(nil)
+
+// senderOption allows management of the mutation configuration using functional options.
+type senderOption func(*SenderMutation)
+
+// newSenderMutation creates new mutation for the Sender entity.
+func newSenderMutation(c config, op Op, opts ...senderOption) *SenderMutation {
+	m := &SenderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSender,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSenderID sets the ID field of the mutation.
+func withSenderID(id int64) senderOption {
+	return func(m *SenderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Sender
+		)
+		m.oldValue = func(ctx context.Context) (*Sender, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Sender.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSender sets the old Sender of the mutation.
+func withSender(node *Sender) senderOption {
+	return func(m *SenderMutation) {
+		m.oldValue = func(context.Context) (*Sender, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SenderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SenderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Sender entities.
+func (m *SenderMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SenderMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SenderMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Sender.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SenderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SenderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SenderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *SenderMutation) SetType(tt types.DialogType) {
+	m._type = &tt
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SenderMutation) GetType() (r types.DialogType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldType(ctx context.Context) (v types.DialogType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SenderMutation) ResetType() {
+	m._type = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SenderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SenderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SenderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *SenderMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *SenderMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *SenderMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *SenderMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *SenderMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *SenderMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *SenderMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// Where appends a list predicates to the SenderMutation builder.
+func (m *SenderMutation) Where(ps ...predicate.Sender) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SenderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SenderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sender, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SenderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SenderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Sender).
+func (m *SenderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SenderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, sender.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, sender.FieldType)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sender.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SenderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sender.FieldName:
+		return m.Name()
+	case sender.FieldType:
+		return m.GetType()
+	case sender.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SenderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sender.FieldName:
+		return m.OldName(ctx)
+	case sender.FieldType:
+		return m.OldType(ctx)
+	case sender.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Sender field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sender.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sender.FieldType:
+		v, ok := value.(types.DialogType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case sender.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sender field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SenderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SenderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Sender numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SenderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SenderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SenderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Sender nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SenderMutation) ResetField(name string) error {
+	switch name {
+	case sender.FieldName:
+		m.ResetName()
+		return nil
+	case sender.FieldType:
+		m.ResetType()
+		return nil
+	case sender.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Sender field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SenderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.messages != nil {
+		edges = append(edges, sender.EdgeMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SenderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sender.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SenderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmessages != nil {
+		edges = append(edges, sender.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SenderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sender.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SenderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessages {
+		edges = append(edges, sender.EdgeMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SenderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sender.EdgeMessages:
+		return m.clearedmessages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SenderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Sender unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SenderMutation) ResetEdge(name string) error {
+	switch name {
+	case sender.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Sender edge %s", name)
+}
+
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	value         *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
+}
+
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id string) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Setting entities.
+func (m *SettingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// SentAt returns the value of the "sent_at" field in the mutation.
-func (m *MessageMutation) SentAt() (r time.Time, exists bool) {
-	v := m.sent_at
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentAt returns the old "sent_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentAt requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.SentAt, nil
+	return oldValue.Value, nil
 }
 
-// ResetSentAt resets all changes to the "sent_at" field.
-func (m *MessageMutation) ResetSentAt() {
-	m.sent_at = nil
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
 }
 
-// ClearDialog clears the "dialog" edge to the Dialog entity.
-func (m *MessageMutation) ClearDialog() {
-	m.cleareddialog = true
-	m.clearedFields[message.FieldDialogID] = struct{}{}
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DialogCleared reports if the "dialog" edge to the Dialog entity was cleared.
-func (m *MessageMutation) DialogCleared() bool {
-	return m.cleareddialog
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// DialogIDs returns the "dialog" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DialogID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) DialogIDs() (ids []int64) {
-	if id := m.dialog; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetDialog resets all changes to the "dialog" edge.
-func (m *MessageMutation) ResetDialog() {
-	m.dialog = nil
-	m.cleareddialog = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1018,45 +8700,30 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *SettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *SettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.msg_id != nil {
-		fields = append(fields, message.FieldMsgID)
-	}
-	if m.dialog != nil {
-		fields = append(fields, message.FieldDialogID)
-	}
-	if m.text != nil {
-		fields = append(fields, message.FieldText)
-	}
-	if m.text_embedding != nil {
-		fields = append(fields, message.FieldTextEmbedding)
-	}
-	if m.has_media != nil {
-		fields = append(fields, message.FieldHasMedia)
-	}
-	if m.media_info != nil {
-		fields = append(fields, message.FieldMediaInfo)
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
 	}
-	if m.sent_at != nil {
-		fields = append(fields, message.FieldSentAt)
+	if m.updated_at != nil {
+		fields = append(fields, setting.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1064,22 +8731,12 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldMsgID:
-		return m.MsgID()
-	case message.FieldDialogID:
-		return m.DialogID()
-	case message.FieldText:
-		return m.Text()
-	case message.FieldTextEmbedding:
-		return m.TextEmbedding()
-	case message.FieldHasMedia:
-		return m.HasMedia()
-	case message.FieldMediaInfo:
-		return m.MediaInfo()
-	case message.FieldSentAt:
-		return m.SentAt()
+	case setting.FieldValue:
+		return m.Value()
+	case setting.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1087,248 +8744,138 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldMsgID:
-		return m.OldMsgID(ctx)
-	case message.FieldDialogID:
-		return m.OldDialogID(ctx)
-	case message.FieldText:
-		return m.OldText(ctx)
-	case message.FieldTextEmbedding:
-		return m.OldTextEmbedding(ctx)
-	case message.FieldHasMedia:
-		return m.OldHasMedia(ctx)
-	case message.FieldMediaInfo:
-		return m.OldMediaInfo(ctx)
-	case message.FieldSentAt:
-		return m.OldSentAt(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	case setting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Setting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldMsgID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsgID(v)
-		return nil
-	case message.FieldDialogID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDialogID(v)
-		return nil
-	case message.FieldText:
+	case setting.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
-		return nil
-	case message.FieldTextEmbedding:
-		v, ok := value.(pgvector.Vector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTextEmbedding(v)
-		return nil
-	case message.FieldHasMedia:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHasMedia(v)
-		return nil
-	case message.FieldMediaInfo:
-		v, ok := value.(*types.MediaInfo)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMediaInfo(v)
+		m.SetValue(v)
 		return nil
-	case message.FieldSentAt:
+	case setting.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	var fields []string
-	if m.addmsg_id != nil {
-		fields = append(fields, message.FieldMsgID)
-	}
-	return fields
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case message.FieldMsgID:
-		return m.AddedMsgID()
-	}
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldMsgID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMsgID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(message.FieldTextEmbedding) {
-		fields = append(fields, message.FieldTextEmbedding)
-	}
-	return fields
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *SettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
-	switch name {
-	case message.FieldTextEmbedding:
-		m.ClearTextEmbedding()
-		return nil
-	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *SettingMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldMsgID:
-		m.ResetMsgID()
-		return nil
-	case message.FieldDialogID:
-		m.ResetDialogID()
-		return nil
-	case message.FieldText:
-		m.ResetText()
-		return nil
-	case message.FieldTextEmbedding:
-		m.ResetTextEmbedding()
-		return nil
-	case message.FieldHasMedia:
-		m.ResetHasMedia()
-		return nil
-	case message.FieldMediaInfo:
-		m.ResetMediaInfo()
+	case setting.FieldValue:
+		m.ResetValue()
 		return nil
-	case message.FieldSentAt:
-		m.ResetSentAt()
+	case setting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.dialog != nil {
-		edges = append(edges, message.EdgeDialog)
-	}
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeDialog:
-		if id := m.dialog; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddialog {
-		edges = append(edges, message.EdgeDialog)
-	}
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case message.EdgeDialog:
-		return m.cleareddialog
-	}
+func (m *SettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	switch name {
-	case message.EdgeDialog:
-		m.ClearDialog()
-		return nil
-	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	switch name {
-	case message.EdgeDialog:
-		m.ResetDialog()
-		return nil
-	}
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// NextEmbedding is the model entity for the NextEmbedding schema.
+type NextEmbedding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// Index holds the value of the "index" field.
+	Index int `json:"index,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// Strategy holds the value of the "strategy" field.
+	Strategy types.EmbeddingStrategy `json:"strategy,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NextEmbeddingQuery when eager-loading is set.
+	Edges        NextEmbeddingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NextEmbeddingEdges holds the relations/edges for other nodes in the graph.
+type NextEmbeddingEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NextEmbeddingEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NextEmbedding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nextembedding.FieldEmbedding:
+			values[i] = new(pgvector.Vector)
+		case nextembedding.FieldIndex:
+			values[i] = new(sql.NullInt64)
+		case nextembedding.FieldText, nextembedding.FieldStrategy, nextembedding.FieldModel:
+			values[i] = new(sql.NullString)
+		case nextembedding.FieldID, nextembedding.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NextEmbedding fields.
+func (ne *NextEmbedding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nextembedding.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ne.ID = *value
+			}
+		case nextembedding.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				ne.MessageID = *value
+			}
+		case nextembedding.FieldIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field index", values[i])
+			} else if value.Valid {
+				ne.Index = int(value.Int64)
+			}
+		case nextembedding.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				ne.Text = value.String
+			}
+		case nextembedding.FieldEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil {
+				ne.Embedding = *value
+			}
+		case nextembedding.FieldStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy", values[i])
+			} else if value.Valid {
+				ne.Strategy = types.EmbeddingStrategy(value.String)
+			}
+		case nextembedding.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				ne.Model = value.String
+			}
+		default:
+			ne.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NextEmbedding.
+// This includes values selected through modifiers, order, etc.
+func (ne *NextEmbedding) Value(name string) (ent.Value, error) {
+	return ne.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the NextEmbedding entity.
+func (ne *NextEmbedding) QueryMessage() *MessageQuery {
+	return NewNextEmbeddingClient(ne.config).QueryMessage(ne)
+}
+
+// Update returns a builder for updating this NextEmbedding.
+// Note that you need to call NextEmbedding.Unwrap() before calling this method if this NextEmbedding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ne *NextEmbedding) Update() *NextEmbeddingUpdateOne {
+	return NewNextEmbeddingClient(ne.config).UpdateOne(ne)
+}
+
+// Unwrap unwraps the NextEmbedding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ne *NextEmbedding) Unwrap() *NextEmbedding {
+	_tx, ok := ne.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NextEmbedding is not a transactional entity")
+	}
+	ne.config.driver = _tx.drv
+	return ne
+}
+
+// String implements the fmt.Stringer.
+func (ne *NextEmbedding) String() string {
+	var builder strings.Builder
+	builder.WriteString("NextEmbedding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ne.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", ne.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("index=")
+	builder.WriteString(fmt.Sprintf("%v", ne.Index))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(ne.Text)
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", ne.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("strategy=")
+	builder.WriteString(fmt.Sprintf("%v", ne.Strategy))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(ne.Model)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NextEmbeddings is a parsable slice of NextEmbedding.
+type NextEmbeddings []*NextEmbedding
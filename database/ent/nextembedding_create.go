@@ -0,0 +1,822 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// NextEmbeddingCreate is the builder for creating a NextEmbedding entity.
+type NextEmbeddingCreate struct {
+	config
+	mutation *NextEmbeddingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (nec *NextEmbeddingCreate) SetMessageID(u uuid.UUID) *NextEmbeddingCreate {
+	nec.mutation.SetMessageID(u)
+	return nec
+}
+
+// SetIndex sets the "index" field.
+func (nec *NextEmbeddingCreate) SetIndex(i int) *NextEmbeddingCreate {
+	nec.mutation.SetIndex(i)
+	return nec
+}
+
+// SetText sets the "text" field.
+func (nec *NextEmbeddingCreate) SetText(s string) *NextEmbeddingCreate {
+	nec.mutation.SetText(s)
+	return nec
+}
+
+// SetEmbedding sets the "embedding" field.
+func (nec *NextEmbeddingCreate) SetEmbedding(pg pgvector.Vector) *NextEmbeddingCreate {
+	nec.mutation.SetEmbedding(pg)
+	return nec
+}
+
+// SetStrategy sets the "strategy" field.
+func (nec *NextEmbeddingCreate) SetStrategy(ts types.EmbeddingStrategy) *NextEmbeddingCreate {
+	nec.mutation.SetStrategy(ts)
+	return nec
+}
+
+// SetModel sets the "model" field.
+func (nec *NextEmbeddingCreate) SetModel(s string) *NextEmbeddingCreate {
+	nec.mutation.SetModel(s)
+	return nec
+}
+
+// SetID sets the "id" field.
+func (nec *NextEmbeddingCreate) SetID(u uuid.UUID) *NextEmbeddingCreate {
+	nec.mutation.SetID(u)
+	return nec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nec *NextEmbeddingCreate) SetNillableID(u *uuid.UUID) *NextEmbeddingCreate {
+	if u != nil {
+		nec.SetID(*u)
+	}
+	return nec
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (nec *NextEmbeddingCreate) SetMessage(m *Message) *NextEmbeddingCreate {
+	return nec.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingMutation object of the builder.
+func (nec *NextEmbeddingCreate) Mutation() *NextEmbeddingMutation {
+	return nec.mutation
+}
+
+// Save creates the NextEmbedding in the database.
+func (nec *NextEmbeddingCreate) Save(ctx context.Context) (*NextEmbedding, error) {
+	nec.defaults()
+	return withHooks(ctx, nec.sqlSave, nec.mutation, nec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nec *NextEmbeddingCreate) SaveX(ctx context.Context) *NextEmbedding {
+	v, err := nec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nec *NextEmbeddingCreate) Exec(ctx context.Context) error {
+	_, err := nec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nec *NextEmbeddingCreate) ExecX(ctx context.Context) {
+	if err := nec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nec *NextEmbeddingCreate) defaults() {
+	if _, ok := nec.mutation.ID(); !ok {
+		v := nextembedding.DefaultID()
+		nec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nec *NextEmbeddingCreate) check() error {
+	if _, ok := nec.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "NextEmbedding.message_id"`)}
+	}
+	if _, ok := nec.mutation.Index(); !ok {
+		return &ValidationError{Name: "index", err: errors.New(`ent: missing required field "NextEmbedding.index"`)}
+	}
+	if _, ok := nec.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "NextEmbedding.text"`)}
+	}
+	if _, ok := nec.mutation.Embedding(); !ok {
+		return &ValidationError{Name: "embedding", err: errors.New(`ent: missing required field "NextEmbedding.embedding"`)}
+	}
+	if _, ok := nec.mutation.Strategy(); !ok {
+		return &ValidationError{Name: "strategy", err: errors.New(`ent: missing required field "NextEmbedding.strategy"`)}
+	}
+	if v, ok := nec.mutation.Strategy(); ok {
+		if err := nextembedding.StrategyValidator(v); err != nil {
+			return &ValidationError{Name: "strategy", err: fmt.Errorf(`ent: validator failed for field "NextEmbedding.strategy": %w`, err)}
+		}
+	}
+	if _, ok := nec.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "NextEmbedding.model"`)}
+	}
+	if len(nec.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "NextEmbedding.message"`)}
+	}
+	return nil
+}
+
+func (nec *NextEmbeddingCreate) sqlSave(ctx context.Context) (*NextEmbedding, error) {
+	if err := nec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	nec.mutation.id = &_node.ID
+	nec.mutation.done = true
+	return _node, nil
+}
+
+func (nec *NextEmbeddingCreate) createSpec() (*NextEmbedding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NextEmbedding{config: nec.config}
+		_spec = sqlgraph.NewCreateSpec(nextembedding.Table, sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = nec.conflict
+	if id, ok := nec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nec.mutation.Index(); ok {
+		_spec.SetField(nextembedding.FieldIndex, field.TypeInt, value)
+		_node.Index = value
+	}
+	if value, ok := nec.mutation.Text(); ok {
+		_spec.SetField(nextembedding.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := nec.mutation.Embedding(); ok {
+		_spec.SetField(nextembedding.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = value
+	}
+	if value, ok := nec.mutation.Strategy(); ok {
+		_spec.SetField(nextembedding.FieldStrategy, field.TypeEnum, value)
+		_node.Strategy = value
+	}
+	if value, ok := nec.mutation.Model(); ok {
+		_spec.SetField(nextembedding.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if nodes := nec.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembedding.MessageTable,
+			Columns: []string{nextembedding.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NextEmbedding.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NextEmbeddingUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (nec *NextEmbeddingCreate) OnConflict(opts ...sql.ConflictOption) *NextEmbeddingUpsertOne {
+	nec.conflict = opts
+	return &NextEmbeddingUpsertOne{
+		create: nec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nec *NextEmbeddingCreate) OnConflictColumns(columns ...string) *NextEmbeddingUpsertOne {
+	nec.conflict = append(nec.conflict, sql.ConflictColumns(columns...))
+	return &NextEmbeddingUpsertOne{
+		create: nec,
+	}
+}
+
+type (
+	// NextEmbeddingUpsertOne is the builder for "upsert"-ing
+	//  one NextEmbedding node.
+	NextEmbeddingUpsertOne struct {
+		create *NextEmbeddingCreate
+	}
+
+	// NextEmbeddingUpsert is the "OnConflict" setter.
+	NextEmbeddingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingUpsert) SetMessageID(v uuid.UUID) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateMessageID() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldMessageID)
+	return u
+}
+
+// SetIndex sets the "index" field.
+func (u *NextEmbeddingUpsert) SetIndex(v int) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldIndex, v)
+	return u
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateIndex() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldIndex)
+	return u
+}
+
+// AddIndex adds v to the "index" field.
+func (u *NextEmbeddingUpsert) AddIndex(v int) *NextEmbeddingUpsert {
+	u.Add(nextembedding.FieldIndex, v)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *NextEmbeddingUpsert) SetText(v string) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateText() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldText)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *NextEmbeddingUpsert) SetEmbedding(v pgvector.Vector) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateEmbedding() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldEmbedding)
+	return u
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *NextEmbeddingUpsert) SetStrategy(v types.EmbeddingStrategy) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldStrategy, v)
+	return u
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateStrategy() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldStrategy)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingUpsert) SetModel(v string) *NextEmbeddingUpsert {
+	u.Set(nextembedding.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingUpsert) UpdateModel() *NextEmbeddingUpsert {
+	u.SetExcluded(nextembedding.FieldModel)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(nextembedding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NextEmbeddingUpsertOne) UpdateNewValues() *NextEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(nextembedding.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NextEmbeddingUpsertOne) Ignore() *NextEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NextEmbeddingUpsertOne) DoNothing() *NextEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NextEmbeddingCreate.OnConflict
+// documentation for more info.
+func (u *NextEmbeddingUpsertOne) Update(set func(*NextEmbeddingUpsert)) *NextEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NextEmbeddingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingUpsertOne) SetMessageID(v uuid.UUID) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateMessageID() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *NextEmbeddingUpsertOne) SetIndex(v int) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *NextEmbeddingUpsertOne) AddIndex(v int) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateIndex() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *NextEmbeddingUpsertOne) SetText(v string) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateText() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *NextEmbeddingUpsertOne) SetEmbedding(v pgvector.Vector) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateEmbedding() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *NextEmbeddingUpsertOne) SetStrategy(v types.EmbeddingStrategy) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateStrategy() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingUpsertOne) SetModel(v string) *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertOne) UpdateModel() *NextEmbeddingUpsertOne {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *NextEmbeddingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NextEmbeddingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NextEmbeddingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NextEmbeddingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NextEmbeddingUpsertOne.ID is not supported by MySQL driver. Use NextEmbeddingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NextEmbeddingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NextEmbeddingCreateBulk is the builder for creating many NextEmbedding entities in bulk.
+type NextEmbeddingCreateBulk struct {
+	config
+	err      error
+	builders []*NextEmbeddingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NextEmbedding entities in the database.
+func (necb *NextEmbeddingCreateBulk) Save(ctx context.Context) ([]*NextEmbedding, error) {
+	if necb.err != nil {
+		return nil, necb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(necb.builders))
+	nodes := make([]*NextEmbedding, len(necb.builders))
+	mutators := make([]Mutator, len(necb.builders))
+	for i := range necb.builders {
+		func(i int, root context.Context) {
+			builder := necb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NextEmbeddingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, necb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = necb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, necb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, necb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (necb *NextEmbeddingCreateBulk) SaveX(ctx context.Context) []*NextEmbedding {
+	v, err := necb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (necb *NextEmbeddingCreateBulk) Exec(ctx context.Context) error {
+	_, err := necb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (necb *NextEmbeddingCreateBulk) ExecX(ctx context.Context) {
+	if err := necb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NextEmbedding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NextEmbeddingUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (necb *NextEmbeddingCreateBulk) OnConflict(opts ...sql.ConflictOption) *NextEmbeddingUpsertBulk {
+	necb.conflict = opts
+	return &NextEmbeddingUpsertBulk{
+		create: necb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (necb *NextEmbeddingCreateBulk) OnConflictColumns(columns ...string) *NextEmbeddingUpsertBulk {
+	necb.conflict = append(necb.conflict, sql.ConflictColumns(columns...))
+	return &NextEmbeddingUpsertBulk{
+		create: necb,
+	}
+}
+
+// NextEmbeddingUpsertBulk is the builder for "upsert"-ing
+// a bulk of NextEmbedding nodes.
+type NextEmbeddingUpsertBulk struct {
+	create *NextEmbeddingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(nextembedding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NextEmbeddingUpsertBulk) UpdateNewValues() *NextEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(nextembedding.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbedding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NextEmbeddingUpsertBulk) Ignore() *NextEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NextEmbeddingUpsertBulk) DoNothing() *NextEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NextEmbeddingCreateBulk.OnConflict
+// documentation for more info.
+func (u *NextEmbeddingUpsertBulk) Update(set func(*NextEmbeddingUpsert)) *NextEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NextEmbeddingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingUpsertBulk) SetMessageID(v uuid.UUID) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateMessageID() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *NextEmbeddingUpsertBulk) SetIndex(v int) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *NextEmbeddingUpsertBulk) AddIndex(v int) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateIndex() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *NextEmbeddingUpsertBulk) SetText(v string) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateText() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *NextEmbeddingUpsertBulk) SetEmbedding(v pgvector.Vector) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateEmbedding() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *NextEmbeddingUpsertBulk) SetStrategy(v types.EmbeddingStrategy) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateStrategy() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingUpsertBulk) SetModel(v string) *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingUpsertBulk) UpdateModel() *NextEmbeddingUpsertBulk {
+	return u.Update(func(s *NextEmbeddingUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// Exec executes the query.
+func (u *NextEmbeddingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NextEmbeddingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NextEmbeddingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NextEmbeddingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
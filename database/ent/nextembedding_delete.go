@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// NextEmbeddingDelete is the builder for deleting a NextEmbedding entity.
+type NextEmbeddingDelete struct {
+	config
+	hooks    []Hook
+	mutation *NextEmbeddingMutation
+}
+
+// Where appends a list predicates to the NextEmbeddingDelete builder.
+func (ned *NextEmbeddingDelete) Where(ps ...predicate.NextEmbedding) *NextEmbeddingDelete {
+	ned.mutation.Where(ps...)
+	return ned
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ned *NextEmbeddingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ned.sqlExec, ned.mutation, ned.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ned *NextEmbeddingDelete) ExecX(ctx context.Context) int {
+	n, err := ned.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ned *NextEmbeddingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(nextembedding.Table, sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID))
+	if ps := ned.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ned.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ned.mutation.done = true
+	return affected, err
+}
+
+// NextEmbeddingDeleteOne is the builder for deleting a single NextEmbedding entity.
+type NextEmbeddingDeleteOne struct {
+	ned *NextEmbeddingDelete
+}
+
+// Where appends a list predicates to the NextEmbeddingDelete builder.
+func (nedo *NextEmbeddingDeleteOne) Where(ps ...predicate.NextEmbedding) *NextEmbeddingDeleteOne {
+	nedo.ned.mutation.Where(ps...)
+	return nedo
+}
+
+// Exec executes the deletion query.
+func (nedo *NextEmbeddingDeleteOne) Exec(ctx context.Context) error {
+	n, err := nedo.ned.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{nextembedding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nedo *NextEmbeddingDeleteOne) ExecX(ctx context.Context) {
+	if err := nedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// NextEmbeddingQuery is the builder for querying NextEmbedding entities.
+type NextEmbeddingQuery struct {
+	config
+	ctx         *QueryContext
+	order       []nextembedding.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.NextEmbedding
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NextEmbeddingQuery builder.
+func (neq *NextEmbeddingQuery) Where(ps ...predicate.NextEmbedding) *NextEmbeddingQuery {
+	neq.predicates = append(neq.predicates, ps...)
+	return neq
+}
+
+// Limit the number of records to be returned by this query.
+func (neq *NextEmbeddingQuery) Limit(limit int) *NextEmbeddingQuery {
+	neq.ctx.Limit = &limit
+	return neq
+}
+
+// Offset to start from.
+func (neq *NextEmbeddingQuery) Offset(offset int) *NextEmbeddingQuery {
+	neq.ctx.Offset = &offset
+	return neq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (neq *NextEmbeddingQuery) Unique(unique bool) *NextEmbeddingQuery {
+	neq.ctx.Unique = &unique
+	return neq
+}
+
+// Order specifies how the records should be ordered.
+func (neq *NextEmbeddingQuery) Order(o ...nextembedding.OrderOption) *NextEmbeddingQuery {
+	neq.order = append(neq.order, o...)
+	return neq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (neq *NextEmbeddingQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: neq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := neq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := neq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nextembedding.Table, nextembedding.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nextembedding.MessageTable, nextembedding.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(neq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NextEmbedding entity from the query.
+// Returns a *NotFoundError when no NextEmbedding was found.
+func (neq *NextEmbeddingQuery) First(ctx context.Context) (*NextEmbedding, error) {
+	nodes, err := neq.Limit(1).All(setContextOp(ctx, neq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{nextembedding.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) FirstX(ctx context.Context) *NextEmbedding {
+	node, err := neq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NextEmbedding ID from the query.
+// Returns a *NotFoundError when no NextEmbedding ID was found.
+func (neq *NextEmbeddingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = neq.Limit(1).IDs(setContextOp(ctx, neq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{nextembedding.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := neq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NextEmbedding entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NextEmbedding entity is found.
+// Returns a *NotFoundError when no NextEmbedding entities are found.
+func (neq *NextEmbeddingQuery) Only(ctx context.Context) (*NextEmbedding, error) {
+	nodes, err := neq.Limit(2).All(setContextOp(ctx, neq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{nextembedding.Label}
+	default:
+		return nil, &NotSingularError{nextembedding.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) OnlyX(ctx context.Context) *NextEmbedding {
+	node, err := neq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NextEmbedding ID in the query.
+// Returns a *NotSingularError when more than one NextEmbedding ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (neq *NextEmbeddingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = neq.Limit(2).IDs(setContextOp(ctx, neq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{nextembedding.Label}
+	default:
+		err = &NotSingularError{nextembedding.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := neq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NextEmbeddings.
+func (neq *NextEmbeddingQuery) All(ctx context.Context) ([]*NextEmbedding, error) {
+	ctx = setContextOp(ctx, neq.ctx, ent.OpQueryAll)
+	if err := neq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NextEmbedding, *NextEmbeddingQuery]()
+	return withInterceptors[[]*NextEmbedding](ctx, neq, qr, neq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) AllX(ctx context.Context) []*NextEmbedding {
+	nodes, err := neq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NextEmbedding IDs.
+func (neq *NextEmbeddingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if neq.ctx.Unique == nil && neq.path != nil {
+		neq.Unique(true)
+	}
+	ctx = setContextOp(ctx, neq.ctx, ent.OpQueryIDs)
+	if err = neq.Select(nextembedding.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := neq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (neq *NextEmbeddingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, neq.ctx, ent.OpQueryCount)
+	if err := neq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, neq, querierCount[*NextEmbeddingQuery](), neq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) CountX(ctx context.Context) int {
+	count, err := neq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (neq *NextEmbeddingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, neq.ctx, ent.OpQueryExist)
+	switch _, err := neq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (neq *NextEmbeddingQuery) ExistX(ctx context.Context) bool {
+	exist, err := neq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NextEmbeddingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (neq *NextEmbeddingQuery) Clone() *NextEmbeddingQuery {
+	if neq == nil {
+		return nil
+	}
+	return &NextEmbeddingQuery{
+		config:      neq.config,
+		ctx:         neq.ctx.Clone(),
+		order:       append([]nextembedding.OrderOption{}, neq.order...),
+		inters:      append([]Interceptor{}, neq.inters...),
+		predicates:  append([]predicate.NextEmbedding{}, neq.predicates...),
+		withMessage: neq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:       neq.sql.Clone(),
+		path:      neq.path,
+		modifiers: append([]func(*sql.Selector){}, neq.modifiers...),
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (neq *NextEmbeddingQuery) WithMessage(opts ...func(*MessageQuery)) *NextEmbeddingQuery {
+	query := (&MessageClient{config: neq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	neq.withMessage = query
+	return neq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NextEmbedding.Query().
+//		GroupBy(nextembedding.FieldMessageID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (neq *NextEmbeddingQuery) GroupBy(field string, fields ...string) *NextEmbeddingGroupBy {
+	neq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NextEmbeddingGroupBy{build: neq}
+	grbuild.flds = &neq.ctx.Fields
+	grbuild.label = nextembedding.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//	}
+//
+//	client.NextEmbedding.Query().
+//		Select(nextembedding.FieldMessageID).
+//		Scan(ctx, &v)
+func (neq *NextEmbeddingQuery) Select(fields ...string) *NextEmbeddingSelect {
+	neq.ctx.Fields = append(neq.ctx.Fields, fields...)
+	sbuild := &NextEmbeddingSelect{NextEmbeddingQuery: neq}
+	sbuild.label = nextembedding.Label
+	sbuild.flds, sbuild.scan = &neq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NextEmbeddingSelect configured with the given aggregations.
+func (neq *NextEmbeddingQuery) Aggregate(fns ...AggregateFunc) *NextEmbeddingSelect {
+	return neq.Select().Aggregate(fns...)
+}
+
+func (neq *NextEmbeddingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range neq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, neq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range neq.ctx.Fields {
+		if !nextembedding.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if neq.path != nil {
+		prev, err := neq.path(ctx)
+		if err != nil {
+			return err
+		}
+		neq.sql = prev
+	}
+	return nil
+}
+
+func (neq *NextEmbeddingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NextEmbedding, error) {
+	var (
+		nodes       = []*NextEmbedding{}
+		_spec       = neq.querySpec()
+		loadedTypes = [1]bool{
+			neq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NextEmbedding).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NextEmbedding{config: neq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(neq.modifiers) > 0 {
+		_spec.Modifiers = neq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, neq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := neq.withMessage; query != nil {
+		if err := neq.loadMessage(ctx, query, nodes, nil,
+			func(n *NextEmbedding, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (neq *NextEmbeddingQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*NextEmbedding, init func(*NextEmbedding), assign func(*NextEmbedding, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NextEmbedding)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (neq *NextEmbeddingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := neq.querySpec()
+	if len(neq.modifiers) > 0 {
+		_spec.Modifiers = neq.modifiers
+	}
+	_spec.Node.Columns = neq.ctx.Fields
+	if len(neq.ctx.Fields) > 0 {
+		_spec.Unique = neq.ctx.Unique != nil && *neq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, neq.driver, _spec)
+}
+
+func (neq *NextEmbeddingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(nextembedding.Table, nextembedding.Columns, sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID))
+	_spec.From = neq.sql
+	if unique := neq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if neq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := neq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nextembedding.FieldID)
+		for i := range fields {
+			if fields[i] != nextembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if neq.withMessage != nil {
+			_spec.Node.AddColumnOnce(nextembedding.FieldMessageID)
+		}
+	}
+	if ps := neq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := neq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := neq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := neq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (neq *NextEmbeddingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(neq.driver.Dialect())
+	t1 := builder.Table(nextembedding.Table)
+	columns := neq.ctx.Fields
+	if len(columns) == 0 {
+		columns = nextembedding.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if neq.sql != nil {
+		selector = neq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if neq.ctx.Unique != nil && *neq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range neq.modifiers {
+		m(selector)
+	}
+	for _, p := range neq.predicates {
+		p(selector)
+	}
+	for _, p := range neq.order {
+		p(selector)
+	}
+	if offset := neq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := neq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (neq *NextEmbeddingQuery) Modify(modifiers ...func(s *sql.Selector)) *NextEmbeddingSelect {
+	neq.modifiers = append(neq.modifiers, modifiers...)
+	return neq.Select()
+}
+
+// NextEmbeddingGroupBy is the group-by builder for NextEmbedding entities.
+type NextEmbeddingGroupBy struct {
+	selector
+	build *NextEmbeddingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (negb *NextEmbeddingGroupBy) Aggregate(fns ...AggregateFunc) *NextEmbeddingGroupBy {
+	negb.fns = append(negb.fns, fns...)
+	return negb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (negb *NextEmbeddingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, negb.build.ctx, ent.OpQueryGroupBy)
+	if err := negb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NextEmbeddingQuery, *NextEmbeddingGroupBy](ctx, negb.build, negb, negb.build.inters, v)
+}
+
+func (negb *NextEmbeddingGroupBy) sqlScan(ctx context.Context, root *NextEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(negb.fns))
+	for _, fn := range negb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*negb.flds)+len(negb.fns))
+		for _, f := range *negb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*negb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := negb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NextEmbeddingSelect is the builder for selecting fields of NextEmbedding entities.
+type NextEmbeddingSelect struct {
+	*NextEmbeddingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nes *NextEmbeddingSelect) Aggregate(fns ...AggregateFunc) *NextEmbeddingSelect {
+	nes.fns = append(nes.fns, fns...)
+	return nes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nes *NextEmbeddingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nes.ctx, ent.OpQuerySelect)
+	if err := nes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NextEmbeddingQuery, *NextEmbeddingSelect](ctx, nes.NextEmbeddingQuery, nes, nes.inters, v)
+}
+
+func (nes *NextEmbeddingSelect) sqlScan(ctx context.Context, root *NextEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nes.fns))
+	for _, fn := range nes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (nes *NextEmbeddingSelect) Modify(modifiers ...func(s *sql.Selector)) *NextEmbeddingSelect {
+	nes.modifiers = append(nes.modifiers, modifiers...)
+	return nes
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// NextEmbeddingUpdate is the builder for updating NextEmbedding entities.
+type NextEmbeddingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NextEmbeddingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NextEmbeddingUpdate builder.
+func (neu *NextEmbeddingUpdate) Where(ps ...predicate.NextEmbedding) *NextEmbeddingUpdate {
+	neu.mutation.Where(ps...)
+	return neu
+}
+
+// SetMessageID sets the "message_id" field.
+func (neu *NextEmbeddingUpdate) SetMessageID(u uuid.UUID) *NextEmbeddingUpdate {
+	neu.mutation.SetMessageID(u)
+	return neu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableMessageID(u *uuid.UUID) *NextEmbeddingUpdate {
+	if u != nil {
+		neu.SetMessageID(*u)
+	}
+	return neu
+}
+
+// SetIndex sets the "index" field.
+func (neu *NextEmbeddingUpdate) SetIndex(i int) *NextEmbeddingUpdate {
+	neu.mutation.ResetIndex()
+	neu.mutation.SetIndex(i)
+	return neu
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableIndex(i *int) *NextEmbeddingUpdate {
+	if i != nil {
+		neu.SetIndex(*i)
+	}
+	return neu
+}
+
+// AddIndex adds i to the "index" field.
+func (neu *NextEmbeddingUpdate) AddIndex(i int) *NextEmbeddingUpdate {
+	neu.mutation.AddIndex(i)
+	return neu
+}
+
+// SetText sets the "text" field.
+func (neu *NextEmbeddingUpdate) SetText(s string) *NextEmbeddingUpdate {
+	neu.mutation.SetText(s)
+	return neu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableText(s *string) *NextEmbeddingUpdate {
+	if s != nil {
+		neu.SetText(*s)
+	}
+	return neu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (neu *NextEmbeddingUpdate) SetEmbedding(pg pgvector.Vector) *NextEmbeddingUpdate {
+	neu.mutation.SetEmbedding(pg)
+	return neu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableEmbedding(pg *pgvector.Vector) *NextEmbeddingUpdate {
+	if pg != nil {
+		neu.SetEmbedding(*pg)
+	}
+	return neu
+}
+
+// SetStrategy sets the "strategy" field.
+func (neu *NextEmbeddingUpdate) SetStrategy(ts types.EmbeddingStrategy) *NextEmbeddingUpdate {
+	neu.mutation.SetStrategy(ts)
+	return neu
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableStrategy(ts *types.EmbeddingStrategy) *NextEmbeddingUpdate {
+	if ts != nil {
+		neu.SetStrategy(*ts)
+	}
+	return neu
+}
+
+// SetModel sets the "model" field.
+func (neu *NextEmbeddingUpdate) SetModel(s string) *NextEmbeddingUpdate {
+	neu.mutation.SetModel(s)
+	return neu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (neu *NextEmbeddingUpdate) SetNillableModel(s *string) *NextEmbeddingUpdate {
+	if s != nil {
+		neu.SetModel(*s)
+	}
+	return neu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (neu *NextEmbeddingUpdate) SetMessage(m *Message) *NextEmbeddingUpdate {
+	return neu.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingMutation object of the builder.
+func (neu *NextEmbeddingUpdate) Mutation() *NextEmbeddingMutation {
+	return neu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (neu *NextEmbeddingUpdate) ClearMessage() *NextEmbeddingUpdate {
+	neu.mutation.ClearMessage()
+	return neu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (neu *NextEmbeddingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, neu.sqlSave, neu.mutation, neu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (neu *NextEmbeddingUpdate) SaveX(ctx context.Context) int {
+	affected, err := neu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (neu *NextEmbeddingUpdate) Exec(ctx context.Context) error {
+	_, err := neu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (neu *NextEmbeddingUpdate) ExecX(ctx context.Context) {
+	if err := neu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (neu *NextEmbeddingUpdate) check() error {
+	if v, ok := neu.mutation.Strategy(); ok {
+		if err := nextembedding.StrategyValidator(v); err != nil {
+			return &ValidationError{Name: "strategy", err: fmt.Errorf(`ent: validator failed for field "NextEmbedding.strategy": %w`, err)}
+		}
+	}
+	if neu.mutation.MessageCleared() && len(neu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NextEmbedding.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (neu *NextEmbeddingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NextEmbeddingUpdate {
+	neu.modifiers = append(neu.modifiers, modifiers...)
+	return neu
+}
+
+func (neu *NextEmbeddingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := neu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nextembedding.Table, nextembedding.Columns, sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID))
+	if ps := neu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := neu.mutation.Index(); ok {
+		_spec.SetField(nextembedding.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := neu.mutation.AddedIndex(); ok {
+		_spec.AddField(nextembedding.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := neu.mutation.Text(); ok {
+		_spec.SetField(nextembedding.FieldText, field.TypeString, value)
+	}
+	if value, ok := neu.mutation.Embedding(); ok {
+		_spec.SetField(nextembedding.FieldEmbedding, field.TypeOther, value)
+	}
+	if value, ok := neu.mutation.Strategy(); ok {
+		_spec.SetField(nextembedding.FieldStrategy, field.TypeEnum, value)
+	}
+	if value, ok := neu.mutation.Model(); ok {
+		_spec.SetField(nextembedding.FieldModel, field.TypeString, value)
+	}
+	if neu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembedding.MessageTable,
+			Columns: []string{nextembedding.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := neu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembedding.MessageTable,
+			Columns: []string{nextembedding.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(neu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, neu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nextembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	neu.mutation.done = true
+	return n, nil
+}
+
+// NextEmbeddingUpdateOne is the builder for updating a single NextEmbedding entity.
+type NextEmbeddingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NextEmbeddingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMessageID sets the "message_id" field.
+func (neuo *NextEmbeddingUpdateOne) SetMessageID(u uuid.UUID) *NextEmbeddingUpdateOne {
+	neuo.mutation.SetMessageID(u)
+	return neuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableMessageID(u *uuid.UUID) *NextEmbeddingUpdateOne {
+	if u != nil {
+		neuo.SetMessageID(*u)
+	}
+	return neuo
+}
+
+// SetIndex sets the "index" field.
+func (neuo *NextEmbeddingUpdateOne) SetIndex(i int) *NextEmbeddingUpdateOne {
+	neuo.mutation.ResetIndex()
+	neuo.mutation.SetIndex(i)
+	return neuo
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableIndex(i *int) *NextEmbeddingUpdateOne {
+	if i != nil {
+		neuo.SetIndex(*i)
+	}
+	return neuo
+}
+
+// AddIndex adds i to the "index" field.
+func (neuo *NextEmbeddingUpdateOne) AddIndex(i int) *NextEmbeddingUpdateOne {
+	neuo.mutation.AddIndex(i)
+	return neuo
+}
+
+// SetText sets the "text" field.
+func (neuo *NextEmbeddingUpdateOne) SetText(s string) *NextEmbeddingUpdateOne {
+	neuo.mutation.SetText(s)
+	return neuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableText(s *string) *NextEmbeddingUpdateOne {
+	if s != nil {
+		neuo.SetText(*s)
+	}
+	return neuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (neuo *NextEmbeddingUpdateOne) SetEmbedding(pg pgvector.Vector) *NextEmbeddingUpdateOne {
+	neuo.mutation.SetEmbedding(pg)
+	return neuo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *NextEmbeddingUpdateOne {
+	if pg != nil {
+		neuo.SetEmbedding(*pg)
+	}
+	return neuo
+}
+
+// SetStrategy sets the "strategy" field.
+func (neuo *NextEmbeddingUpdateOne) SetStrategy(ts types.EmbeddingStrategy) *NextEmbeddingUpdateOne {
+	neuo.mutation.SetStrategy(ts)
+	return neuo
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableStrategy(ts *types.EmbeddingStrategy) *NextEmbeddingUpdateOne {
+	if ts != nil {
+		neuo.SetStrategy(*ts)
+	}
+	return neuo
+}
+
+// SetModel sets the "model" field.
+func (neuo *NextEmbeddingUpdateOne) SetModel(s string) *NextEmbeddingUpdateOne {
+	neuo.mutation.SetModel(s)
+	return neuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (neuo *NextEmbeddingUpdateOne) SetNillableModel(s *string) *NextEmbeddingUpdateOne {
+	if s != nil {
+		neuo.SetModel(*s)
+	}
+	return neuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (neuo *NextEmbeddingUpdateOne) SetMessage(m *Message) *NextEmbeddingUpdateOne {
+	return neuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingMutation object of the builder.
+func (neuo *NextEmbeddingUpdateOne) Mutation() *NextEmbeddingMutation {
+	return neuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (neuo *NextEmbeddingUpdateOne) ClearMessage() *NextEmbeddingUpdateOne {
+	neuo.mutation.ClearMessage()
+	return neuo
+}
+
+// Where appends a list predicates to the NextEmbeddingUpdate builder.
+func (neuo *NextEmbeddingUpdateOne) Where(ps ...predicate.NextEmbedding) *NextEmbeddingUpdateOne {
+	neuo.mutation.Where(ps...)
+	return neuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (neuo *NextEmbeddingUpdateOne) Select(field string, fields ...string) *NextEmbeddingUpdateOne {
+	neuo.fields = append([]string{field}, fields...)
+	return neuo
+}
+
+// Save executes the query and returns the updated NextEmbedding entity.
+func (neuo *NextEmbeddingUpdateOne) Save(ctx context.Context) (*NextEmbedding, error) {
+	return withHooks(ctx, neuo.sqlSave, neuo.mutation, neuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (neuo *NextEmbeddingUpdateOne) SaveX(ctx context.Context) *NextEmbedding {
+	node, err := neuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (neuo *NextEmbeddingUpdateOne) Exec(ctx context.Context) error {
+	_, err := neuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (neuo *NextEmbeddingUpdateOne) ExecX(ctx context.Context) {
+	if err := neuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (neuo *NextEmbeddingUpdateOne) check() error {
+	if v, ok := neuo.mutation.Strategy(); ok {
+		if err := nextembedding.StrategyValidator(v); err != nil {
+			return &ValidationError{Name: "strategy", err: fmt.Errorf(`ent: validator failed for field "NextEmbedding.strategy": %w`, err)}
+		}
+	}
+	if neuo.mutation.MessageCleared() && len(neuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NextEmbedding.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (neuo *NextEmbeddingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NextEmbeddingUpdateOne {
+	neuo.modifiers = append(neuo.modifiers, modifiers...)
+	return neuo
+}
+
+func (neuo *NextEmbeddingUpdateOne) sqlSave(ctx context.Context) (_node *NextEmbedding, err error) {
+	if err := neuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nextembedding.Table, nextembedding.Columns, sqlgraph.NewFieldSpec(nextembedding.FieldID, field.TypeUUID))
+	id, ok := neuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NextEmbedding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := neuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nextembedding.FieldID)
+		for _, f := range fields {
+			if !nextembedding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nextembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := neuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := neuo.mutation.Index(); ok {
+		_spec.SetField(nextembedding.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := neuo.mutation.AddedIndex(); ok {
+		_spec.AddField(nextembedding.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := neuo.mutation.Text(); ok {
+		_spec.SetField(nextembedding.FieldText, field.TypeString, value)
+	}
+	if value, ok := neuo.mutation.Embedding(); ok {
+		_spec.SetField(nextembedding.FieldEmbedding, field.TypeOther, value)
+	}
+	if value, ok := neuo.mutation.Strategy(); ok {
+		_spec.SetField(nextembedding.FieldStrategy, field.TypeEnum, value)
+	}
+	if value, ok := neuo.mutation.Model(); ok {
+		_spec.SetField(nextembedding.FieldModel, field.TypeString, value)
+	}
+	if neuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembedding.MessageTable,
+			Columns: []string{nextembedding.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := neuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembedding.MessageTable,
+			Columns: []string{nextembedding.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(neuo.modifiers...)
+	_node = &NextEmbedding{config: neuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, neuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nextembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	neuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+)
+
+// NextEmbeddingFailure is the model entity for the NextEmbeddingFailure schema.
+type NextEmbeddingFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// RetryAt holds the value of the "retry_at" field.
+	RetryAt *time.Time `json:"retry_at,omitempty"`
+	// FailedAt holds the value of the "failed_at" field.
+	FailedAt *time.Time `json:"failed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NextEmbeddingFailureQuery when eager-loading is set.
+	Edges        NextEmbeddingFailureEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NextEmbeddingFailureEdges holds the relations/edges for other nodes in the graph.
+type NextEmbeddingFailureEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NextEmbeddingFailureEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NextEmbeddingFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nextembeddingfailure.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case nextembeddingfailure.FieldModel, nextembeddingfailure.FieldError:
+			values[i] = new(sql.NullString)
+		case nextembeddingfailure.FieldRetryAt, nextembeddingfailure.FieldFailedAt:
+			values[i] = new(sql.NullTime)
+		case nextembeddingfailure.FieldID, nextembeddingfailure.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NextEmbeddingFailure fields.
+func (nef *NextEmbeddingFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nextembeddingfailure.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nef.ID = *value
+			}
+		case nextembeddingfailure.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				nef.MessageID = *value
+			}
+		case nextembeddingfailure.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				nef.Model = value.String
+			}
+		case nextembeddingfailure.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				nef.Attempts = int(value.Int64)
+			}
+		case nextembeddingfailure.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				nef.Error = value.String
+			}
+		case nextembeddingfailure.FieldRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_at", values[i])
+			} else if value.Valid {
+				nef.RetryAt = new(time.Time)
+				*nef.RetryAt = value.Time
+			}
+		case nextembeddingfailure.FieldFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_at", values[i])
+			} else if value.Valid {
+				nef.FailedAt = new(time.Time)
+				*nef.FailedAt = value.Time
+			}
+		default:
+			nef.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NextEmbeddingFailure.
+// This includes values selected through modifiers, order, etc.
+func (nef *NextEmbeddingFailure) Value(name string) (ent.Value, error) {
+	return nef.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the NextEmbeddingFailure entity.
+func (nef *NextEmbeddingFailure) QueryMessage() *MessageQuery {
+	return NewNextEmbeddingFailureClient(nef.config).QueryMessage(nef)
+}
+
+// Update returns a builder for updating this NextEmbeddingFailure.
+// Note that you need to call NextEmbeddingFailure.Unwrap() before calling this method if this NextEmbeddingFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nef *NextEmbeddingFailure) Update() *NextEmbeddingFailureUpdateOne {
+	return NewNextEmbeddingFailureClient(nef.config).UpdateOne(nef)
+}
+
+// Unwrap unwraps the NextEmbeddingFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nef *NextEmbeddingFailure) Unwrap() *NextEmbeddingFailure {
+	_tx, ok := nef.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NextEmbeddingFailure is not a transactional entity")
+	}
+	nef.config.driver = _tx.drv
+	return nef
+}
+
+// String implements the fmt.Stringer.
+func (nef *NextEmbeddingFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("NextEmbeddingFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nef.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", nef.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(nef.Model)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", nef.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(nef.Error)
+	builder.WriteString(", ")
+	if v := nef.RetryAt; v != nil {
+		builder.WriteString("retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := nef.FailedAt; v != nil {
+		builder.WriteString("failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NextEmbeddingFailures is a parsable slice of NextEmbeddingFailure.
+type NextEmbeddingFailures []*NextEmbeddingFailure
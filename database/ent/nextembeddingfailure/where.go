@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nextembeddingfailure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldID, id))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldMessageID, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldModel, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldError, v))
+}
+
+// RetryAt applies equality check predicate on the "retry_at" field. It's identical to RetryAtEQ.
+func RetryAt(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldRetryAt, v))
+}
+
+// FailedAt applies equality check predicate on the "failed_at" field. It's identical to FailedAtEQ.
+func FailedAt(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...uuid.UUID) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldContainsFold(FieldModel, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldContainsFold(FieldError, v))
+}
+
+// RetryAtEQ applies the EQ predicate on the "retry_at" field.
+func RetryAtEQ(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldRetryAt, v))
+}
+
+// RetryAtNEQ applies the NEQ predicate on the "retry_at" field.
+func RetryAtNEQ(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldRetryAt, v))
+}
+
+// RetryAtIn applies the In predicate on the "retry_at" field.
+func RetryAtIn(vs ...time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldRetryAt, vs...))
+}
+
+// RetryAtNotIn applies the NotIn predicate on the "retry_at" field.
+func RetryAtNotIn(vs ...time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldRetryAt, vs...))
+}
+
+// RetryAtGT applies the GT predicate on the "retry_at" field.
+func RetryAtGT(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldRetryAt, v))
+}
+
+// RetryAtGTE applies the GTE predicate on the "retry_at" field.
+func RetryAtGTE(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldRetryAt, v))
+}
+
+// RetryAtLT applies the LT predicate on the "retry_at" field.
+func RetryAtLT(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldRetryAt, v))
+}
+
+// RetryAtLTE applies the LTE predicate on the "retry_at" field.
+func RetryAtLTE(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldRetryAt, v))
+}
+
+// RetryAtIsNil applies the IsNil predicate on the "retry_at" field.
+func RetryAtIsNil() predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIsNull(FieldRetryAt))
+}
+
+// RetryAtNotNil applies the NotNil predicate on the "retry_at" field.
+func RetryAtNotNil() predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotNull(FieldRetryAt))
+}
+
+// FailedAtEQ applies the EQ predicate on the "failed_at" field.
+func FailedAtEQ(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// FailedAtNEQ applies the NEQ predicate on the "failed_at" field.
+func FailedAtNEQ(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNEQ(FieldFailedAt, v))
+}
+
+// FailedAtIn applies the In predicate on the "failed_at" field.
+func FailedAtIn(vs ...time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIn(FieldFailedAt, vs...))
+}
+
+// FailedAtNotIn applies the NotIn predicate on the "failed_at" field.
+func FailedAtNotIn(vs ...time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotIn(FieldFailedAt, vs...))
+}
+
+// FailedAtGT applies the GT predicate on the "failed_at" field.
+func FailedAtGT(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGT(FieldFailedAt, v))
+}
+
+// FailedAtGTE applies the GTE predicate on the "failed_at" field.
+func FailedAtGTE(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldGTE(FieldFailedAt, v))
+}
+
+// FailedAtLT applies the LT predicate on the "failed_at" field.
+func FailedAtLT(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLT(FieldFailedAt, v))
+}
+
+// FailedAtLTE applies the LTE predicate on the "failed_at" field.
+func FailedAtLTE(v time.Time) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldLTE(FieldFailedAt, v))
+}
+
+// FailedAtIsNil applies the IsNil predicate on the "failed_at" field.
+func FailedAtIsNil() predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldIsNull(FieldFailedAt))
+}
+
+// FailedAtNotNil applies the NotNil predicate on the "failed_at" field.
+func FailedAtNotNil() predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.FieldNotNull(FieldFailedAt))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NextEmbeddingFailure) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NextEmbeddingFailure) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NextEmbeddingFailure) predicate.NextEmbeddingFailure {
+	return predicate.NextEmbeddingFailure(sql.NotPredicates(p))
+}
@@ -0,0 +1,878 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+)
+
+// NextEmbeddingFailureCreate is the builder for creating a NextEmbeddingFailure entity.
+type NextEmbeddingFailureCreate struct {
+	config
+	mutation *NextEmbeddingFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMessageID sets the "message_id" field.
+func (nefc *NextEmbeddingFailureCreate) SetMessageID(u uuid.UUID) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetMessageID(u)
+	return nefc
+}
+
+// SetModel sets the "model" field.
+func (nefc *NextEmbeddingFailureCreate) SetModel(s string) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetModel(s)
+	return nefc
+}
+
+// SetAttempts sets the "attempts" field.
+func (nefc *NextEmbeddingFailureCreate) SetAttempts(i int) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetAttempts(i)
+	return nefc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (nefc *NextEmbeddingFailureCreate) SetNillableAttempts(i *int) *NextEmbeddingFailureCreate {
+	if i != nil {
+		nefc.SetAttempts(*i)
+	}
+	return nefc
+}
+
+// SetError sets the "error" field.
+func (nefc *NextEmbeddingFailureCreate) SetError(s string) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetError(s)
+	return nefc
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (nefc *NextEmbeddingFailureCreate) SetRetryAt(t time.Time) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetRetryAt(t)
+	return nefc
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (nefc *NextEmbeddingFailureCreate) SetNillableRetryAt(t *time.Time) *NextEmbeddingFailureCreate {
+	if t != nil {
+		nefc.SetRetryAt(*t)
+	}
+	return nefc
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (nefc *NextEmbeddingFailureCreate) SetFailedAt(t time.Time) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetFailedAt(t)
+	return nefc
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (nefc *NextEmbeddingFailureCreate) SetNillableFailedAt(t *time.Time) *NextEmbeddingFailureCreate {
+	if t != nil {
+		nefc.SetFailedAt(*t)
+	}
+	return nefc
+}
+
+// SetID sets the "id" field.
+func (nefc *NextEmbeddingFailureCreate) SetID(u uuid.UUID) *NextEmbeddingFailureCreate {
+	nefc.mutation.SetID(u)
+	return nefc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nefc *NextEmbeddingFailureCreate) SetNillableID(u *uuid.UUID) *NextEmbeddingFailureCreate {
+	if u != nil {
+		nefc.SetID(*u)
+	}
+	return nefc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (nefc *NextEmbeddingFailureCreate) SetMessage(m *Message) *NextEmbeddingFailureCreate {
+	return nefc.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingFailureMutation object of the builder.
+func (nefc *NextEmbeddingFailureCreate) Mutation() *NextEmbeddingFailureMutation {
+	return nefc.mutation
+}
+
+// Save creates the NextEmbeddingFailure in the database.
+func (nefc *NextEmbeddingFailureCreate) Save(ctx context.Context) (*NextEmbeddingFailure, error) {
+	nefc.defaults()
+	return withHooks(ctx, nefc.sqlSave, nefc.mutation, nefc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nefc *NextEmbeddingFailureCreate) SaveX(ctx context.Context) *NextEmbeddingFailure {
+	v, err := nefc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nefc *NextEmbeddingFailureCreate) Exec(ctx context.Context) error {
+	_, err := nefc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefc *NextEmbeddingFailureCreate) ExecX(ctx context.Context) {
+	if err := nefc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nefc *NextEmbeddingFailureCreate) defaults() {
+	if _, ok := nefc.mutation.Attempts(); !ok {
+		v := nextembeddingfailure.DefaultAttempts
+		nefc.mutation.SetAttempts(v)
+	}
+	if _, ok := nefc.mutation.ID(); !ok {
+		v := nextembeddingfailure.DefaultID()
+		nefc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nefc *NextEmbeddingFailureCreate) check() error {
+	if _, ok := nefc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "NextEmbeddingFailure.message_id"`)}
+	}
+	if _, ok := nefc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "NextEmbeddingFailure.model"`)}
+	}
+	if _, ok := nefc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NextEmbeddingFailure.attempts"`)}
+	}
+	if _, ok := nefc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "NextEmbeddingFailure.error"`)}
+	}
+	if len(nefc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "NextEmbeddingFailure.message"`)}
+	}
+	return nil
+}
+
+func (nefc *NextEmbeddingFailureCreate) sqlSave(ctx context.Context) (*NextEmbeddingFailure, error) {
+	if err := nefc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nefc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nefc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	nefc.mutation.id = &_node.ID
+	nefc.mutation.done = true
+	return _node, nil
+}
+
+func (nefc *NextEmbeddingFailureCreate) createSpec() (*NextEmbeddingFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NextEmbeddingFailure{config: nefc.config}
+		_spec = sqlgraph.NewCreateSpec(nextembeddingfailure.Table, sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = nefc.conflict
+	if id, ok := nefc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nefc.mutation.Model(); ok {
+		_spec.SetField(nextembeddingfailure.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := nefc.mutation.Attempts(); ok {
+		_spec.SetField(nextembeddingfailure.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := nefc.mutation.Error(); ok {
+		_spec.SetField(nextembeddingfailure.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := nefc.mutation.RetryAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldRetryAt, field.TypeTime, value)
+		_node.RetryAt = &value
+	}
+	if value, ok := nefc.mutation.FailedAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = &value
+	}
+	if nodes := nefc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembeddingfailure.MessageTable,
+			Columns: []string{nextembeddingfailure.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NextEmbeddingFailure.Create().
+//		SetMessageID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NextEmbeddingFailureUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (nefc *NextEmbeddingFailureCreate) OnConflict(opts ...sql.ConflictOption) *NextEmbeddingFailureUpsertOne {
+	nefc.conflict = opts
+	return &NextEmbeddingFailureUpsertOne{
+		create: nefc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nefc *NextEmbeddingFailureCreate) OnConflictColumns(columns ...string) *NextEmbeddingFailureUpsertOne {
+	nefc.conflict = append(nefc.conflict, sql.ConflictColumns(columns...))
+	return &NextEmbeddingFailureUpsertOne{
+		create: nefc,
+	}
+}
+
+type (
+	// NextEmbeddingFailureUpsertOne is the builder for "upsert"-ing
+	//  one NextEmbeddingFailure node.
+	NextEmbeddingFailureUpsertOne struct {
+		create *NextEmbeddingFailureCreate
+	}
+
+	// NextEmbeddingFailureUpsert is the "OnConflict" setter.
+	NextEmbeddingFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingFailureUpsert) SetMessageID(v uuid.UUID) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateMessageID() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldMessageID)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingFailureUpsert) SetModel(v string) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateModel() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldModel)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NextEmbeddingFailureUpsert) SetAttempts(v int) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateAttempts() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NextEmbeddingFailureUpsert) AddAttempts(v int) *NextEmbeddingFailureUpsert {
+	u.Add(nextembeddingfailure.FieldAttempts, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *NextEmbeddingFailureUpsert) SetError(v string) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateError() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldError)
+	return u
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (u *NextEmbeddingFailureUpsert) SetRetryAt(v time.Time) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldRetryAt, v)
+	return u
+}
+
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateRetryAt() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldRetryAt)
+	return u
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *NextEmbeddingFailureUpsert) ClearRetryAt() *NextEmbeddingFailureUpsert {
+	u.SetNull(nextembeddingfailure.FieldRetryAt)
+	return u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *NextEmbeddingFailureUpsert) SetFailedAt(v time.Time) *NextEmbeddingFailureUpsert {
+	u.Set(nextembeddingfailure.FieldFailedAt, v)
+	return u
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsert) UpdateFailedAt() *NextEmbeddingFailureUpsert {
+	u.SetExcluded(nextembeddingfailure.FieldFailedAt)
+	return u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *NextEmbeddingFailureUpsert) ClearFailedAt() *NextEmbeddingFailureUpsert {
+	u.SetNull(nextembeddingfailure.FieldFailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(nextembeddingfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NextEmbeddingFailureUpsertOne) UpdateNewValues() *NextEmbeddingFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(nextembeddingfailure.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NextEmbeddingFailureUpsertOne) Ignore() *NextEmbeddingFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NextEmbeddingFailureUpsertOne) DoNothing() *NextEmbeddingFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NextEmbeddingFailureCreate.OnConflict
+// documentation for more info.
+func (u *NextEmbeddingFailureUpsertOne) Update(set func(*NextEmbeddingFailureUpsert)) *NextEmbeddingFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NextEmbeddingFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingFailureUpsertOne) SetMessageID(v uuid.UUID) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateMessageID() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingFailureUpsertOne) SetModel(v string) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateModel() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NextEmbeddingFailureUpsertOne) SetAttempts(v int) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NextEmbeddingFailureUpsertOne) AddAttempts(v int) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateAttempts() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NextEmbeddingFailureUpsertOne) SetError(v string) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateError() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (u *NextEmbeddingFailureUpsertOne) SetRetryAt(v time.Time) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetRetryAt(v)
+	})
+}
+
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateRetryAt() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateRetryAt()
+	})
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *NextEmbeddingFailureUpsertOne) ClearRetryAt() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.ClearRetryAt()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *NextEmbeddingFailureUpsertOne) SetFailedAt(v time.Time) *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertOne) UpdateFailedAt() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *NextEmbeddingFailureUpsertOne) ClearFailedAt() *NextEmbeddingFailureUpsertOne {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.ClearFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NextEmbeddingFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NextEmbeddingFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NextEmbeddingFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NextEmbeddingFailureUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NextEmbeddingFailureUpsertOne.ID is not supported by MySQL driver. Use NextEmbeddingFailureUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NextEmbeddingFailureUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NextEmbeddingFailureCreateBulk is the builder for creating many NextEmbeddingFailure entities in bulk.
+type NextEmbeddingFailureCreateBulk struct {
+	config
+	err      error
+	builders []*NextEmbeddingFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NextEmbeddingFailure entities in the database.
+func (nefcb *NextEmbeddingFailureCreateBulk) Save(ctx context.Context) ([]*NextEmbeddingFailure, error) {
+	if nefcb.err != nil {
+		return nil, nefcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nefcb.builders))
+	nodes := make([]*NextEmbeddingFailure, len(nefcb.builders))
+	mutators := make([]Mutator, len(nefcb.builders))
+	for i := range nefcb.builders {
+		func(i int, root context.Context) {
+			builder := nefcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NextEmbeddingFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nefcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nefcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nefcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nefcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nefcb *NextEmbeddingFailureCreateBulk) SaveX(ctx context.Context) []*NextEmbeddingFailure {
+	v, err := nefcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nefcb *NextEmbeddingFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := nefcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefcb *NextEmbeddingFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := nefcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NextEmbeddingFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NextEmbeddingFailureUpsert) {
+//			SetMessageID(v+v).
+//		}).
+//		Exec(ctx)
+func (nefcb *NextEmbeddingFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *NextEmbeddingFailureUpsertBulk {
+	nefcb.conflict = opts
+	return &NextEmbeddingFailureUpsertBulk{
+		create: nefcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nefcb *NextEmbeddingFailureCreateBulk) OnConflictColumns(columns ...string) *NextEmbeddingFailureUpsertBulk {
+	nefcb.conflict = append(nefcb.conflict, sql.ConflictColumns(columns...))
+	return &NextEmbeddingFailureUpsertBulk{
+		create: nefcb,
+	}
+}
+
+// NextEmbeddingFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of NextEmbeddingFailure nodes.
+type NextEmbeddingFailureUpsertBulk struct {
+	create *NextEmbeddingFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(nextembeddingfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NextEmbeddingFailureUpsertBulk) UpdateNewValues() *NextEmbeddingFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(nextembeddingfailure.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NextEmbeddingFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NextEmbeddingFailureUpsertBulk) Ignore() *NextEmbeddingFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NextEmbeddingFailureUpsertBulk) DoNothing() *NextEmbeddingFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NextEmbeddingFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *NextEmbeddingFailureUpsertBulk) Update(set func(*NextEmbeddingFailureUpsert)) *NextEmbeddingFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NextEmbeddingFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetMessageID(v uuid.UUID) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateMessageID() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetModel(v string) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateModel() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetAttempts(v int) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NextEmbeddingFailureUpsertBulk) AddAttempts(v int) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateAttempts() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetError(v string) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateError() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetRetryAt(v time.Time) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetRetryAt(v)
+	})
+}
+
+// UpdateRetryAt sets the "retry_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateRetryAt() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateRetryAt()
+	})
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (u *NextEmbeddingFailureUpsertBulk) ClearRetryAt() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.ClearRetryAt()
+	})
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (u *NextEmbeddingFailureUpsertBulk) SetFailedAt(v time.Time) *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.SetFailedAt(v)
+	})
+}
+
+// UpdateFailedAt sets the "failed_at" field to the value that was provided on create.
+func (u *NextEmbeddingFailureUpsertBulk) UpdateFailedAt() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.UpdateFailedAt()
+	})
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (u *NextEmbeddingFailureUpsertBulk) ClearFailedAt() *NextEmbeddingFailureUpsertBulk {
+	return u.Update(func(s *NextEmbeddingFailureUpsert) {
+		s.ClearFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NextEmbeddingFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NextEmbeddingFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NextEmbeddingFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NextEmbeddingFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
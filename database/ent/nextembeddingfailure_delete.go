@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// NextEmbeddingFailureDelete is the builder for deleting a NextEmbeddingFailure entity.
+type NextEmbeddingFailureDelete struct {
+	config
+	hooks    []Hook
+	mutation *NextEmbeddingFailureMutation
+}
+
+// Where appends a list predicates to the NextEmbeddingFailureDelete builder.
+func (nefd *NextEmbeddingFailureDelete) Where(ps ...predicate.NextEmbeddingFailure) *NextEmbeddingFailureDelete {
+	nefd.mutation.Where(ps...)
+	return nefd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nefd *NextEmbeddingFailureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nefd.sqlExec, nefd.mutation, nefd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefd *NextEmbeddingFailureDelete) ExecX(ctx context.Context) int {
+	n, err := nefd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nefd *NextEmbeddingFailureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(nextembeddingfailure.Table, sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID))
+	if ps := nefd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nefd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nefd.mutation.done = true
+	return affected, err
+}
+
+// NextEmbeddingFailureDeleteOne is the builder for deleting a single NextEmbeddingFailure entity.
+type NextEmbeddingFailureDeleteOne struct {
+	nefd *NextEmbeddingFailureDelete
+}
+
+// Where appends a list predicates to the NextEmbeddingFailureDelete builder.
+func (nefdo *NextEmbeddingFailureDeleteOne) Where(ps ...predicate.NextEmbeddingFailure) *NextEmbeddingFailureDeleteOne {
+	nefdo.nefd.mutation.Where(ps...)
+	return nefdo
+}
+
+// Exec executes the deletion query.
+func (nefdo *NextEmbeddingFailureDeleteOne) Exec(ctx context.Context) error {
+	n, err := nefdo.nefd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{nextembeddingfailure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefdo *NextEmbeddingFailureDeleteOne) ExecX(ctx context.Context) {
+	if err := nefdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
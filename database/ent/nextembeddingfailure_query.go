@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// NextEmbeddingFailureQuery is the builder for querying NextEmbeddingFailure entities.
+type NextEmbeddingFailureQuery struct {
+	config
+	ctx         *QueryContext
+	order       []nextembeddingfailure.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.NextEmbeddingFailure
+	withMessage *MessageQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NextEmbeddingFailureQuery builder.
+func (nefq *NextEmbeddingFailureQuery) Where(ps ...predicate.NextEmbeddingFailure) *NextEmbeddingFailureQuery {
+	nefq.predicates = append(nefq.predicates, ps...)
+	return nefq
+}
+
+// Limit the number of records to be returned by this query.
+func (nefq *NextEmbeddingFailureQuery) Limit(limit int) *NextEmbeddingFailureQuery {
+	nefq.ctx.Limit = &limit
+	return nefq
+}
+
+// Offset to start from.
+func (nefq *NextEmbeddingFailureQuery) Offset(offset int) *NextEmbeddingFailureQuery {
+	nefq.ctx.Offset = &offset
+	return nefq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nefq *NextEmbeddingFailureQuery) Unique(unique bool) *NextEmbeddingFailureQuery {
+	nefq.ctx.Unique = &unique
+	return nefq
+}
+
+// Order specifies how the records should be ordered.
+func (nefq *NextEmbeddingFailureQuery) Order(o ...nextembeddingfailure.OrderOption) *NextEmbeddingFailureQuery {
+	nefq.order = append(nefq.order, o...)
+	return nefq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (nefq *NextEmbeddingFailureQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: nefq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nefq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nefq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nextembeddingfailure.Table, nextembeddingfailure.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nextembeddingfailure.MessageTable, nextembeddingfailure.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nefq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NextEmbeddingFailure entity from the query.
+// Returns a *NotFoundError when no NextEmbeddingFailure was found.
+func (nefq *NextEmbeddingFailureQuery) First(ctx context.Context) (*NextEmbeddingFailure, error) {
+	nodes, err := nefq.Limit(1).All(setContextOp(ctx, nefq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{nextembeddingfailure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) FirstX(ctx context.Context) *NextEmbeddingFailure {
+	node, err := nefq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NextEmbeddingFailure ID from the query.
+// Returns a *NotFoundError when no NextEmbeddingFailure ID was found.
+func (nefq *NextEmbeddingFailureQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nefq.Limit(1).IDs(setContextOp(ctx, nefq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{nextembeddingfailure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := nefq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NextEmbeddingFailure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NextEmbeddingFailure entity is found.
+// Returns a *NotFoundError when no NextEmbeddingFailure entities are found.
+func (nefq *NextEmbeddingFailureQuery) Only(ctx context.Context) (*NextEmbeddingFailure, error) {
+	nodes, err := nefq.Limit(2).All(setContextOp(ctx, nefq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{nextembeddingfailure.Label}
+	default:
+		return nil, &NotSingularError{nextembeddingfailure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) OnlyX(ctx context.Context) *NextEmbeddingFailure {
+	node, err := nefq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NextEmbeddingFailure ID in the query.
+// Returns a *NotSingularError when more than one NextEmbeddingFailure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nefq *NextEmbeddingFailureQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nefq.Limit(2).IDs(setContextOp(ctx, nefq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{nextembeddingfailure.Label}
+	default:
+		err = &NotSingularError{nextembeddingfailure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := nefq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NextEmbeddingFailures.
+func (nefq *NextEmbeddingFailureQuery) All(ctx context.Context) ([]*NextEmbeddingFailure, error) {
+	ctx = setContextOp(ctx, nefq.ctx, ent.OpQueryAll)
+	if err := nefq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NextEmbeddingFailure, *NextEmbeddingFailureQuery]()
+	return withInterceptors[[]*NextEmbeddingFailure](ctx, nefq, qr, nefq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) AllX(ctx context.Context) []*NextEmbeddingFailure {
+	nodes, err := nefq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NextEmbeddingFailure IDs.
+func (nefq *NextEmbeddingFailureQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if nefq.ctx.Unique == nil && nefq.path != nil {
+		nefq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nefq.ctx, ent.OpQueryIDs)
+	if err = nefq.Select(nextembeddingfailure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := nefq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nefq *NextEmbeddingFailureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nefq.ctx, ent.OpQueryCount)
+	if err := nefq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nefq, querierCount[*NextEmbeddingFailureQuery](), nefq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) CountX(ctx context.Context) int {
+	count, err := nefq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nefq *NextEmbeddingFailureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nefq.ctx, ent.OpQueryExist)
+	switch _, err := nefq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nefq *NextEmbeddingFailureQuery) ExistX(ctx context.Context) bool {
+	exist, err := nefq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NextEmbeddingFailureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nefq *NextEmbeddingFailureQuery) Clone() *NextEmbeddingFailureQuery {
+	if nefq == nil {
+		return nil
+	}
+	return &NextEmbeddingFailureQuery{
+		config:      nefq.config,
+		ctx:         nefq.ctx.Clone(),
+		order:       append([]nextembeddingfailure.OrderOption{}, nefq.order...),
+		inters:      append([]Interceptor{}, nefq.inters...),
+		predicates:  append([]predicate.NextEmbeddingFailure{}, nefq.predicates...),
+		withMessage: nefq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:       nefq.sql.Clone(),
+		path:      nefq.path,
+		modifiers: append([]func(*sql.Selector){}, nefq.modifiers...),
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (nefq *NextEmbeddingFailureQuery) WithMessage(opts ...func(*MessageQuery)) *NextEmbeddingFailureQuery {
+	query := (&MessageClient{config: nefq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nefq.withMessage = query
+	return nefq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NextEmbeddingFailure.Query().
+//		GroupBy(nextembeddingfailure.FieldMessageID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nefq *NextEmbeddingFailureQuery) GroupBy(field string, fields ...string) *NextEmbeddingFailureGroupBy {
+	nefq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NextEmbeddingFailureGroupBy{build: nefq}
+	grbuild.flds = &nefq.ctx.Fields
+	grbuild.label = nextembeddingfailure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID uuid.UUID `json:"message_id,omitempty"`
+//	}
+//
+//	client.NextEmbeddingFailure.Query().
+//		Select(nextembeddingfailure.FieldMessageID).
+//		Scan(ctx, &v)
+func (nefq *NextEmbeddingFailureQuery) Select(fields ...string) *NextEmbeddingFailureSelect {
+	nefq.ctx.Fields = append(nefq.ctx.Fields, fields...)
+	sbuild := &NextEmbeddingFailureSelect{NextEmbeddingFailureQuery: nefq}
+	sbuild.label = nextembeddingfailure.Label
+	sbuild.flds, sbuild.scan = &nefq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NextEmbeddingFailureSelect configured with the given aggregations.
+func (nefq *NextEmbeddingFailureQuery) Aggregate(fns ...AggregateFunc) *NextEmbeddingFailureSelect {
+	return nefq.Select().Aggregate(fns...)
+}
+
+func (nefq *NextEmbeddingFailureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nefq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nefq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nefq.ctx.Fields {
+		if !nextembeddingfailure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nefq.path != nil {
+		prev, err := nefq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nefq.sql = prev
+	}
+	return nil
+}
+
+func (nefq *NextEmbeddingFailureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NextEmbeddingFailure, error) {
+	var (
+		nodes       = []*NextEmbeddingFailure{}
+		_spec       = nefq.querySpec()
+		loadedTypes = [1]bool{
+			nefq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NextEmbeddingFailure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NextEmbeddingFailure{config: nefq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(nefq.modifiers) > 0 {
+		_spec.Modifiers = nefq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nefq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := nefq.withMessage; query != nil {
+		if err := nefq.loadMessage(ctx, query, nodes, nil,
+			func(n *NextEmbeddingFailure, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (nefq *NextEmbeddingFailureQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*NextEmbeddingFailure, init func(*NextEmbeddingFailure), assign func(*NextEmbeddingFailure, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NextEmbeddingFailure)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (nefq *NextEmbeddingFailureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nefq.querySpec()
+	if len(nefq.modifiers) > 0 {
+		_spec.Modifiers = nefq.modifiers
+	}
+	_spec.Node.Columns = nefq.ctx.Fields
+	if len(nefq.ctx.Fields) > 0 {
+		_spec.Unique = nefq.ctx.Unique != nil && *nefq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nefq.driver, _spec)
+}
+
+func (nefq *NextEmbeddingFailureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(nextembeddingfailure.Table, nextembeddingfailure.Columns, sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID))
+	_spec.From = nefq.sql
+	if unique := nefq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nefq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nefq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nextembeddingfailure.FieldID)
+		for i := range fields {
+			if fields[i] != nextembeddingfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if nefq.withMessage != nil {
+			_spec.Node.AddColumnOnce(nextembeddingfailure.FieldMessageID)
+		}
+	}
+	if ps := nefq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nefq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nefq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nefq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nefq *NextEmbeddingFailureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nefq.driver.Dialect())
+	t1 := builder.Table(nextembeddingfailure.Table)
+	columns := nefq.ctx.Fields
+	if len(columns) == 0 {
+		columns = nextembeddingfailure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nefq.sql != nil {
+		selector = nefq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nefq.ctx.Unique != nil && *nefq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range nefq.modifiers {
+		m(selector)
+	}
+	for _, p := range nefq.predicates {
+		p(selector)
+	}
+	for _, p := range nefq.order {
+		p(selector)
+	}
+	if offset := nefq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nefq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (nefq *NextEmbeddingFailureQuery) Modify(modifiers ...func(s *sql.Selector)) *NextEmbeddingFailureSelect {
+	nefq.modifiers = append(nefq.modifiers, modifiers...)
+	return nefq.Select()
+}
+
+// NextEmbeddingFailureGroupBy is the group-by builder for NextEmbeddingFailure entities.
+type NextEmbeddingFailureGroupBy struct {
+	selector
+	build *NextEmbeddingFailureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nefgb *NextEmbeddingFailureGroupBy) Aggregate(fns ...AggregateFunc) *NextEmbeddingFailureGroupBy {
+	nefgb.fns = append(nefgb.fns, fns...)
+	return nefgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nefgb *NextEmbeddingFailureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nefgb.build.ctx, ent.OpQueryGroupBy)
+	if err := nefgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NextEmbeddingFailureQuery, *NextEmbeddingFailureGroupBy](ctx, nefgb.build, nefgb, nefgb.build.inters, v)
+}
+
+func (nefgb *NextEmbeddingFailureGroupBy) sqlScan(ctx context.Context, root *NextEmbeddingFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nefgb.fns))
+	for _, fn := range nefgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nefgb.flds)+len(nefgb.fns))
+		for _, f := range *nefgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nefgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nefgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NextEmbeddingFailureSelect is the builder for selecting fields of NextEmbeddingFailure entities.
+type NextEmbeddingFailureSelect struct {
+	*NextEmbeddingFailureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nefs *NextEmbeddingFailureSelect) Aggregate(fns ...AggregateFunc) *NextEmbeddingFailureSelect {
+	nefs.fns = append(nefs.fns, fns...)
+	return nefs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nefs *NextEmbeddingFailureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nefs.ctx, ent.OpQuerySelect)
+	if err := nefs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NextEmbeddingFailureQuery, *NextEmbeddingFailureSelect](ctx, nefs.NextEmbeddingFailureQuery, nefs, nefs.inters, v)
+}
+
+func (nefs *NextEmbeddingFailureSelect) sqlScan(ctx context.Context, root *NextEmbeddingFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nefs.fns))
+	for _, fn := range nefs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nefs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nefs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (nefs *NextEmbeddingFailureSelect) Modify(modifiers ...func(s *sql.Selector)) *NextEmbeddingFailureSelect {
+	nefs.modifiers = append(nefs.modifiers, modifiers...)
+	return nefs
+}
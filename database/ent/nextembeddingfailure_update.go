@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// NextEmbeddingFailureUpdate is the builder for updating NextEmbeddingFailure entities.
+type NextEmbeddingFailureUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *NextEmbeddingFailureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the NextEmbeddingFailureUpdate builder.
+func (nefu *NextEmbeddingFailureUpdate) Where(ps ...predicate.NextEmbeddingFailure) *NextEmbeddingFailureUpdate {
+	nefu.mutation.Where(ps...)
+	return nefu
+}
+
+// SetMessageID sets the "message_id" field.
+func (nefu *NextEmbeddingFailureUpdate) SetMessageID(u uuid.UUID) *NextEmbeddingFailureUpdate {
+	nefu.mutation.SetMessageID(u)
+	return nefu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableMessageID(u *uuid.UUID) *NextEmbeddingFailureUpdate {
+	if u != nil {
+		nefu.SetMessageID(*u)
+	}
+	return nefu
+}
+
+// SetModel sets the "model" field.
+func (nefu *NextEmbeddingFailureUpdate) SetModel(s string) *NextEmbeddingFailureUpdate {
+	nefu.mutation.SetModel(s)
+	return nefu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableModel(s *string) *NextEmbeddingFailureUpdate {
+	if s != nil {
+		nefu.SetModel(*s)
+	}
+	return nefu
+}
+
+// SetAttempts sets the "attempts" field.
+func (nefu *NextEmbeddingFailureUpdate) SetAttempts(i int) *NextEmbeddingFailureUpdate {
+	nefu.mutation.ResetAttempts()
+	nefu.mutation.SetAttempts(i)
+	return nefu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableAttempts(i *int) *NextEmbeddingFailureUpdate {
+	if i != nil {
+		nefu.SetAttempts(*i)
+	}
+	return nefu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (nefu *NextEmbeddingFailureUpdate) AddAttempts(i int) *NextEmbeddingFailureUpdate {
+	nefu.mutation.AddAttempts(i)
+	return nefu
+}
+
+// SetError sets the "error" field.
+func (nefu *NextEmbeddingFailureUpdate) SetError(s string) *NextEmbeddingFailureUpdate {
+	nefu.mutation.SetError(s)
+	return nefu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableError(s *string) *NextEmbeddingFailureUpdate {
+	if s != nil {
+		nefu.SetError(*s)
+	}
+	return nefu
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (nefu *NextEmbeddingFailureUpdate) SetRetryAt(t time.Time) *NextEmbeddingFailureUpdate {
+	nefu.mutation.SetRetryAt(t)
+	return nefu
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableRetryAt(t *time.Time) *NextEmbeddingFailureUpdate {
+	if t != nil {
+		nefu.SetRetryAt(*t)
+	}
+	return nefu
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (nefu *NextEmbeddingFailureUpdate) ClearRetryAt() *NextEmbeddingFailureUpdate {
+	nefu.mutation.ClearRetryAt()
+	return nefu
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (nefu *NextEmbeddingFailureUpdate) SetFailedAt(t time.Time) *NextEmbeddingFailureUpdate {
+	nefu.mutation.SetFailedAt(t)
+	return nefu
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (nefu *NextEmbeddingFailureUpdate) SetNillableFailedAt(t *time.Time) *NextEmbeddingFailureUpdate {
+	if t != nil {
+		nefu.SetFailedAt(*t)
+	}
+	return nefu
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (nefu *NextEmbeddingFailureUpdate) ClearFailedAt() *NextEmbeddingFailureUpdate {
+	nefu.mutation.ClearFailedAt()
+	return nefu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (nefu *NextEmbeddingFailureUpdate) SetMessage(m *Message) *NextEmbeddingFailureUpdate {
+	return nefu.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingFailureMutation object of the builder.
+func (nefu *NextEmbeddingFailureUpdate) Mutation() *NextEmbeddingFailureMutation {
+	return nefu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (nefu *NextEmbeddingFailureUpdate) ClearMessage() *NextEmbeddingFailureUpdate {
+	nefu.mutation.ClearMessage()
+	return nefu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nefu *NextEmbeddingFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nefu.sqlSave, nefu.mutation, nefu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nefu *NextEmbeddingFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := nefu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nefu *NextEmbeddingFailureUpdate) Exec(ctx context.Context) error {
+	_, err := nefu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefu *NextEmbeddingFailureUpdate) ExecX(ctx context.Context) {
+	if err := nefu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nefu *NextEmbeddingFailureUpdate) check() error {
+	if nefu.mutation.MessageCleared() && len(nefu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NextEmbeddingFailure.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (nefu *NextEmbeddingFailureUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NextEmbeddingFailureUpdate {
+	nefu.modifiers = append(nefu.modifiers, modifiers...)
+	return nefu
+}
+
+func (nefu *NextEmbeddingFailureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nefu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nextembeddingfailure.Table, nextembeddingfailure.Columns, sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID))
+	if ps := nefu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nefu.mutation.Model(); ok {
+		_spec.SetField(nextembeddingfailure.FieldModel, field.TypeString, value)
+	}
+	if value, ok := nefu.mutation.Attempts(); ok {
+		_spec.SetField(nextembeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nefu.mutation.AddedAttempts(); ok {
+		_spec.AddField(nextembeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nefu.mutation.Error(); ok {
+		_spec.SetField(nextembeddingfailure.FieldError, field.TypeString, value)
+	}
+	if value, ok := nefu.mutation.RetryAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldRetryAt, field.TypeTime, value)
+	}
+	if nefu.mutation.RetryAtCleared() {
+		_spec.ClearField(nextembeddingfailure.FieldRetryAt, field.TypeTime)
+	}
+	if value, ok := nefu.mutation.FailedAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	if nefu.mutation.FailedAtCleared() {
+		_spec.ClearField(nextembeddingfailure.FieldFailedAt, field.TypeTime)
+	}
+	if nefu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembeddingfailure.MessageTable,
+			Columns: []string{nextembeddingfailure.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nefu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembeddingfailure.MessageTable,
+			Columns: []string{nextembeddingfailure.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(nefu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, nefu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nextembeddingfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nefu.mutation.done = true
+	return n, nil
+}
+
+// NextEmbeddingFailureUpdateOne is the builder for updating a single NextEmbeddingFailure entity.
+type NextEmbeddingFailureUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *NextEmbeddingFailureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMessageID sets the "message_id" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetMessageID(u uuid.UUID) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.SetMessageID(u)
+	return nefuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableMessageID(u *uuid.UUID) *NextEmbeddingFailureUpdateOne {
+	if u != nil {
+		nefuo.SetMessageID(*u)
+	}
+	return nefuo
+}
+
+// SetModel sets the "model" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetModel(s string) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.SetModel(s)
+	return nefuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableModel(s *string) *NextEmbeddingFailureUpdateOne {
+	if s != nil {
+		nefuo.SetModel(*s)
+	}
+	return nefuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetAttempts(i int) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.ResetAttempts()
+	nefuo.mutation.SetAttempts(i)
+	return nefuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableAttempts(i *int) *NextEmbeddingFailureUpdateOne {
+	if i != nil {
+		nefuo.SetAttempts(*i)
+	}
+	return nefuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) AddAttempts(i int) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.AddAttempts(i)
+	return nefuo
+}
+
+// SetError sets the "error" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetError(s string) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.SetError(s)
+	return nefuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableError(s *string) *NextEmbeddingFailureUpdateOne {
+	if s != nil {
+		nefuo.SetError(*s)
+	}
+	return nefuo
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetRetryAt(t time.Time) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.SetRetryAt(t)
+	return nefuo
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableRetryAt(t *time.Time) *NextEmbeddingFailureUpdateOne {
+	if t != nil {
+		nefuo.SetRetryAt(*t)
+	}
+	return nefuo
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) ClearRetryAt() *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.ClearRetryAt()
+	return nefuo
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetFailedAt(t time.Time) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.SetFailedAt(t)
+	return nefuo
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetNillableFailedAt(t *time.Time) *NextEmbeddingFailureUpdateOne {
+	if t != nil {
+		nefuo.SetFailedAt(*t)
+	}
+	return nefuo
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (nefuo *NextEmbeddingFailureUpdateOne) ClearFailedAt() *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.ClearFailedAt()
+	return nefuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (nefuo *NextEmbeddingFailureUpdateOne) SetMessage(m *Message) *NextEmbeddingFailureUpdateOne {
+	return nefuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the NextEmbeddingFailureMutation object of the builder.
+func (nefuo *NextEmbeddingFailureUpdateOne) Mutation() *NextEmbeddingFailureMutation {
+	return nefuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (nefuo *NextEmbeddingFailureUpdateOne) ClearMessage() *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.ClearMessage()
+	return nefuo
+}
+
+// Where appends a list predicates to the NextEmbeddingFailureUpdate builder.
+func (nefuo *NextEmbeddingFailureUpdateOne) Where(ps ...predicate.NextEmbeddingFailure) *NextEmbeddingFailureUpdateOne {
+	nefuo.mutation.Where(ps...)
+	return nefuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nefuo *NextEmbeddingFailureUpdateOne) Select(field string, fields ...string) *NextEmbeddingFailureUpdateOne {
+	nefuo.fields = append([]string{field}, fields...)
+	return nefuo
+}
+
+// Save executes the query and returns the updated NextEmbeddingFailure entity.
+func (nefuo *NextEmbeddingFailureUpdateOne) Save(ctx context.Context) (*NextEmbeddingFailure, error) {
+	return withHooks(ctx, nefuo.sqlSave, nefuo.mutation, nefuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nefuo *NextEmbeddingFailureUpdateOne) SaveX(ctx context.Context) *NextEmbeddingFailure {
+	node, err := nefuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nefuo *NextEmbeddingFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := nefuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nefuo *NextEmbeddingFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := nefuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nefuo *NextEmbeddingFailureUpdateOne) check() error {
+	if nefuo.mutation.MessageCleared() && len(nefuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NextEmbeddingFailure.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (nefuo *NextEmbeddingFailureUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *NextEmbeddingFailureUpdateOne {
+	nefuo.modifiers = append(nefuo.modifiers, modifiers...)
+	return nefuo
+}
+
+func (nefuo *NextEmbeddingFailureUpdateOne) sqlSave(ctx context.Context) (_node *NextEmbeddingFailure, err error) {
+	if err := nefuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nextembeddingfailure.Table, nextembeddingfailure.Columns, sqlgraph.NewFieldSpec(nextembeddingfailure.FieldID, field.TypeUUID))
+	id, ok := nefuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NextEmbeddingFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nefuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nextembeddingfailure.FieldID)
+		for _, f := range fields {
+			if !nextembeddingfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nextembeddingfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nefuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nefuo.mutation.Model(); ok {
+		_spec.SetField(nextembeddingfailure.FieldModel, field.TypeString, value)
+	}
+	if value, ok := nefuo.mutation.Attempts(); ok {
+		_spec.SetField(nextembeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nefuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(nextembeddingfailure.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nefuo.mutation.Error(); ok {
+		_spec.SetField(nextembeddingfailure.FieldError, field.TypeString, value)
+	}
+	if value, ok := nefuo.mutation.RetryAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldRetryAt, field.TypeTime, value)
+	}
+	if nefuo.mutation.RetryAtCleared() {
+		_spec.ClearField(nextembeddingfailure.FieldRetryAt, field.TypeTime)
+	}
+	if value, ok := nefuo.mutation.FailedAt(); ok {
+		_spec.SetField(nextembeddingfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	if nefuo.mutation.FailedAtCleared() {
+		_spec.ClearField(nextembeddingfailure.FieldFailedAt, field.TypeTime)
+	}
+	if nefuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembeddingfailure.MessageTable,
+			Columns: []string{nextembeddingfailure.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nefuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nextembeddingfailure.MessageTable,
+			Columns: []string{nextembeddingfailure.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(nefuo.modifiers...)
+	_node = &NextEmbeddingFailure{config: nefuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nefuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nextembeddingfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nefuo.mutation.done = true
+	return _node, nil
+}
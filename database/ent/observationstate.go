@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+)
+
+// ObservationState is the model entity for the ObservationState schema.
+type ObservationState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
+	// EmbeddingEnabled holds the value of the "embedding_enabled" field.
+	EmbeddingEnabled bool `json:"embedding_enabled,omitempty"`
+	// Retention holds the value of the "retention" field.
+	Retention *time.Duration `json:"retention,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ObservationState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case observationstate.FieldPaused, observationstate.FieldEmbeddingEnabled:
+			values[i] = new(sql.NullBool)
+		case observationstate.FieldID, observationstate.FieldRetention:
+			values[i] = new(sql.NullInt64)
+		case observationstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ObservationState fields.
+func (os *ObservationState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case observationstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			os.ID = int64(value.Int64)
+		case observationstate.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				os.Paused = value.Bool
+			}
+		case observationstate.FieldEmbeddingEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_enabled", values[i])
+			} else if value.Valid {
+				os.EmbeddingEnabled = value.Bool
+			}
+		case observationstate.FieldRetention:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention", values[i])
+			} else if value.Valid {
+				os.Retention = new(time.Duration)
+				*os.Retention = time.Duration(value.Int64)
+			}
+		case observationstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				os.UpdatedAt = value.Time
+			}
+		default:
+			os.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ObservationState.
+// This includes values selected through modifiers, order, etc.
+func (os *ObservationState) Value(name string) (ent.Value, error) {
+	return os.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ObservationState.
+// Note that you need to call ObservationState.Unwrap() before calling this method if this ObservationState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (os *ObservationState) Update() *ObservationStateUpdateOne {
+	return NewObservationStateClient(os.config).UpdateOne(os)
+}
+
+// Unwrap unwraps the ObservationState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (os *ObservationState) Unwrap() *ObservationState {
+	_tx, ok := os.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ObservationState is not a transactional entity")
+	}
+	os.config.driver = _tx.drv
+	return os
+}
+
+// String implements the fmt.Stringer.
+func (os *ObservationState) String() string {
+	var builder strings.Builder
+	builder.WriteString("ObservationState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", os.ID))
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", os.Paused))
+	builder.WriteString(", ")
+	builder.WriteString("embedding_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", os.EmbeddingEnabled))
+	builder.WriteString(", ")
+	if v := os.Retention; v != nil {
+		builder.WriteString("retention=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(os.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ObservationStates is a parsable slice of ObservationState.
+type ObservationStates []*ObservationState
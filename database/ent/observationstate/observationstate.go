@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package observationstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the observationstate type in the database.
+	Label = "observation_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
+	// FieldEmbeddingEnabled holds the string denoting the embedding_enabled field in the database.
+	FieldEmbeddingEnabled = "embedding_enabled"
+	// FieldRetention holds the string denoting the retention field in the database.
+	FieldRetention = "retention"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the observationstate in the database.
+	Table = "observation_states"
+)
+
+// Columns holds all SQL columns for observationstate fields.
+var Columns = []string{
+	FieldID,
+	FieldPaused,
+	FieldEmbeddingEnabled,
+	FieldRetention,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
+	// DefaultEmbeddingEnabled holds the default value on creation for the "embedding_enabled" field.
+	DefaultEmbeddingEnabled bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ObservationState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByEmbeddingEnabled orders the results by the embedding_enabled field.
+func ByEmbeddingEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingEnabled, opts...).ToFunc()
+}
+
+// ByRetention orders the results by the retention field.
+func ByRetention(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetention, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package observationstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldLTE(FieldID, id))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldPaused, v))
+}
+
+// EmbeddingEnabled applies equality check predicate on the "embedding_enabled" field. It's identical to EmbeddingEnabledEQ.
+func EmbeddingEnabled(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldEmbeddingEnabled, v))
+}
+
+// Retention applies equality check predicate on the "retention" field. It's identical to RetentionEQ.
+func Retention(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldEQ(FieldRetention, vc))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNEQ(FieldPaused, v))
+}
+
+// EmbeddingEnabledEQ applies the EQ predicate on the "embedding_enabled" field.
+func EmbeddingEnabledEQ(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldEmbeddingEnabled, v))
+}
+
+// EmbeddingEnabledNEQ applies the NEQ predicate on the "embedding_enabled" field.
+func EmbeddingEnabledNEQ(v bool) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNEQ(FieldEmbeddingEnabled, v))
+}
+
+// RetentionEQ applies the EQ predicate on the "retention" field.
+func RetentionEQ(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldEQ(FieldRetention, vc))
+}
+
+// RetentionNEQ applies the NEQ predicate on the "retention" field.
+func RetentionNEQ(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldNEQ(FieldRetention, vc))
+}
+
+// RetentionIn applies the In predicate on the "retention" field.
+func RetentionIn(vs ...time.Duration) predicate.ObservationState {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.ObservationState(sql.FieldIn(FieldRetention, v...))
+}
+
+// RetentionNotIn applies the NotIn predicate on the "retention" field.
+func RetentionNotIn(vs ...time.Duration) predicate.ObservationState {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.ObservationState(sql.FieldNotIn(FieldRetention, v...))
+}
+
+// RetentionGT applies the GT predicate on the "retention" field.
+func RetentionGT(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldGT(FieldRetention, vc))
+}
+
+// RetentionGTE applies the GTE predicate on the "retention" field.
+func RetentionGTE(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldGTE(FieldRetention, vc))
+}
+
+// RetentionLT applies the LT predicate on the "retention" field.
+func RetentionLT(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldLT(FieldRetention, vc))
+}
+
+// RetentionLTE applies the LTE predicate on the "retention" field.
+func RetentionLTE(v time.Duration) predicate.ObservationState {
+	vc := int64(v)
+	return predicate.ObservationState(sql.FieldLTE(FieldRetention, vc))
+}
+
+// RetentionIsNil applies the IsNil predicate on the "retention" field.
+func RetentionIsNil() predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldIsNull(FieldRetention))
+}
+
+// RetentionNotNil applies the NotNil predicate on the "retention" field.
+func RetentionNotNil() predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNotNull(FieldRetention))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ObservationState {
+	return predicate.ObservationState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ObservationState) predicate.ObservationState {
+	return predicate.ObservationState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ObservationState) predicate.ObservationState {
+	return predicate.ObservationState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ObservationState) predicate.ObservationState {
+	return predicate.ObservationState(sql.NotPredicates(p))
+}
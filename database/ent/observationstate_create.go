@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+)
+
+// ObservationStateCreate is the builder for creating a ObservationState entity.
+type ObservationStateCreate struct {
+	config
+	mutation *ObservationStateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPaused sets the "paused" field.
+func (osc *ObservationStateCreate) SetPaused(b bool) *ObservationStateCreate {
+	osc.mutation.SetPaused(b)
+	return osc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (osc *ObservationStateCreate) SetNillablePaused(b *bool) *ObservationStateCreate {
+	if b != nil {
+		osc.SetPaused(*b)
+	}
+	return osc
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (osc *ObservationStateCreate) SetEmbeddingEnabled(b bool) *ObservationStateCreate {
+	osc.mutation.SetEmbeddingEnabled(b)
+	return osc
+}
+
+// SetNillableEmbeddingEnabled sets the "embedding_enabled" field if the given value is not nil.
+func (osc *ObservationStateCreate) SetNillableEmbeddingEnabled(b *bool) *ObservationStateCreate {
+	if b != nil {
+		osc.SetEmbeddingEnabled(*b)
+	}
+	return osc
+}
+
+// SetRetention sets the "retention" field.
+func (osc *ObservationStateCreate) SetRetention(t time.Duration) *ObservationStateCreate {
+	osc.mutation.SetRetention(t)
+	return osc
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (osc *ObservationStateCreate) SetNillableRetention(t *time.Duration) *ObservationStateCreate {
+	if t != nil {
+		osc.SetRetention(*t)
+	}
+	return osc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (osc *ObservationStateCreate) SetUpdatedAt(t time.Time) *ObservationStateCreate {
+	osc.mutation.SetUpdatedAt(t)
+	return osc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (osc *ObservationStateCreate) SetNillableUpdatedAt(t *time.Time) *ObservationStateCreate {
+	if t != nil {
+		osc.SetUpdatedAt(*t)
+	}
+	return osc
+}
+
+// SetID sets the "id" field.
+func (osc *ObservationStateCreate) SetID(i int64) *ObservationStateCreate {
+	osc.mutation.SetID(i)
+	return osc
+}
+
+// Mutation returns the ObservationStateMutation object of the builder.
+func (osc *ObservationStateCreate) Mutation() *ObservationStateMutation {
+	return osc.mutation
+}
+
+// Save creates the ObservationState in the database.
+func (osc *ObservationStateCreate) Save(ctx context.Context) (*ObservationState, error) {
+	osc.defaults()
+	return withHooks(ctx, osc.sqlSave, osc.mutation, osc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (osc *ObservationStateCreate) SaveX(ctx context.Context) *ObservationState {
+	v, err := osc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (osc *ObservationStateCreate) Exec(ctx context.Context) error {
+	_, err := osc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osc *ObservationStateCreate) ExecX(ctx context.Context) {
+	if err := osc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (osc *ObservationStateCreate) defaults() {
+	if _, ok := osc.mutation.Paused(); !ok {
+		v := observationstate.DefaultPaused
+		osc.mutation.SetPaused(v)
+	}
+	if _, ok := osc.mutation.EmbeddingEnabled(); !ok {
+		v := observationstate.DefaultEmbeddingEnabled
+		osc.mutation.SetEmbeddingEnabled(v)
+	}
+	if _, ok := osc.mutation.UpdatedAt(); !ok {
+		v := observationstate.DefaultUpdatedAt()
+		osc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (osc *ObservationStateCreate) check() error {
+	if _, ok := osc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "ObservationState.paused"`)}
+	}
+	if _, ok := osc.mutation.EmbeddingEnabled(); !ok {
+		return &ValidationError{Name: "embedding_enabled", err: errors.New(`ent: missing required field "ObservationState.embedding_enabled"`)}
+	}
+	if _, ok := osc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ObservationState.updated_at"`)}
+	}
+	return nil
+}
+
+func (osc *ObservationStateCreate) sqlSave(ctx context.Context) (*ObservationState, error) {
+	if err := osc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := osc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, osc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	osc.mutation.id = &_node.ID
+	osc.mutation.done = true
+	return _node, nil
+}
+
+func (osc *ObservationStateCreate) createSpec() (*ObservationState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ObservationState{config: osc.config}
+		_spec = sqlgraph.NewCreateSpec(observationstate.Table, sqlgraph.NewFieldSpec(observationstate.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = osc.conflict
+	if id, ok := osc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := osc.mutation.Paused(); ok {
+		_spec.SetField(observationstate.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := osc.mutation.EmbeddingEnabled(); ok {
+		_spec.SetField(observationstate.FieldEmbeddingEnabled, field.TypeBool, value)
+		_node.EmbeddingEnabled = value
+	}
+	if value, ok := osc.mutation.Retention(); ok {
+		_spec.SetField(observationstate.FieldRetention, field.TypeInt64, value)
+		_node.Retention = &value
+	}
+	if value, ok := osc.mutation.UpdatedAt(); ok {
+		_spec.SetField(observationstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObservationState.Create().
+//		SetPaused(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObservationStateUpsert) {
+//			SetPaused(v+v).
+//		}).
+//		Exec(ctx)
+func (osc *ObservationStateCreate) OnConflict(opts ...sql.ConflictOption) *ObservationStateUpsertOne {
+	osc.conflict = opts
+	return &ObservationStateUpsertOne{
+		create: osc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (osc *ObservationStateCreate) OnConflictColumns(columns ...string) *ObservationStateUpsertOne {
+	osc.conflict = append(osc.conflict, sql.ConflictColumns(columns...))
+	return &ObservationStateUpsertOne{
+		create: osc,
+	}
+}
+
+type (
+	// ObservationStateUpsertOne is the builder for "upsert"-ing
+	//  one ObservationState node.
+	ObservationStateUpsertOne struct {
+		create *ObservationStateCreate
+	}
+
+	// ObservationStateUpsert is the "OnConflict" setter.
+	ObservationStateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPaused sets the "paused" field.
+func (u *ObservationStateUpsert) SetPaused(v bool) *ObservationStateUpsert {
+	u.Set(observationstate.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ObservationStateUpsert) UpdatePaused() *ObservationStateUpsert {
+	u.SetExcluded(observationstate.FieldPaused)
+	return u
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (u *ObservationStateUpsert) SetEmbeddingEnabled(v bool) *ObservationStateUpsert {
+	u.Set(observationstate.FieldEmbeddingEnabled, v)
+	return u
+}
+
+// UpdateEmbeddingEnabled sets the "embedding_enabled" field to the value that was provided on create.
+func (u *ObservationStateUpsert) UpdateEmbeddingEnabled() *ObservationStateUpsert {
+	u.SetExcluded(observationstate.FieldEmbeddingEnabled)
+	return u
+}
+
+// SetRetention sets the "retention" field.
+func (u *ObservationStateUpsert) SetRetention(v time.Duration) *ObservationStateUpsert {
+	u.Set(observationstate.FieldRetention, v)
+	return u
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *ObservationStateUpsert) UpdateRetention() *ObservationStateUpsert {
+	u.SetExcluded(observationstate.FieldRetention)
+	return u
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *ObservationStateUpsert) AddRetention(v time.Duration) *ObservationStateUpsert {
+	u.Add(observationstate.FieldRetention, v)
+	return u
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (u *ObservationStateUpsert) ClearRetention() *ObservationStateUpsert {
+	u.SetNull(observationstate.FieldRetention)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ObservationStateUpsert) SetUpdatedAt(v time.Time) *ObservationStateUpsert {
+	u.Set(observationstate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ObservationStateUpsert) UpdateUpdatedAt() *ObservationStateUpsert {
+	u.SetExcluded(observationstate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(observationstate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ObservationStateUpsertOne) UpdateNewValues() *ObservationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(observationstate.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ObservationStateUpsertOne) Ignore() *ObservationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObservationStateUpsertOne) DoNothing() *ObservationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObservationStateCreate.OnConflict
+// documentation for more info.
+func (u *ObservationStateUpsertOne) Update(set func(*ObservationStateUpsert)) *ObservationStateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObservationStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *ObservationStateUpsertOne) SetPaused(v bool) *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ObservationStateUpsertOne) UpdatePaused() *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (u *ObservationStateUpsertOne) SetEmbeddingEnabled(v bool) *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetEmbeddingEnabled(v)
+	})
+}
+
+// UpdateEmbeddingEnabled sets the "embedding_enabled" field to the value that was provided on create.
+func (u *ObservationStateUpsertOne) UpdateEmbeddingEnabled() *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateEmbeddingEnabled()
+	})
+}
+
+// SetRetention sets the "retention" field.
+func (u *ObservationStateUpsertOne) SetRetention(v time.Duration) *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetRetention(v)
+	})
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *ObservationStateUpsertOne) AddRetention(v time.Duration) *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.AddRetention(v)
+	})
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *ObservationStateUpsertOne) UpdateRetention() *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateRetention()
+	})
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (u *ObservationStateUpsertOne) ClearRetention() *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.ClearRetention()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ObservationStateUpsertOne) SetUpdatedAt(v time.Time) *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ObservationStateUpsertOne) UpdateUpdatedAt() *ObservationStateUpsertOne {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ObservationStateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObservationStateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObservationStateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ObservationStateUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ObservationStateUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ObservationStateCreateBulk is the builder for creating many ObservationState entities in bulk.
+type ObservationStateCreateBulk struct {
+	config
+	err      error
+	builders []*ObservationStateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ObservationState entities in the database.
+func (oscb *ObservationStateCreateBulk) Save(ctx context.Context) ([]*ObservationState, error) {
+	if oscb.err != nil {
+		return nil, oscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oscb.builders))
+	nodes := make([]*ObservationState, len(oscb.builders))
+	mutators := make([]Mutator, len(oscb.builders))
+	for i := range oscb.builders {
+		func(i int, root context.Context) {
+			builder := oscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ObservationStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = oscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oscb *ObservationStateCreateBulk) SaveX(ctx context.Context) []*ObservationState {
+	v, err := oscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oscb *ObservationStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := oscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscb *ObservationStateCreateBulk) ExecX(ctx context.Context) {
+	if err := oscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ObservationState.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ObservationStateUpsert) {
+//			SetPaused(v+v).
+//		}).
+//		Exec(ctx)
+func (oscb *ObservationStateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ObservationStateUpsertBulk {
+	oscb.conflict = opts
+	return &ObservationStateUpsertBulk{
+		create: oscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oscb *ObservationStateCreateBulk) OnConflictColumns(columns ...string) *ObservationStateUpsertBulk {
+	oscb.conflict = append(oscb.conflict, sql.ConflictColumns(columns...))
+	return &ObservationStateUpsertBulk{
+		create: oscb,
+	}
+}
+
+// ObservationStateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ObservationState nodes.
+type ObservationStateUpsertBulk struct {
+	create *ObservationStateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(observationstate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ObservationStateUpsertBulk) UpdateNewValues() *ObservationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(observationstate.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ObservationState.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ObservationStateUpsertBulk) Ignore() *ObservationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ObservationStateUpsertBulk) DoNothing() *ObservationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ObservationStateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ObservationStateUpsertBulk) Update(set func(*ObservationStateUpsert)) *ObservationStateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ObservationStateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *ObservationStateUpsertBulk) SetPaused(v bool) *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ObservationStateUpsertBulk) UpdatePaused() *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (u *ObservationStateUpsertBulk) SetEmbeddingEnabled(v bool) *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetEmbeddingEnabled(v)
+	})
+}
+
+// UpdateEmbeddingEnabled sets the "embedding_enabled" field to the value that was provided on create.
+func (u *ObservationStateUpsertBulk) UpdateEmbeddingEnabled() *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateEmbeddingEnabled()
+	})
+}
+
+// SetRetention sets the "retention" field.
+func (u *ObservationStateUpsertBulk) SetRetention(v time.Duration) *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetRetention(v)
+	})
+}
+
+// AddRetention adds v to the "retention" field.
+func (u *ObservationStateUpsertBulk) AddRetention(v time.Duration) *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.AddRetention(v)
+	})
+}
+
+// UpdateRetention sets the "retention" field to the value that was provided on create.
+func (u *ObservationStateUpsertBulk) UpdateRetention() *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateRetention()
+	})
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (u *ObservationStateUpsertBulk) ClearRetention() *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.ClearRetention()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ObservationStateUpsertBulk) SetUpdatedAt(v time.Time) *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ObservationStateUpsertBulk) UpdateUpdatedAt() *ObservationStateUpsertBulk {
+	return u.Update(func(s *ObservationStateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ObservationStateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ObservationStateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ObservationStateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ObservationStateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ObservationStateDelete is the builder for deleting a ObservationState entity.
+type ObservationStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *ObservationStateMutation
+}
+
+// Where appends a list predicates to the ObservationStateDelete builder.
+func (osd *ObservationStateDelete) Where(ps ...predicate.ObservationState) *ObservationStateDelete {
+	osd.mutation.Where(ps...)
+	return osd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (osd *ObservationStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, osd.sqlExec, osd.mutation, osd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osd *ObservationStateDelete) ExecX(ctx context.Context) int {
+	n, err := osd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (osd *ObservationStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(observationstate.Table, sqlgraph.NewFieldSpec(observationstate.FieldID, field.TypeInt64))
+	if ps := osd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, osd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	osd.mutation.done = true
+	return affected, err
+}
+
+// ObservationStateDeleteOne is the builder for deleting a single ObservationState entity.
+type ObservationStateDeleteOne struct {
+	osd *ObservationStateDelete
+}
+
+// Where appends a list predicates to the ObservationStateDelete builder.
+func (osdo *ObservationStateDeleteOne) Where(ps ...predicate.ObservationState) *ObservationStateDeleteOne {
+	osdo.osd.mutation.Where(ps...)
+	return osdo
+}
+
+// Exec executes the deletion query.
+func (osdo *ObservationStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := osdo.osd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{observationstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osdo *ObservationStateDeleteOne) ExecX(ctx context.Context) {
+	if err := osdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
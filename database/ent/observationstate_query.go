@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ObservationStateQuery is the builder for querying ObservationState entities.
+type ObservationStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []observationstate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ObservationState
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ObservationStateQuery builder.
+func (osq *ObservationStateQuery) Where(ps ...predicate.ObservationState) *ObservationStateQuery {
+	osq.predicates = append(osq.predicates, ps...)
+	return osq
+}
+
+// Limit the number of records to be returned by this query.
+func (osq *ObservationStateQuery) Limit(limit int) *ObservationStateQuery {
+	osq.ctx.Limit = &limit
+	return osq
+}
+
+// Offset to start from.
+func (osq *ObservationStateQuery) Offset(offset int) *ObservationStateQuery {
+	osq.ctx.Offset = &offset
+	return osq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (osq *ObservationStateQuery) Unique(unique bool) *ObservationStateQuery {
+	osq.ctx.Unique = &unique
+	return osq
+}
+
+// Order specifies how the records should be ordered.
+func (osq *ObservationStateQuery) Order(o ...observationstate.OrderOption) *ObservationStateQuery {
+	osq.order = append(osq.order, o...)
+	return osq
+}
+
+// First returns the first ObservationState entity from the query.
+// Returns a *NotFoundError when no ObservationState was found.
+func (osq *ObservationStateQuery) First(ctx context.Context) (*ObservationState, error) {
+	nodes, err := osq.Limit(1).All(setContextOp(ctx, osq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{observationstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (osq *ObservationStateQuery) FirstX(ctx context.Context) *ObservationState {
+	node, err := osq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ObservationState ID from the query.
+// Returns a *NotFoundError when no ObservationState ID was found.
+func (osq *ObservationStateQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = osq.Limit(1).IDs(setContextOp(ctx, osq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{observationstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (osq *ObservationStateQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := osq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ObservationState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ObservationState entity is found.
+// Returns a *NotFoundError when no ObservationState entities are found.
+func (osq *ObservationStateQuery) Only(ctx context.Context) (*ObservationState, error) {
+	nodes, err := osq.Limit(2).All(setContextOp(ctx, osq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{observationstate.Label}
+	default:
+		return nil, &NotSingularError{observationstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (osq *ObservationStateQuery) OnlyX(ctx context.Context) *ObservationState {
+	node, err := osq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ObservationState ID in the query.
+// Returns a *NotSingularError when more than one ObservationState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (osq *ObservationStateQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = osq.Limit(2).IDs(setContextOp(ctx, osq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{observationstate.Label}
+	default:
+		err = &NotSingularError{observationstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (osq *ObservationStateQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := osq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ObservationStates.
+func (osq *ObservationStateQuery) All(ctx context.Context) ([]*ObservationState, error) {
+	ctx = setContextOp(ctx, osq.ctx, ent.OpQueryAll)
+	if err := osq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ObservationState, *ObservationStateQuery]()
+	return withInterceptors[[]*ObservationState](ctx, osq, qr, osq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (osq *ObservationStateQuery) AllX(ctx context.Context) []*ObservationState {
+	nodes, err := osq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ObservationState IDs.
+func (osq *ObservationStateQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if osq.ctx.Unique == nil && osq.path != nil {
+		osq.Unique(true)
+	}
+	ctx = setContextOp(ctx, osq.ctx, ent.OpQueryIDs)
+	if err = osq.Select(observationstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (osq *ObservationStateQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := osq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (osq *ObservationStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, osq.ctx, ent.OpQueryCount)
+	if err := osq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, osq, querierCount[*ObservationStateQuery](), osq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (osq *ObservationStateQuery) CountX(ctx context.Context) int {
+	count, err := osq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (osq *ObservationStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, osq.ctx, ent.OpQueryExist)
+	switch _, err := osq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (osq *ObservationStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := osq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ObservationStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (osq *ObservationStateQuery) Clone() *ObservationStateQuery {
+	if osq == nil {
+		return nil
+	}
+	return &ObservationStateQuery{
+		config:     osq.config,
+		ctx:        osq.ctx.Clone(),
+		order:      append([]observationstate.OrderOption{}, osq.order...),
+		inters:     append([]Interceptor{}, osq.inters...),
+		predicates: append([]predicate.ObservationState{}, osq.predicates...),
+		// clone intermediate query.
+		sql:       osq.sql.Clone(),
+		path:      osq.path,
+		modifiers: append([]func(*sql.Selector){}, osq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Paused bool `json:"paused,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ObservationState.Query().
+//		GroupBy(observationstate.FieldPaused).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (osq *ObservationStateQuery) GroupBy(field string, fields ...string) *ObservationStateGroupBy {
+	osq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ObservationStateGroupBy{build: osq}
+	grbuild.flds = &osq.ctx.Fields
+	grbuild.label = observationstate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Paused bool `json:"paused,omitempty"`
+//	}
+//
+//	client.ObservationState.Query().
+//		Select(observationstate.FieldPaused).
+//		Scan(ctx, &v)
+func (osq *ObservationStateQuery) Select(fields ...string) *ObservationStateSelect {
+	osq.ctx.Fields = append(osq.ctx.Fields, fields...)
+	sbuild := &ObservationStateSelect{ObservationStateQuery: osq}
+	sbuild.label = observationstate.Label
+	sbuild.flds, sbuild.scan = &osq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ObservationStateSelect configured with the given aggregations.
+func (osq *ObservationStateQuery) Aggregate(fns ...AggregateFunc) *ObservationStateSelect {
+	return osq.Select().Aggregate(fns...)
+}
+
+func (osq *ObservationStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range osq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, osq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range osq.ctx.Fields {
+		if !observationstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if osq.path != nil {
+		prev, err := osq.path(ctx)
+		if err != nil {
+			return err
+		}
+		osq.sql = prev
+	}
+	return nil
+}
+
+func (osq *ObservationStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ObservationState, error) {
+	var (
+		nodes = []*ObservationState{}
+		_spec = osq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ObservationState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ObservationState{config: osq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(osq.modifiers) > 0 {
+		_spec.Modifiers = osq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, osq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (osq *ObservationStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := osq.querySpec()
+	if len(osq.modifiers) > 0 {
+		_spec.Modifiers = osq.modifiers
+	}
+	_spec.Node.Columns = osq.ctx.Fields
+	if len(osq.ctx.Fields) > 0 {
+		_spec.Unique = osq.ctx.Unique != nil && *osq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, osq.driver, _spec)
+}
+
+func (osq *ObservationStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(observationstate.Table, observationstate.Columns, sqlgraph.NewFieldSpec(observationstate.FieldID, field.TypeInt64))
+	_spec.From = osq.sql
+	if unique := osq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if osq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := osq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, observationstate.FieldID)
+		for i := range fields {
+			if fields[i] != observationstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := osq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := osq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := osq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := osq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (osq *ObservationStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(osq.driver.Dialect())
+	t1 := builder.Table(observationstate.Table)
+	columns := osq.ctx.Fields
+	if len(columns) == 0 {
+		columns = observationstate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if osq.sql != nil {
+		selector = osq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if osq.ctx.Unique != nil && *osq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range osq.modifiers {
+		m(selector)
+	}
+	for _, p := range osq.predicates {
+		p(selector)
+	}
+	for _, p := range osq.order {
+		p(selector)
+	}
+	if offset := osq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := osq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (osq *ObservationStateQuery) Modify(modifiers ...func(s *sql.Selector)) *ObservationStateSelect {
+	osq.modifiers = append(osq.modifiers, modifiers...)
+	return osq.Select()
+}
+
+// ObservationStateGroupBy is the group-by builder for ObservationState entities.
+type ObservationStateGroupBy struct {
+	selector
+	build *ObservationStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (osgb *ObservationStateGroupBy) Aggregate(fns ...AggregateFunc) *ObservationStateGroupBy {
+	osgb.fns = append(osgb.fns, fns...)
+	return osgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (osgb *ObservationStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, osgb.build.ctx, ent.OpQueryGroupBy)
+	if err := osgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObservationStateQuery, *ObservationStateGroupBy](ctx, osgb.build, osgb, osgb.build.inters, v)
+}
+
+func (osgb *ObservationStateGroupBy) sqlScan(ctx context.Context, root *ObservationStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(osgb.fns))
+	for _, fn := range osgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*osgb.flds)+len(osgb.fns))
+		for _, f := range *osgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*osgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := osgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ObservationStateSelect is the builder for selecting fields of ObservationState entities.
+type ObservationStateSelect struct {
+	*ObservationStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oss *ObservationStateSelect) Aggregate(fns ...AggregateFunc) *ObservationStateSelect {
+	oss.fns = append(oss.fns, fns...)
+	return oss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oss *ObservationStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oss.ctx, ent.OpQuerySelect)
+	if err := oss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ObservationStateQuery, *ObservationStateSelect](ctx, oss.ObservationStateQuery, oss, oss.inters, v)
+}
+
+func (oss *ObservationStateSelect) sqlScan(ctx context.Context, root *ObservationStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oss.fns))
+	for _, fn := range oss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oss *ObservationStateSelect) Modify(modifiers ...func(s *sql.Selector)) *ObservationStateSelect {
+	oss.modifiers = append(oss.modifiers, modifiers...)
+	return oss
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+)
+
+// ObservationStateUpdate is the builder for updating ObservationState entities.
+type ObservationStateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ObservationStateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ObservationStateUpdate builder.
+func (osu *ObservationStateUpdate) Where(ps ...predicate.ObservationState) *ObservationStateUpdate {
+	osu.mutation.Where(ps...)
+	return osu
+}
+
+// SetPaused sets the "paused" field.
+func (osu *ObservationStateUpdate) SetPaused(b bool) *ObservationStateUpdate {
+	osu.mutation.SetPaused(b)
+	return osu
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (osu *ObservationStateUpdate) SetNillablePaused(b *bool) *ObservationStateUpdate {
+	if b != nil {
+		osu.SetPaused(*b)
+	}
+	return osu
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (osu *ObservationStateUpdate) SetEmbeddingEnabled(b bool) *ObservationStateUpdate {
+	osu.mutation.SetEmbeddingEnabled(b)
+	return osu
+}
+
+// SetNillableEmbeddingEnabled sets the "embedding_enabled" field if the given value is not nil.
+func (osu *ObservationStateUpdate) SetNillableEmbeddingEnabled(b *bool) *ObservationStateUpdate {
+	if b != nil {
+		osu.SetEmbeddingEnabled(*b)
+	}
+	return osu
+}
+
+// SetRetention sets the "retention" field.
+func (osu *ObservationStateUpdate) SetRetention(t time.Duration) *ObservationStateUpdate {
+	osu.mutation.ResetRetention()
+	osu.mutation.SetRetention(t)
+	return osu
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (osu *ObservationStateUpdate) SetNillableRetention(t *time.Duration) *ObservationStateUpdate {
+	if t != nil {
+		osu.SetRetention(*t)
+	}
+	return osu
+}
+
+// AddRetention adds t to the "retention" field.
+func (osu *ObservationStateUpdate) AddRetention(t time.Duration) *ObservationStateUpdate {
+	osu.mutation.AddRetention(t)
+	return osu
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (osu *ObservationStateUpdate) ClearRetention() *ObservationStateUpdate {
+	osu.mutation.ClearRetention()
+	return osu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (osu *ObservationStateUpdate) SetUpdatedAt(t time.Time) *ObservationStateUpdate {
+	osu.mutation.SetUpdatedAt(t)
+	return osu
+}
+
+// Mutation returns the ObservationStateMutation object of the builder.
+func (osu *ObservationStateUpdate) Mutation() *ObservationStateMutation {
+	return osu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (osu *ObservationStateUpdate) Save(ctx context.Context) (int, error) {
+	osu.defaults()
+	return withHooks(ctx, osu.sqlSave, osu.mutation, osu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osu *ObservationStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := osu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (osu *ObservationStateUpdate) Exec(ctx context.Context) error {
+	_, err := osu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osu *ObservationStateUpdate) ExecX(ctx context.Context) {
+	if err := osu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (osu *ObservationStateUpdate) defaults() {
+	if _, ok := osu.mutation.UpdatedAt(); !ok {
+		v := observationstate.UpdateDefaultUpdatedAt()
+		osu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (osu *ObservationStateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ObservationStateUpdate {
+	osu.modifiers = append(osu.modifiers, modifiers...)
+	return osu
+}
+
+func (osu *ObservationStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(observationstate.Table, observationstate.Columns, sqlgraph.NewFieldSpec(observationstate.FieldID, field.TypeInt64))
+	if ps := osu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := osu.mutation.Paused(); ok {
+		_spec.SetField(observationstate.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := osu.mutation.EmbeddingEnabled(); ok {
+		_spec.SetField(observationstate.FieldEmbeddingEnabled, field.TypeBool, value)
+	}
+	if value, ok := osu.mutation.Retention(); ok {
+		_spec.SetField(observationstate.FieldRetention, field.TypeInt64, value)
+	}
+	if value, ok := osu.mutation.AddedRetention(); ok {
+		_spec.AddField(observationstate.FieldRetention, field.TypeInt64, value)
+	}
+	if osu.mutation.RetentionCleared() {
+		_spec.ClearField(observationstate.FieldRetention, field.TypeInt64)
+	}
+	if value, ok := osu.mutation.UpdatedAt(); ok {
+		_spec.SetField(observationstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(osu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, osu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{observationstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	osu.mutation.done = true
+	return n, nil
+}
+
+// ObservationStateUpdateOne is the builder for updating a single ObservationState entity.
+type ObservationStateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ObservationStateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPaused sets the "paused" field.
+func (osuo *ObservationStateUpdateOne) SetPaused(b bool) *ObservationStateUpdateOne {
+	osuo.mutation.SetPaused(b)
+	return osuo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (osuo *ObservationStateUpdateOne) SetNillablePaused(b *bool) *ObservationStateUpdateOne {
+	if b != nil {
+		osuo.SetPaused(*b)
+	}
+	return osuo
+}
+
+// SetEmbeddingEnabled sets the "embedding_enabled" field.
+func (osuo *ObservationStateUpdateOne) SetEmbeddingEnabled(b bool) *ObservationStateUpdateOne {
+	osuo.mutation.SetEmbeddingEnabled(b)
+	return osuo
+}
+
+// SetNillableEmbeddingEnabled sets the "embedding_enabled" field if the given value is not nil.
+func (osuo *ObservationStateUpdateOne) SetNillableEmbeddingEnabled(b *bool) *ObservationStateUpdateOne {
+	if b != nil {
+		osuo.SetEmbeddingEnabled(*b)
+	}
+	return osuo
+}
+
+// SetRetention sets the "retention" field.
+func (osuo *ObservationStateUpdateOne) SetRetention(t time.Duration) *ObservationStateUpdateOne {
+	osuo.mutation.ResetRetention()
+	osuo.mutation.SetRetention(t)
+	return osuo
+}
+
+// SetNillableRetention sets the "retention" field if the given value is not nil.
+func (osuo *ObservationStateUpdateOne) SetNillableRetention(t *time.Duration) *ObservationStateUpdateOne {
+	if t != nil {
+		osuo.SetRetention(*t)
+	}
+	return osuo
+}
+
+// AddRetention adds t to the "retention" field.
+func (osuo *ObservationStateUpdateOne) AddRetention(t time.Duration) *ObservationStateUpdateOne {
+	osuo.mutation.AddRetention(t)
+	return osuo
+}
+
+// ClearRetention clears the value of the "retention" field.
+func (osuo *ObservationStateUpdateOne) ClearRetention() *ObservationStateUpdateOne {
+	osuo.mutation.ClearRetention()
+	return osuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (osuo *ObservationStateUpdateOne) SetUpdatedAt(t time.Time) *ObservationStateUpdateOne {
+	osuo.mutation.SetUpdatedAt(t)
+	return osuo
+}
+
+// Mutation returns the ObservationStateMutation object of the builder.
+func (osuo *ObservationStateUpdateOne) Mutation() *ObservationStateMutation {
+	return osuo.mutation
+}
+
+// Where appends a list predicates to the ObservationStateUpdate builder.
+func (osuo *ObservationStateUpdateOne) Where(ps ...predicate.ObservationState) *ObservationStateUpdateOne {
+	osuo.mutation.Where(ps...)
+	return osuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (osuo *ObservationStateUpdateOne) Select(field string, fields ...string) *ObservationStateUpdateOne {
+	osuo.fields = append([]string{field}, fields...)
+	return osuo
+}
+
+// Save executes the query and returns the updated ObservationState entity.
+func (osuo *ObservationStateUpdateOne) Save(ctx context.Context) (*ObservationState, error) {
+	osuo.defaults()
+	return withHooks(ctx, osuo.sqlSave, osuo.mutation, osuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osuo *ObservationStateUpdateOne) SaveX(ctx context.Context) *ObservationState {
+	node, err := osuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (osuo *ObservationStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := osuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osuo *ObservationStateUpdateOne) ExecX(ctx context.Context) {
+	if err := osuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (osuo *ObservationStateUpdateOne) defaults() {
+	if _, ok := osuo.mutation.UpdatedAt(); !ok {
+		v := observationstate.UpdateDefaultUpdatedAt()
+		osuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (osuo *ObservationStateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ObservationStateUpdateOne {
+	osuo.modifiers = append(osuo.modifiers, modifiers...)
+	return osuo
+}
+
+func (osuo *ObservationStateUpdateOne) sqlSave(ctx context.Context) (_node *ObservationState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(observationstate.Table, observationstate.Columns, sqlgraph.NewFieldSpec(observationstate.FieldID, field.TypeInt64))
+	id, ok := osuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ObservationState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := osuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, observationstate.FieldID)
+		for _, f := range fields {
+			if !observationstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != observationstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := osuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := osuo.mutation.Paused(); ok {
+		_spec.SetField(observationstate.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := osuo.mutation.EmbeddingEnabled(); ok {
+		_spec.SetField(observationstate.FieldEmbeddingEnabled, field.TypeBool, value)
+	}
+	if value, ok := osuo.mutation.Retention(); ok {
+		_spec.SetField(observationstate.FieldRetention, field.TypeInt64, value)
+	}
+	if value, ok := osuo.mutation.AddedRetention(); ok {
+		_spec.AddField(observationstate.FieldRetention, field.TypeInt64, value)
+	}
+	if osuo.mutation.RetentionCleared() {
+		_spec.ClearField(observationstate.FieldRetention, field.TypeInt64)
+	}
+	if value, ok := osuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(observationstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(osuo.modifiers...)
+	_node = &ObservationState{config: osuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, osuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{observationstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	osuo.mutation.done = true
+	return _node, nil
+}
@@ -9,5 +9,32 @@ import (
 // Dialog is the predicate function for dialog builders.
 type Dialog func(*sql.Selector)
 
+// EmbeddingCache is the predicate function for embeddingcache builders.
+type EmbeddingCache func(*sql.Selector)
+
+// EmbeddingSpend is the predicate function for embeddingspend builders.
+type EmbeddingSpend func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
+
+// MessageChunk is the predicate function for messagechunk builders.
+type MessageChunk func(*sql.Selector)
+
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
+// NextEmbedding is the predicate function for nextembedding builders.
+type NextEmbedding func(*sql.Selector)
+
+// NextEmbeddingFailure is the predicate function for nextembeddingfailure builders.
+type NextEmbeddingFailure func(*sql.Selector)
+
+// ObservationState is the predicate function for observationstate builders.
+type ObservationState func(*sql.Selector)
+
+// Sender is the predicate function for sender builders.
+type Sender func(*sql.Selector)
+
+// Setting is the predicate function for setting builders.
+type Setting func(*sql.Selector)
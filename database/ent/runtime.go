@@ -7,8 +7,17 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/xyenon/telemikiya/database/ent/dialog"
+	"github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"github.com/xyenon/telemikiya/database/ent/embeddingspend"
 	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/messagechunk"
+	"github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/nextembedding"
+	"github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/observationstate"
 	"github.com/xyenon/telemikiya/database/ent/schema"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/database/ent/setting"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -21,10 +30,114 @@ func init() {
 	dialogDescUpdatedAt := dialogFields[3].Descriptor()
 	// dialog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	dialog.DefaultUpdatedAt = dialogDescUpdatedAt.Default.(func() time.Time)
+	embeddingcacheFields := schema.EmbeddingCache{}.Fields()
+	_ = embeddingcacheFields
+	// embeddingcacheDescHits is the schema descriptor for hits field.
+	embeddingcacheDescHits := embeddingcacheFields[4].Descriptor()
+	// embeddingcache.DefaultHits holds the default value on creation for the hits field.
+	embeddingcache.DefaultHits = embeddingcacheDescHits.Default.(int)
+	// embeddingcacheDescCreatedAt is the schema descriptor for created_at field.
+	embeddingcacheDescCreatedAt := embeddingcacheFields[5].Descriptor()
+	// embeddingcache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	embeddingcache.DefaultCreatedAt = embeddingcacheDescCreatedAt.Default.(func() time.Time)
+	// embeddingcacheDescID is the schema descriptor for id field.
+	embeddingcacheDescID := embeddingcacheFields[0].Descriptor()
+	// embeddingcache.DefaultID holds the default value on creation for the id field.
+	embeddingcache.DefaultID = embeddingcacheDescID.Default.(func() uuid.UUID)
+	embeddingspendFields := schema.EmbeddingSpend{}.Fields()
+	_ = embeddingspendFields
+	// embeddingspendDescSpent is the schema descriptor for spent field.
+	embeddingspendDescSpent := embeddingspendFields[3].Descriptor()
+	// embeddingspend.DefaultSpent holds the default value on creation for the spent field.
+	embeddingspend.DefaultSpent = embeddingspendDescSpent.Default.(float64)
+	// embeddingspendDescUpdatedAt is the schema descriptor for updated_at field.
+	embeddingspendDescUpdatedAt := embeddingspendFields[4].Descriptor()
+	// embeddingspend.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	embeddingspend.DefaultUpdatedAt = embeddingspendDescUpdatedAt.Default.(func() time.Time)
+	// embeddingspend.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	embeddingspend.UpdateDefaultUpdatedAt = embeddingspendDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// embeddingspendDescID is the schema descriptor for id field.
+	embeddingspendDescID := embeddingspendFields[0].Descriptor()
+	// embeddingspend.DefaultID holds the default value on creation for the id field.
+	embeddingspend.DefaultID = embeddingspendDescID.Default.(func() uuid.UUID)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
+	// messageDescDerivedText is the schema descriptor for derived_text field.
+	messageDescDerivedText := messageFields[13].Descriptor()
+	// message.DefaultDerivedText holds the default value on creation for the derived_text field.
+	message.DefaultDerivedText = messageDescDerivedText.Default.(string)
+	// messageDescEmbeddingAttempts is the schema descriptor for embedding_attempts field.
+	messageDescEmbeddingAttempts := messageFields[18].Descriptor()
+	// message.DefaultEmbeddingAttempts holds the default value on creation for the embedding_attempts field.
+	message.DefaultEmbeddingAttempts = messageDescEmbeddingAttempts.Default.(int)
+	// messageDescEmbeddingSkipped is the schema descriptor for embedding_skipped field.
+	messageDescEmbeddingSkipped := messageFields[22].Descriptor()
+	// message.DefaultEmbeddingSkipped holds the default value on creation for the embedding_skipped field.
+	message.DefaultEmbeddingSkipped = messageDescEmbeddingSkipped.Default.(bool)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() uuid.UUID)
+	messagechunkFields := schema.MessageChunk{}.Fields()
+	_ = messagechunkFields
+	// messagechunkDescID is the schema descriptor for id field.
+	messagechunkDescID := messagechunkFields[0].Descriptor()
+	// messagechunk.DefaultID holds the default value on creation for the id field.
+	messagechunk.DefaultID = messagechunkDescID.Default.(func() uuid.UUID)
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionFields[4].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionFields[0].Descriptor()
+	// messagerevision.DefaultID holds the default value on creation for the id field.
+	messagerevision.DefaultID = messagerevisionDescID.Default.(func() uuid.UUID)
+	nextembeddingFields := schema.NextEmbedding{}.Fields()
+	_ = nextembeddingFields
+	// nextembeddingDescID is the schema descriptor for id field.
+	nextembeddingDescID := nextembeddingFields[0].Descriptor()
+	// nextembedding.DefaultID holds the default value on creation for the id field.
+	nextembedding.DefaultID = nextembeddingDescID.Default.(func() uuid.UUID)
+	nextembeddingfailureFields := schema.NextEmbeddingFailure{}.Fields()
+	_ = nextembeddingfailureFields
+	// nextembeddingfailureDescAttempts is the schema descriptor for attempts field.
+	nextembeddingfailureDescAttempts := nextembeddingfailureFields[3].Descriptor()
+	// nextembeddingfailure.DefaultAttempts holds the default value on creation for the attempts field.
+	nextembeddingfailure.DefaultAttempts = nextembeddingfailureDescAttempts.Default.(int)
+	// nextembeddingfailureDescID is the schema descriptor for id field.
+	nextembeddingfailureDescID := nextembeddingfailureFields[0].Descriptor()
+	// nextembeddingfailure.DefaultID holds the default value on creation for the id field.
+	nextembeddingfailure.DefaultID = nextembeddingfailureDescID.Default.(func() uuid.UUID)
+	observationstateFields := schema.ObservationState{}.Fields()
+	_ = observationstateFields
+	// observationstateDescPaused is the schema descriptor for paused field.
+	observationstateDescPaused := observationstateFields[1].Descriptor()
+	// observationstate.DefaultPaused holds the default value on creation for the paused field.
+	observationstate.DefaultPaused = observationstateDescPaused.Default.(bool)
+	// observationstateDescEmbeddingEnabled is the schema descriptor for embedding_enabled field.
+	observationstateDescEmbeddingEnabled := observationstateFields[2].Descriptor()
+	// observationstate.DefaultEmbeddingEnabled holds the default value on creation for the embedding_enabled field.
+	observationstate.DefaultEmbeddingEnabled = observationstateDescEmbeddingEnabled.Default.(bool)
+	// observationstateDescUpdatedAt is the schema descriptor for updated_at field.
+	observationstateDescUpdatedAt := observationstateFields[4].Descriptor()
+	// observationstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	observationstate.DefaultUpdatedAt = observationstateDescUpdatedAt.Default.(func() time.Time)
+	// observationstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	observationstate.UpdateDefaultUpdatedAt = observationstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	senderFields := schema.Sender{}.Fields()
+	_ = senderFields
+	// senderDescUpdatedAt is the schema descriptor for updated_at field.
+	senderDescUpdatedAt := senderFields[3].Descriptor()
+	// sender.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sender.DefaultUpdatedAt = senderDescUpdatedAt.Default.(func() time.Time)
+	settingFields := schema.Setting{}.Fields()
+	_ = settingFields
+	// settingDescUpdatedAt is the schema descriptor for updated_at field.
+	settingDescUpdatedAt := settingFields[2].Descriptor()
+	// setting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
+	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -21,6 +21,16 @@ func (Dialog) Fields() []ent.Field {
 		field.String("title"),
 		field.Enum("type").GoType(types.DialogType("")),
 		field.Time("updated_at").Default(time.Now),
+		// backfill_offset_id is the oldest message ID fetched so far by the
+		// history backfill, used to resume interrupted runs.
+		field.Int("backfill_offset_id").Optional().Nillable(),
+		field.Time("backfill_completed_at").Optional().Nillable(),
+		// last_msg_id is the newest message ID recorded without gaps before it,
+		// used to catch up on messages missed while the observer was down.
+		field.Int("last_msg_id").Optional().Nillable(),
+		// migrated_to_id is the dialog ID of the supergroup a basic group was
+		// upgraded to. Both dialogs make up the same conversation.
+		field.Int64("migrated_to_id").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+// EmbeddingCache holds the schema definition for the EmbeddingCache entity.
+// It keeps the embeddings of texts by the hash of their normalized text, so
+// that identical texts are only embedded once per model.
+type EmbeddingCache struct {
+	ent.Schema
+}
+
+// Fields of the EmbeddingCache.
+func (EmbeddingCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		// hash is the SHA-256 hash of the normalized text.
+		field.Bytes("hash"),
+		// model identifies the provider, model and dimensions of the embedding.
+		field.String("model"),
+		// embedding has no fixed dimensions, as it is only looked up by hash.
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector"}),
+		// hits is the number of times the embedding was taken from the cache.
+		field.Int("hits").Default(0),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Indexes of the EmbeddingCache.
+func (EmbeddingCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model", "hash").Unique(),
+	}
+}
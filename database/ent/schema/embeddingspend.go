@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmbeddingSpend holds the schema definition for the EmbeddingSpend entity.
+// It is the estimated spending on an embedding provider during a UTC day,
+// which is kept across restarts so that the daily spend cap holds.
+type EmbeddingSpend struct {
+	ent.Schema
+}
+
+// Fields of the EmbeddingSpend.
+func (EmbeddingSpend) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		// day is the UTC day the spending is counted for.
+		field.Time("day").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		// provider identifies the provider by its type, model and base URL.
+		field.String("provider"),
+		field.Float("spent").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EmbeddingSpend.
+func (EmbeddingSpend) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("day", "provider").Unique(),
+	}
+}
+
+// Edges of the EmbeddingSpend.
+func (EmbeddingSpend) Edges() []ent.Edge {
+	return nil
+}
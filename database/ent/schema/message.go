@@ -23,14 +23,50 @@ func (Message) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.Int("msg_id"),
 		field.Int64("dialog_id"),
+		field.Int64("sender_id").Optional().Nillable(),
+		field.Int("reply_to_msg_id").Optional().Nillable(),
+		field.Int("top_msg_id").Optional().Nillable(),
+		field.Int("topic_id").Optional().Nillable(),
+		// fwd_* fields describe the origin of a forwarded message.
+		field.Int64("fwd_from_id").Optional().Nillable(),
+		field.String("fwd_from_name").Optional().Nillable(),
+		field.Int("fwd_from_msg_id").Optional().Nillable(),
+		field.Time("fwd_from_date").Optional().Nillable(),
+		field.String("fwd_post_author").Optional().Nillable(),
 		field.String("text").
 			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// derived_text is searchable text extracted from media, such as link
+		// previews, polls and venues. It is indexed alongside text.
+		field.String("derived_text").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Default(""),
 		field.Other("text_embedding", pgvector.Vector{}).
 			SchemaType(map[string]string{dialect.Postgres: "vector(%d)"}).
 			Optional(),
+		// embedding_strategy is how the text embedded in text_embedding was built.
+		field.Enum("embedding_strategy").GoType(types.EmbeddingStrategy("")).Optional().Nillable(),
+		// embedding_model identifies the provider, model and dimensions that
+		// produced text_embedding.
+		field.String("embedding_model").Optional().Nillable(),
+		// next_embedding_model is set once the message is re-embedded with the
+		// next model, whose embeddings are kept in next_embeddings.
+		field.String("next_embedding_model").Optional().Nillable(),
+		// the following embedding_* fields track failed attempts to embed the
+		// message, which are reset whenever its embedding is cleared.
+		field.Int("embedding_attempts").Default(0),
+		field.String("embedding_error").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Optional().Nillable(),
+		field.Time("embedding_retry_at").Optional().Nillable(),
+		field.Time("embedding_failed_at").Optional().Nillable(),
+		// embedding_skipped is set for messages not worth embedding, such as
+		// ones without text or with only emoji. They are still full-text searchable.
+		field.Bool("embedding_skipped").Default(false),
 		field.Bool("has_media"),
 		field.JSON("media_info", &types.MediaInfo{}),
 		field.Time("sent_at"),
+		field.Time("edited_at").Optional().Nillable(),
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -38,13 +74,21 @@ func (Message) Fields() []ent.Field {
 func (Message) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("msg_id", "dialog_id").Unique(),
+		// pages through the messages of each dialog in order
+		index.Fields("dialog_id", "msg_id"),
 		index.Fields("text").Annotations(entsql.IndexType("pgroonga")),
+		index.Fields("derived_text").Annotations(entsql.IndexType("pgroonga")),
 		index.Fields("text_embedding").
 			Annotations(
 				entsql.IndexType("vchordrq"),
 				entsql.OpClass("vector_cosine_ops"),
 			),
 		index.Fields("sent_at"),
+		index.Fields("deleted_at"),
+		index.Fields("sender_id"),
+		index.Fields("dialog_id", "reply_to_msg_id"),
+		index.Fields("dialog_id", "topic_id"),
+		index.Fields("fwd_from_id", "fwd_from_msg_id"),
 	}
 }
 
@@ -52,5 +96,14 @@ func (Message) Indexes() []ent.Index {
 func (Message) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("dialog", Dialog.Type).Ref("messages").Field("dialog_id").Unique().Required(),
+		edge.From("sender", Sender.Type).Ref("messages").Field("sender_id").Unique(),
+		edge.To("revisions", MessageRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chunks", MessageChunk.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("next_embeddings", NextEmbedding.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("next_embedding_failures", NextEmbeddingFailure.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
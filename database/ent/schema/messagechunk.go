@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+// MessageChunk holds the schema definition for the MessageChunk entity.
+// Messages too long to be embedded at once are split into overlapping chunks,
+// which are embedded separately.
+type MessageChunk struct {
+	ent.Schema
+}
+
+// Fields of the MessageChunk.
+func (MessageChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("message_id", uuid.UUID{}),
+		// index is the position of the chunk in the message, starting from 0.
+		field.Int("index"),
+		field.String("text").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Other("text_embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector(%d)"}),
+	}
+}
+
+// Indexes of the MessageChunk.
+func (MessageChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "index").Unique(),
+		index.Fields("text_embedding").
+			Annotations(
+				entsql.IndexType("vchordrq"),
+				entsql.OpClass("vector_cosine_ops"),
+			),
+	}
+}
+
+// Edges of the MessageChunk.
+func (MessageChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("chunks").Field("message_id").Unique().Required(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageRevision holds the schema definition for the MessageRevision entity.
+// A revision keeps the text a message had before it was edited.
+type MessageRevision struct {
+	ent.Schema
+}
+
+// Fields of the MessageRevision.
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("message_id", uuid.UUID{}),
+		field.String("text").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("edited_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Indexes of the MessageRevision.
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id"),
+		index.Fields("text").Annotations(entsql.IndexType("pgroonga")),
+	}
+}
+
+// Edges of the MessageRevision.
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("revisions").Field("message_id").Unique().Required(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// NextEmbedding holds the schema definition for the NextEmbedding entity.
+// Messages are re-embedded with the next model in the background, while
+// search keeps using their current embeddings until switching over.
+type NextEmbedding struct {
+	ent.Schema
+}
+
+// Fields of the NextEmbedding.
+func (NextEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("message_id", uuid.UUID{}),
+		// index is the position of the chunk in the message, starting from 0.
+		// Messages that are not chunked have a single embedding.
+		field.Int("index"),
+		field.String("text").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// embedding has no fixed dimensions, as they may differ from the
+		// current model, and it is not searched.
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{dialect.Postgres: "vector"}),
+		field.Enum("strategy").GoType(types.EmbeddingStrategy("")),
+		// model identifies the provider, model and dimensions of the embedding.
+		field.String("model"),
+	}
+}
+
+// Indexes of the NextEmbedding.
+func (NextEmbedding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "index").Unique(),
+	}
+}
+
+// Edges of the NextEmbedding.
+func (NextEmbedding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("next_embeddings").Field("message_id").Unique().Required(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// NextEmbeddingFailure holds the schema definition for the NextEmbeddingFailure
+// entity. It is the failed attempts to re-embed a message with the next model,
+// which are kept apart from the ones of the current model, as both are
+// embedded alongside.
+type NextEmbeddingFailure struct {
+	ent.Schema
+}
+
+// Fields of the NextEmbeddingFailure.
+func (NextEmbeddingFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("message_id", uuid.UUID{}),
+		// model identifies the provider, model and dimensions of the next model.
+		field.String("model"),
+		field.Int("attempts").Default(0),
+		field.String("error").
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// retry_at is when the message is retried, unless it failed for good.
+		field.Time("retry_at").Optional().Nillable(),
+		// failed_at is when the message ran out of attempts.
+		field.Time("failed_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the NextEmbeddingFailure.
+func (NextEmbeddingFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "model").Unique(),
+	}
+}
+
+// Edges of the NextEmbeddingFailure.
+func (NextEmbeddingFailure) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("next_embedding_failures").Field("message_id").Unique().Required(),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ObservationState holds the schema definition for the ObservationState entity.
+// It is the observation state of a dialog that is managed at runtime, keyed by
+// the dialog ID, so that it can be set before any message of the dialog is recorded.
+type ObservationState struct {
+	ent.Schema
+}
+
+// Fields of the ObservationState.
+func (ObservationState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Bool("paused").Default(false),
+		field.Bool("embedding_enabled").Default(true),
+		// retention is how long messages of the dialog are kept, forever if not set.
+		field.Int64("retention").GoType(time.Duration(0)).Optional().Nillable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ObservationState.
+func (ObservationState) Edges() []ent.Edge {
+	return nil
+}
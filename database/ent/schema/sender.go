@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// Sender holds the schema definition for the Sender entity.
+// A sender is the user or channel a message was sent from.
+type Sender struct {
+	ent.Schema
+}
+
+// Fields of the Sender.
+func (Sender) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name"),
+		field.Enum("type").GoType(types.DialogType("")),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Sender.
+func (Sender) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("messages", Message.Type),
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Setting holds the schema definition for the Setting entity.
+// It is a setting the database was last set up with, keyed by its name, such
+// as the embedding model the messages are embedded with.
+type Setting struct {
+	ent.Schema
+}
+
+// Fields of the Setting.
+func (Setting) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("value"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Setting.
+func (Setting) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// Sender is the model entity for the Sender schema.
+type Sender struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type types.DialogType `json:"type,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SenderQuery when eager-loading is set.
+	Edges        SenderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SenderEdges holds the relations/edges for other nodes in the graph.
+type SenderEdges struct {
+	// Messages holds the value of the messages edge.
+	Messages []*Message `json:"messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[0] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sender) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sender.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sender.FieldName, sender.FieldType:
+			values[i] = new(sql.NullString)
+		case sender.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sender fields.
+func (s *Sender) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sender.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int64(value.Int64)
+		case sender.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case sender.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = types.DialogType(value.String)
+			}
+		case sender.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sender.
+// This includes values selected through modifiers, order, etc.
+func (s *Sender) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryMessages queries the "messages" edge of the Sender entity.
+func (s *Sender) QueryMessages() *MessageQuery {
+	return NewSenderClient(s.config).QueryMessages(s)
+}
+
+// Update returns a builder for updating this Sender.
+// Note that you need to call Sender.Unwrap() before calling this method if this Sender
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Sender) Update() *SenderUpdateOne {
+	return NewSenderClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Sender entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Sender) Unwrap() *Sender {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sender is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Sender) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sender(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Senders is a parsable slice of Sender.
+type Senders []*Sender
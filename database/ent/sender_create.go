@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// SenderCreate is the builder for creating a Sender entity.
+type SenderCreate struct {
+	config
+	mutation *SenderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (sc *SenderCreate) SetName(s string) *SenderCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *SenderCreate) SetType(tt types.DialogType) *SenderCreate {
+	sc.mutation.SetType(tt)
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SenderCreate) SetUpdatedAt(t time.Time) *SenderCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SenderCreate) SetNillableUpdatedAt(t *time.Time) *SenderCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SenderCreate) SetID(i int64) *SenderCreate {
+	sc.mutation.SetID(i)
+	return sc
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (sc *SenderCreate) AddMessageIDs(ids ...uuid.UUID) *SenderCreate {
+	sc.mutation.AddMessageIDs(ids...)
+	return sc
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (sc *SenderCreate) AddMessages(m ...*Message) *SenderCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return sc.AddMessageIDs(ids...)
+}
+
+// Mutation returns the SenderMutation object of the builder.
+func (sc *SenderCreate) Mutation() *SenderMutation {
+	return sc.mutation
+}
+
+// Save creates the Sender in the database.
+func (sc *SenderCreate) Save(ctx context.Context) (*Sender, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SenderCreate) SaveX(ctx context.Context) *Sender {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SenderCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SenderCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SenderCreate) defaults() {
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := sender.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SenderCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Sender.name"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Sender.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := sender.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Sender.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Sender.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *SenderCreate) sqlSave(ctx context.Context) (*Sender, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SenderCreate) createSpec() (*Sender, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sender{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sender.Table, sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(sender.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.SetField(sender.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sender.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SenderUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SenderCreate) OnConflict(opts ...sql.ConflictOption) *SenderUpsertOne {
+	sc.conflict = opts
+	return &SenderUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SenderCreate) OnConflictColumns(columns ...string) *SenderUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SenderUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SenderUpsertOne is the builder for "upsert"-ing
+	//  one Sender node.
+	SenderUpsertOne struct {
+		create *SenderCreate
+	}
+
+	// SenderUpsert is the "OnConflict" setter.
+	SenderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SenderUpsert) SetName(v string) *SenderUpsert {
+	u.Set(sender.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderUpsert) UpdateName() *SenderUpsert {
+	u.SetExcluded(sender.FieldName)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *SenderUpsert) SetType(v types.DialogType) *SenderUpsert {
+	u.Set(sender.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SenderUpsert) UpdateType() *SenderUpsert {
+	u.SetExcluded(sender.FieldType)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderUpsert) SetUpdatedAt(v time.Time) *SenderUpsert {
+	u.Set(sender.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderUpsert) UpdateUpdatedAt() *SenderUpsert {
+	u.SetExcluded(sender.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sender.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SenderUpsertOne) UpdateNewValues() *SenderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sender.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SenderUpsertOne) Ignore() *SenderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SenderUpsertOne) DoNothing() *SenderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SenderCreate.OnConflict
+// documentation for more info.
+func (u *SenderUpsertOne) Update(set func(*SenderUpsert)) *SenderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SenderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SenderUpsertOne) SetName(v string) *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderUpsertOne) UpdateName() *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SenderUpsertOne) SetType(v types.DialogType) *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SenderUpsertOne) UpdateType() *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderUpsertOne) SetUpdatedAt(v time.Time) *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderUpsertOne) UpdateUpdatedAt() *SenderUpsertOne {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SenderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SenderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SenderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SenderUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SenderUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SenderCreateBulk is the builder for creating many Sender entities in bulk.
+type SenderCreateBulk struct {
+	config
+	err      error
+	builders []*SenderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Sender entities in the database.
+func (scb *SenderCreateBulk) Save(ctx context.Context) ([]*Sender, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sender, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SenderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SenderCreateBulk) SaveX(ctx context.Context) []*Sender {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SenderCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SenderCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sender.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SenderUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SenderCreateBulk) OnConflict(opts ...sql.ConflictOption) *SenderUpsertBulk {
+	scb.conflict = opts
+	return &SenderUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SenderCreateBulk) OnConflictColumns(columns ...string) *SenderUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SenderUpsertBulk{
+		create: scb,
+	}
+}
+
+// SenderUpsertBulk is the builder for "upsert"-ing
+// a bulk of Sender nodes.
+type SenderUpsertBulk struct {
+	create *SenderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sender.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SenderUpsertBulk) UpdateNewValues() *SenderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sender.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sender.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SenderUpsertBulk) Ignore() *SenderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SenderUpsertBulk) DoNothing() *SenderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SenderCreateBulk.OnConflict
+// documentation for more info.
+func (u *SenderUpsertBulk) Update(set func(*SenderUpsert)) *SenderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SenderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SenderUpsertBulk) SetName(v string) *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SenderUpsertBulk) UpdateName() *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *SenderUpsertBulk) SetType(v types.DialogType) *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *SenderUpsertBulk) UpdateType() *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SenderUpsertBulk) SetUpdatedAt(v time.Time) *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SenderUpsertBulk) UpdateUpdatedAt() *SenderUpsertBulk {
+	return u.Update(func(s *SenderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SenderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SenderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SenderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SenderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// SenderUpdate is the builder for updating Sender entities.
+type SenderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SenderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SenderUpdate builder.
+func (su *SenderUpdate) Where(ps ...predicate.Sender) *SenderUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SenderUpdate) SetName(s string) *SenderUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SenderUpdate) SetNillableName(s *string) *SenderUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetType sets the "type" field.
+func (su *SenderUpdate) SetType(tt types.DialogType) *SenderUpdate {
+	su.mutation.SetType(tt)
+	return su
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (su *SenderUpdate) SetNillableType(tt *types.DialogType) *SenderUpdate {
+	if tt != nil {
+		su.SetType(*tt)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SenderUpdate) SetUpdatedAt(t time.Time) *SenderUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (su *SenderUpdate) SetNillableUpdatedAt(t *time.Time) *SenderUpdate {
+	if t != nil {
+		su.SetUpdatedAt(*t)
+	}
+	return su
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (su *SenderUpdate) AddMessageIDs(ids ...uuid.UUID) *SenderUpdate {
+	su.mutation.AddMessageIDs(ids...)
+	return su
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (su *SenderUpdate) AddMessages(m ...*Message) *SenderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.AddMessageIDs(ids...)
+}
+
+// Mutation returns the SenderMutation object of the builder.
+func (su *SenderUpdate) Mutation() *SenderMutation {
+	return su.mutation
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (su *SenderUpdate) ClearMessages() *SenderUpdate {
+	su.mutation.ClearMessages()
+	return su
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (su *SenderUpdate) RemoveMessageIDs(ids ...uuid.UUID) *SenderUpdate {
+	su.mutation.RemoveMessageIDs(ids...)
+	return su
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (su *SenderUpdate) RemoveMessages(m ...*Message) *SenderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.RemoveMessageIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SenderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SenderUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SenderUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SenderUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SenderUpdate) check() error {
+	if v, ok := su.mutation.GetType(); ok {
+		if err := sender.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Sender.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *SenderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SenderUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *SenderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sender.Table, sender.Columns, sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(sender.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.GetType(); ok {
+		_spec.SetField(sender.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !su.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SenderUpdateOne is the builder for updating a single Sender entity.
+type SenderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SenderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (suo *SenderUpdateOne) SetName(s string) *SenderUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SenderUpdateOne) SetNillableName(s *string) *SenderUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetType sets the "type" field.
+func (suo *SenderUpdateOne) SetType(tt types.DialogType) *SenderUpdateOne {
+	suo.mutation.SetType(tt)
+	return suo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (suo *SenderUpdateOne) SetNillableType(tt *types.DialogType) *SenderUpdateOne {
+	if tt != nil {
+		suo.SetType(*tt)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SenderUpdateOne) SetUpdatedAt(t time.Time) *SenderUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (suo *SenderUpdateOne) SetNillableUpdatedAt(t *time.Time) *SenderUpdateOne {
+	if t != nil {
+		suo.SetUpdatedAt(*t)
+	}
+	return suo
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (suo *SenderUpdateOne) AddMessageIDs(ids ...uuid.UUID) *SenderUpdateOne {
+	suo.mutation.AddMessageIDs(ids...)
+	return suo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (suo *SenderUpdateOne) AddMessages(m ...*Message) *SenderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.AddMessageIDs(ids...)
+}
+
+// Mutation returns the SenderMutation object of the builder.
+func (suo *SenderUpdateOne) Mutation() *SenderMutation {
+	return suo.mutation
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (suo *SenderUpdateOne) ClearMessages() *SenderUpdateOne {
+	suo.mutation.ClearMessages()
+	return suo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (suo *SenderUpdateOne) RemoveMessageIDs(ids ...uuid.UUID) *SenderUpdateOne {
+	suo.mutation.RemoveMessageIDs(ids...)
+	return suo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (suo *SenderUpdateOne) RemoveMessages(m ...*Message) *SenderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.RemoveMessageIDs(ids...)
+}
+
+// Where appends a list predicates to the SenderUpdate builder.
+func (suo *SenderUpdateOne) Where(ps ...predicate.Sender) *SenderUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SenderUpdateOne) Select(field string, fields ...string) *SenderUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Sender entity.
+func (suo *SenderUpdateOne) Save(ctx context.Context) (*Sender, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SenderUpdateOne) SaveX(ctx context.Context) *Sender {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SenderUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SenderUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SenderUpdateOne) check() error {
+	if v, ok := suo.mutation.GetType(); ok {
+		if err := sender.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Sender.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *SenderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SenderUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *SenderUpdateOne) sqlSave(ctx context.Context) (_node *Sender, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sender.Table, sender.Columns, sqlgraph.NewFieldSpec(sender.FieldID, field.TypeInt64))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sender.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sender.FieldID)
+		for _, f := range fields {
+			if !sender.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sender.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(sender.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.GetType(); ok {
+		_spec.SetField(sender.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !suo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sender.MessagesTable,
+			Columns: []string{sender.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Sender{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,8 +16,26 @@ type Tx struct {
 	config
 	// Dialog is the client for interacting with the Dialog builders.
 	Dialog *DialogClient
+	// EmbeddingCache is the client for interacting with the EmbeddingCache builders.
+	EmbeddingCache *EmbeddingCacheClient
+	// EmbeddingSpend is the client for interacting with the EmbeddingSpend builders.
+	EmbeddingSpend *EmbeddingSpendClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageChunk is the client for interacting with the MessageChunk builders.
+	MessageChunk *MessageChunkClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// NextEmbedding is the client for interacting with the NextEmbedding builders.
+	NextEmbedding *NextEmbeddingClient
+	// NextEmbeddingFailure is the client for interacting with the NextEmbeddingFailure builders.
+	NextEmbeddingFailure *NextEmbeddingFailureClient
+	// ObservationState is the client for interacting with the ObservationState builders.
+	ObservationState *ObservationStateClient
+	// Sender is the client for interacting with the Sender builders.
+	Sender *SenderClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,7 +168,16 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Dialog = NewDialogClient(tx.config)
+	tx.EmbeddingCache = NewEmbeddingCacheClient(tx.config)
+	tx.EmbeddingSpend = NewEmbeddingSpendClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageChunk = NewMessageChunkClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
+	tx.NextEmbedding = NewNextEmbeddingClient(tx.config)
+	tx.NextEmbeddingFailure = NewNextEmbeddingFailureClient(tx.config)
+	tx.ObservationState = NewObservationStateClient(tx.config)
+	tx.Sender = NewSenderClient(tx.config)
+	tx.Setting = NewSettingClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -211,3 +240,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
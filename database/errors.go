@@ -2,4 +2,9 @@ package database
 
 import "errors"
 
-var ErrNotAllowedToClearEmbedding = errors.New("embedding dimensions changed, but clearing embedding is not allowed")
+var (
+	ErrNotAllowedToClearEmbedding    = errors.New("embedding dimensions changed, but clearing embedding is not allowed")
+	ErrInsufficientEmbeddingCoverage = errors.New("not enough messages are embedded with the next model")
+	ErrEmbeddingModelMismatch        = errors.New("messages are embedded with another model than the configured one")
+	ErrEmbeddingModelUnknown         = errors.New("the embedding model of the messages is unknown")
+)
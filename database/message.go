@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+)
+
+// UpsertMessage saves the message in the transaction, or updates the stored
+// message with the same msg_id and dialog_id instead. Only changed columns are
+// updated, and the embedding is only reset if the embedded text changed. If
+// the text changed, the previous text is kept as a revision, like when the
+// edit is observed. The lacking columns are the ones the source has no data
+// for, which keep their stored value unless one is given. It reports whether
+// the message was inserted or updated.
+func UpsertMessage(ctx context.Context, tx *ent.Tx, create *ent.MessageCreate, lacking ...string) (bool, error) {
+	mutation := create.Mutation()
+	msgID, _ := mutation.MsgID()
+	dialogID, _ := mutation.DialogID()
+	old, err := tx.Message.Query().
+		Select(entmessage.FieldID, entmessage.FieldText).
+		Where(entmessage.MsgID(msgID), entmessage.DialogID(dialogID)).
+		Modify(func(s *entsql.Selector) { s.ForUpdate() }).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, fmt.Errorf("failed to query message: %w", err)
+	}
+
+	values := upsertValues(lacking)
+	err = create.
+		OnConflict(
+			entsql.ConflictColumns(entmessage.FieldMsgID, entmessage.FieldDialogID),
+			entsql.ResolveWith(func(u *entsql.UpdateSet) { resolveMessageConflict(u, values) }),
+			// skip updating a message that is saved again unchanged
+			entsql.UpdateWhere(entsql.ExprP(isDistinct(values))),
+		).
+		Exec(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// message already exists and nothing changed
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	if text, _ := mutation.Text(); old != nil && old.Text != text {
+		editedAt, ok := mutation.EditedAt()
+		if !ok {
+			editedAt = time.Now()
+		}
+		err = tx.MessageRevision.Create().
+			SetMessageID(old.ID).
+			SetText(old.Text).
+			SetEditedAt(editedAt).
+			Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to save message revision: %w", err)
+		}
+	}
+	return true, nil
+}
+
+var (
+	// upsertColumns are updated when a message is saved again.
+	upsertColumns = []string{
+		entmessage.FieldText,
+		entmessage.FieldDerivedText,
+		entmessage.FieldHasMedia,
+		entmessage.FieldMediaInfo,
+		entmessage.FieldSenderID,
+		entmessage.FieldReplyToMsgID,
+		entmessage.FieldTopMsgID,
+		entmessage.FieldTopicID,
+		entmessage.FieldFwdFromID,
+		entmessage.FieldFwdFromName,
+		entmessage.FieldFwdFromMsgID,
+		entmessage.FieldFwdFromDate,
+		entmessage.FieldFwdPostAuthor,
+		entmessage.FieldEditedAt,
+	}
+	// keptColumns are never cleared once known, as a message does not lose
+	// its sender or its edits, so they are only unknown to the source.
+	keptColumns = []string{
+		entmessage.FieldSenderID,
+		entmessage.FieldEditedAt,
+	}
+	// embeddedColumns are the columns the embedding is computed from.
+	embeddedColumns = []string{
+		entmessage.FieldText,
+		entmessage.FieldDerivedText,
+	}
+	// embeddingColumns are reset to these values when the embedded text changes.
+	embeddingColumns = map[string]string{
+		entmessage.FieldTextEmbedding:      "NULL",
+		entmessage.FieldEmbeddingStrategy:  "NULL",
+		entmessage.FieldEmbeddingModel:     "NULL",
+		entmessage.FieldNextEmbeddingModel: "NULL",
+		entmessage.FieldEmbeddingAttempts:  "0",
+		entmessage.FieldEmbeddingError:     "NULL",
+		entmessage.FieldEmbeddingRetryAt:   "NULL",
+		entmessage.FieldEmbeddingFailedAt:  "NULL",
+		entmessage.FieldEmbeddingSkipped:   "FALSE",
+	}
+)
+
+// ResetEmbedding clears the embedding of the mutated messages along with
+// their failed attempts, so that they are considered for embedding again.
+func ResetEmbedding(m *ent.MessageMutation) {
+	m.ClearTextEmbedding()
+	m.ClearEmbeddingStrategy()
+	m.ClearEmbeddingModel()
+	m.ClearNextEmbeddingModel()
+	m.SetEmbeddingAttempts(0)
+	m.ClearEmbeddingError()
+	m.ClearEmbeddingRetryAt()
+	m.ClearEmbeddingFailedAt()
+	m.SetEmbeddingSkipped(false)
+}
+
+// excluded refers to the column of the message proposed for insertion.
+func excluded(column string) string {
+	return entsql.Dialect(dialect.Postgres).String(func(b *entsql.Builder) {
+		b.WriteString("EXCLUDED.").Ident(column)
+	})
+}
+
+// upsertValue is the value a column is updated to when a message is saved again.
+type upsertValue struct {
+	column string
+	value  string
+}
+
+// upsertValues returns the values the upsert columns are updated to, which are
+// the incoming ones, except for the kept and lacking columns that keep their
+// stored value unless one is given.
+func upsertValues(lacking []string) []upsertValue {
+	table := entsql.Dialect(dialect.Postgres).Table(entmessage.Table)
+	return lo.Map(upsertColumns, func(c string, _ int) upsertValue {
+		if lo.Contains(keptColumns, c) || lo.Contains(lacking, c) {
+			return upsertValue{column: c, value: fmt.Sprintf("COALESCE(%s, %s)", excluded(c), table.C(c))}
+		}
+		return upsertValue{column: c, value: excluded(c)}
+	})
+}
+
+// isDistinct builds a condition that is true if any of the columns differs
+// from the value it is updated to.
+func isDistinct(values []upsertValue) string {
+	table := entsql.Dialect(dialect.Postgres).Table(entmessage.Table)
+	return fmt.Sprintf("(%s) IS DISTINCT FROM (%s)",
+		strings.Join(lo.Map(values, func(v upsertValue, _ int) string { return table.C(v.column) }), ", "),
+		strings.Join(lo.Map(values, func(v upsertValue, _ int) string { return v.value }), ", "),
+	)
+}
+
+// resolveMessageConflict updates a message that is saved again, resetting its
+// embedding only if the embedded text changed.
+func resolveMessageConflict(u *entsql.UpdateSet, values []upsertValue) {
+	for _, v := range values {
+		u.Set(v.column, entsql.Expr(v.value))
+	}
+	embedded := lo.Filter(values, func(v upsertValue, _ int) bool { return lo.Contains(embeddedColumns, v.column) })
+	for c, reset := range embeddingColumns {
+		u.Set(c, entsql.Expr(fmt.Sprintf(
+			"CASE WHEN %s THEN %s ELSE %s END",
+			isDistinct(embedded), reset, u.Table().C(c),
+		)))
+	}
+}
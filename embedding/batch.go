@@ -0,0 +1,54 @@
+package embedding
+
+import (
+	"github.com/xyenon/telemikiya/database/ent"
+	"go.uber.org/zap"
+)
+
+// batches splits the inputs into as many requests as needed to stay within
+// the maximum number of inputs and tokens of a request. An input with more
+// tokens than a request allows is sent on its own.
+func (e *Embedding) batches(m *model, inputs []string) (batches [][]string) {
+	maxInputs, maxTokens := int(e.cfg.Batching.MaxInputs), int(e.cfg.Batching.MaxTokens)
+	t := m.provider.Tokenizer()
+
+	var batch []string
+	var tokens int
+	for _, input := range inputs {
+		count := t.Count(input)
+		if len(batch) > 0 &&
+			((maxInputs > 0 && len(batch) >= maxInputs) || (maxTokens > 0 && tokens+count > maxTokens)) {
+			batches = append(batches, batch)
+			batch, tokens = nil, 0
+		}
+		batch = append(batch, input)
+		tokens += count
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// truncate truncates the texts longer than the context length of the model,
+// which the provider would otherwise reject or truncate silently.
+func (e *Embedding) truncate(m *model, message *ent.Message, texts []string) []string {
+	maxTokens := int(e.cfg.Batching.MaxInputTokens)
+	if maxTokens == 0 {
+		return texts
+	}
+	t := m.provider.Tokenizer()
+	for i, text := range texts {
+		truncated, ok := t.Truncate(text, maxTokens)
+		if !ok {
+			continue
+		}
+		e.logger.Warn("truncating text longer than the context length of the model",
+			zap.Stringer("id", message.ID),
+			zap.String("model", m.key),
+			zap.Int("tokens", t.Count(text)),
+			zap.Int("max_tokens", maxTokens))
+		texts[i] = truncated
+	}
+	return texts
+}
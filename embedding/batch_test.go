@@ -0,0 +1,164 @@
+package embedding
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database/ent"
+)
+
+func TestBatches(t *testing.T) {
+	// 25 tokens, more than a request allows
+	long := strings.Repeat("word ", 25)
+	tests := []struct {
+		name     string
+		batching config.Batching
+		inputs   []string
+		want     [][]string
+	}{
+		{
+			name:   "no inputs",
+			inputs: nil,
+			want:   nil,
+		},
+		{
+			name:   "unlimited",
+			inputs: []string{"a", "b", "c"},
+			want:   [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:     "max inputs",
+			batching: config.Batching{MaxInputs: 2},
+			inputs:   []string{"a", "b", "c", "d", "e"},
+			want:     [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name:     "max tokens",
+			batching: config.Batching{MaxTokens: 4},
+			inputs:   []string{"one two", "three", "four", "five six seven", "eight"},
+			want:     [][]string{{"one two", "three"}, {"four"}, {"five six seven"}, {"eight"}},
+		},
+		{
+			name:     "exactly max tokens",
+			batching: config.Batching{MaxTokens: 4},
+			inputs:   []string{"one two", "six ten"},
+			want:     [][]string{{"one two", "six ten"}},
+		},
+		{
+			// an input over the limit is sent on its own
+			name:     "single input over max tokens",
+			batching: config.Batching{MaxTokens: 10},
+			inputs:   []string{"a", long, "b", "c"},
+			want:     [][]string{{"a"}, {long}, {"b", "c"}},
+		},
+		{
+			name:     "only input over max tokens",
+			batching: config.Batching{MaxTokens: 10},
+			inputs:   []string{long},
+			want:     [][]string{{long}},
+		},
+		{
+			name:     "both limits",
+			batching: config.Batching{MaxInputs: 2, MaxTokens: 3},
+			inputs:   []string{"a", "b", "c", "one two three", "d"},
+			want:     [][]string{{"a", "b"}, {"c"}, {"one two three"}, {"d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEmbedding(config.Embedding{Batching: tt.batching})
+			m := &model{key: "fake", provider: &fakeProvider{}}
+			if got := e.batches(m, tt.inputs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("batches = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxInputTokens uint
+		texts          []string
+		want           []string
+	}{
+		{
+			name:  "disabled",
+			texts: []string{"one two three four"},
+			want:  []string{"one two three four"},
+		},
+		{
+			name:           "fits",
+			maxInputTokens: 5,
+			texts:          []string{"one two three four"},
+			want:           []string{"one two three four"},
+		},
+		{
+			name:           "truncated",
+			maxInputTokens: 4,
+			texts:          []string{"one two", "one two three four", "three four"},
+			want:           []string{"one two", "one two three", "three four"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEmbedding(config.Embedding{Batching: config.Batching{MaxInputTokens: tt.maxInputTokens}})
+			m := &model{key: "fake", provider: &fakeProvider{}}
+			got := e.truncate(m, &ent.Message{ID: uuid.New()}, tt.texts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("truncate = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	const text = "one two three four five six"
+	tests := []struct {
+		name     string
+		chunking config.Chunking
+		want     []string
+	}{
+		{
+			name: "disabled",
+			want: []string{text},
+		},
+		{
+			name:     "fits",
+			chunking: config.Chunking{MaxTokens: 7, Overlap: 2},
+			want:     []string{text},
+		},
+		{
+			name:     "chunked",
+			chunking: config.Chunking{MaxTokens: 3},
+			want:     []string{"one two", "three four", "five six"},
+		},
+		{
+			name:     "overlap",
+			chunking: config.Chunking{MaxTokens: 3, Overlap: 1},
+			want:     []string{"one two", "two three", "four five six"},
+		},
+		{
+			name:     "overlap equal to max tokens",
+			chunking: config.Chunking{MaxTokens: 3, Overlap: 3},
+			want:     []string{"one two", "two three", "four five six"},
+		},
+		{
+			name:     "overlap above max tokens",
+			chunking: config.Chunking{MaxTokens: 3, Overlap: 100},
+			want:     []string{"one two", "two three", "four five six"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEmbedding(config.Embedding{Chunking: tt.chunking})
+			m := &model{key: "fake", provider: &fakeProvider{}}
+			if got := e.split(m, text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("split = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/database/ent"
+	entembeddingcache "github.com/xyenon/telemikiya/database/ent/embeddingcache"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+
+	Config   *config.Config
+	Database *database.Database
+}
+
+// Cache keeps the embeddings of texts embedded before, so that identical
+// texts, like reposted announcements, are only embedded once.
+type Cache struct {
+	db    *database.Database
+	model string
+	// prefix is prepended to the texts when they are embedded, so that
+	// changing it does not reuse the embeddings of the unprefixed texts.
+	prefix string
+}
+
+func New(params Params) *Cache {
+	return newCache(params.Database, &params.Config.Embedding)
+}
+
+func newCache(db *database.Database, cfg *config.Embedding) *Cache {
+	return &Cache{
+		db:     db,
+		model:  cfg.ModelKey(),
+		prefix: cfg.DocumentPrefix,
+	}
+}
+
+// WithModel returns a cache for the embeddings of another model.
+func (c Cache) WithModel(cfg *config.Embedding) *Cache {
+	return newCache(c.db, cfg)
+}
+
+// Get returns the cached embeddings of the texts, with nil for the texts not
+// in the cache. Their hits are counted by Hit once they are used.
+func (c Cache) Get(ctx context.Context, texts []string) ([][]float32, error) {
+	hashes := lo.Map(texts, func(text string, _ int) []byte { return hash(c.prefix + text) })
+	entries, err := c.db.EmbeddingCache.Query().
+		Where(
+			entembeddingcache.Model(c.model),
+			entembeddingcache.HashIn(uniqHashes(hashes)...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query embedding cache: %w", err)
+	}
+
+	embeddings := lo.SliceToMap(entries, func(entry *ent.EmbeddingCache) (string, []float32) {
+		return string(entry.Hash), entry.Embedding.Slice()
+	})
+	return lo.Map(hashes, func(h []byte, _ int) []float32 { return embeddings[string(h)] }), nil
+}
+
+// Hit counts a hit of the cached embeddings of the texts that were used,
+// once per distinct text.
+func (c Cache) Hit(ctx context.Context, texts []string) error {
+	if len(texts) == 0 {
+		return nil
+	}
+	hashes := lo.Map(texts, func(text string, _ int) []byte { return hash(c.prefix + text) })
+	err := c.db.EmbeddingCache.Update().
+		Where(
+			entembeddingcache.Model(c.model),
+			entembeddingcache.HashIn(uniqHashes(hashes)...),
+		).
+		AddHits(1).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count embedding cache hits: %w", err)
+	}
+	return nil
+}
+
+// Put caches the embeddings of the texts.
+func (c Cache) Put(ctx context.Context, texts []string, embeddings [][]float32) error {
+	creates := make([]*ent.EmbeddingCacheCreate, 0, len(texts))
+	seen := make(map[string]struct{}, len(texts))
+	for i, text := range texts {
+		h := hash(c.prefix + text)
+		if _, ok := seen[string(h)]; ok {
+			continue
+		}
+		seen[string(h)] = struct{}{}
+		creates = append(creates, c.db.EmbeddingCache.Create().
+			SetHash(h).
+			SetModel(c.model).
+			SetEmbedding(pgvector.NewVector(embeddings[i])),
+		)
+	}
+
+	err := c.db.EmbeddingCache.CreateBulk(creates...).
+		OnConflictColumns(entembeddingcache.FieldModel, entembeddingcache.FieldHash).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save embedding cache: %w", err)
+	}
+	return nil
+}
+
+// Stats summarizes how much the cache of the current model is used.
+type Stats struct {
+	Entries int
+	Hits    int
+}
+
+// HitRate is the share of texts taken from the cache, counting each entry as
+// the miss that filled it.
+func (s Stats) HitRate() float64 {
+	if s.Entries == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Entries)
+}
+
+func (c Cache) Stats(ctx context.Context) (stats Stats, err error) {
+	query := c.db.EmbeddingCache.Query().Where(entembeddingcache.Model(c.model))
+	if stats.Entries, err = query.Clone().Count(ctx); err != nil {
+		return stats, fmt.Errorf("failed to count embedding cache entries: %w", err)
+	}
+	if stats.Entries == 0 {
+		return stats, nil
+	}
+	if stats.Hits, err = query.Aggregate(ent.Sum(entembeddingcache.FieldHits)).Int(ctx); err != nil {
+		return stats, fmt.Errorf("failed to count embedding cache hits: %w", err)
+	}
+	return stats, nil
+}
+
+func uniqHashes(hashes [][]byte) [][]byte {
+	return lo.UniqBy(hashes, func(h []byte) string { return string(h) })
+}
+
+// hash returns the hash of the text, ignoring differences in whitespace.
+func hash(text string) []byte {
+	h := sha256.Sum256([]byte(strings.Join(strings.Fields(text), " ")))
+	return h[:]
+}
@@ -0,0 +1,79 @@
+package embedding
+
+import (
+	"fmt"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessagechunk "github.com/xyenon/telemikiya/database/ent/messagechunk"
+)
+
+// split returns the texts to embed for the input, which are its chunks if it
+// is too long to be embedded at once.
+func (e *Embedding) split(m *model, input string) []string {
+	maxTokens, t := int(e.cfg.Chunking.MaxTokens), m.provider.Tokenizer()
+	if maxTokens == 0 || t.Count(input) <= maxTokens {
+		return []string{input}
+	}
+	return t.Split(input, maxTokens, int(e.cfg.Chunking.Overlap))
+}
+
+// save saves the embedding of the document, replacing the chunks of the
+// previous text of its message. A chunked message is saved with its chunks,
+// and gets the mean of their embeddings to be known as embedded.
+func (e *Embedding) save(m *model, doc document, embeddings [][]float32) error {
+	message, chunks := doc.message, doc.texts
+	tx, err := e.db.Tx(e.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.MessageChunk.Delete().Where(entmessagechunk.MessageID(message.ID)).Exec(e.ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete message chunks: %w", err))
+	}
+	if len(chunks) > 1 {
+		creates := make([]*ent.MessageChunkCreate, len(chunks))
+		for i, chunk := range chunks {
+			creates[i] = tx.MessageChunk.Create().
+				SetMessageID(message.ID).
+				SetIndex(i).
+				SetText(chunk).
+				SetTextEmbedding(pgvector.NewVector(embeddings[i]))
+		}
+		if err = tx.MessageChunk.CreateBulk(creates...).Exec(e.ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to save message chunks: %w", err))
+		}
+	}
+
+	err = tx.Message.UpdateOne(message).
+		SetTextEmbedding(pgvector.NewVector(mean(embeddings))).
+		SetEmbeddingStrategy(doc.strategy).
+		SetEmbeddingModel(m.key).
+		Exec(e.ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to save embedding: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+func mean(vectors [][]float32) []float32 {
+	if len(vectors) == 1 {
+		return vectors[0]
+	}
+	result := make([]float32, len(vectors[0]))
+	for _, v := range vectors {
+		for i := range result {
+			result[i] += v[i] / float32(len(vectors))
+		}
+	}
+	return result
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %w", err, rerr)
+	}
+	return err
+}
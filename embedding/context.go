@@ -0,0 +1,116 @@
+package embedding
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/types"
+)
+
+// document is what is embedded for a message, which are the chunks of a long
+// message or the text built by the embedding strategy of its dialog.
+type document struct {
+	message  *ent.Message
+	strategy types.EmbeddingStrategy
+	texts    []string
+}
+
+// documents builds the documents to embed for the messages with the model.
+func (e *Embedding) documents(m *model, messages []*ent.Message) ([]document, error) {
+	docs := make([]document, len(messages))
+	for i, message := range messages {
+		docs[i] = document{
+			message:  message,
+			strategy: types.EmbeddingStrategyMessage,
+			texts:    e.split(m, embeddingInput(message)),
+		}
+		if len(docs[i].texts) > 1 {
+			// long messages need no context
+			docs[i].texts = e.truncate(m, message, docs[i].texts)
+			continue
+		}
+
+		strategy := types.EmbeddingStrategyMessage
+		if dialog := message.Edges.Dialog; dialog != nil {
+			strategy = lo.CoalesceOrEmpty(e.cfg.Context.Strategies[dialog.Type], types.EmbeddingStrategyMessage)
+		}
+		context, err := e.contextMessages(message, strategy)
+		if err != nil {
+			return nil, err
+		}
+		if text, ok := e.withContext(m, message, context); ok {
+			docs[i].strategy, docs[i].texts = strategy, []string{text}
+		}
+		docs[i].texts = e.truncate(m, message, docs[i].texts)
+	}
+	return docs, nil
+}
+
+// contextMessages returns the messages to embed along with the message by the
+// strategy, oldest first.
+func (e *Embedding) contextMessages(message *ent.Message, strategy types.EmbeddingStrategy) ([]*ent.Message, error) {
+	query := e.db.Message.Query().
+		Select(entmessage.FieldText, entmessage.FieldDerivedText, entmessage.FieldSenderID).
+		Where(entmessage.DialogID(message.DialogID)).
+		WithSender(func(q *ent.SenderQuery) { q.Select(entsender.FieldName) })
+
+	switch strategy {
+	case types.EmbeddingStrategyPreceding:
+		if e.cfg.Context.Messages == 0 {
+			return nil, nil
+		}
+		query = query.Where(entmessage.MsgIDLT(message.MsgID))
+		if message.TopicID != nil {
+			query = query.Where(entmessage.TopicID(*message.TopicID))
+		}
+		messages, err := query.
+			Order(entmessage.ByMsgID(sql.OrderDesc())).
+			Limit(int(e.cfg.Context.Messages)).
+			All(e.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query preceding messages: %w", err)
+		}
+		slices.Reverse(messages)
+		return messages, nil
+	case types.EmbeddingStrategyReply:
+		if message.ReplyToMsgID == nil {
+			return nil, nil
+		}
+		messages, err := query.Where(entmessage.MsgID(*message.ReplyToMsgID)).All(e.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query replied message: %w", err)
+		}
+		return messages, nil
+	default:
+		return nil, nil
+	}
+}
+
+// withContext returns the text to embed for the message following its context
+// messages, each line prefixed by the name of its sender. The oldest context
+// messages are left out as needed to fit in a chunk. It reports false if no
+// context is left.
+func (e *Embedding) withContext(m *model, message *ent.Message, context []*ent.Message) (string, bool) {
+	lines := lo.FilterMap(append(context, message), func(msg *ent.Message, _ int) (string, bool) {
+		text := embeddingInput(msg)
+		if sender := msg.Edges.Sender; sender != nil && text != "" {
+			text = sender.Name + ": " + text
+		}
+		return text, text != ""
+	})
+
+	maxTokens := int(e.cfg.Chunking.MaxTokens)
+	for len(lines) > 1 && maxTokens > 0 && m.provider.Tokenizer().Count(strings.Join(lines, "\n")) > maxTokens {
+		lines = lines[1:]
+	}
+	if len(lines) <= 1 {
+		return "", false
+	}
+	return strings.Join(lines, "\n"), true
+}
@@ -0,0 +1,54 @@
+package embedding
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+)
+
+// eligibility decides which messages are worth embedding.
+type eligibility struct {
+	minLength     int
+	skipEmojiOnly bool
+	stopPhrases   map[string]struct{}
+}
+
+func newEligibility(cfg *config.Eligibility) eligibility {
+	stopPhrases := lo.Compact(lo.Map(cfg.StopPhrases, func(p string, _ int) string { return normalizePhrase(p) }))
+	return eligibility{
+		minLength:     int(cfg.MinLength),
+		skipEmojiOnly: cfg.SkipEmojiOnly,
+		stopPhrases:   lo.Keyify(stopPhrases),
+	}
+}
+
+// check returns why the text is not worth embedding, if it is not.
+func (e eligibility) check(text string) (reason string, ok bool) {
+	text = strings.TrimSpace(text)
+	switch {
+	case text == "":
+		return "empty", false
+	case utf8.RuneCountInString(text) < e.minLength:
+		return "too short", false
+	case e.skipEmojiOnly && !strings.ContainsFunc(text, isWordRune):
+		return "emoji only", false
+	}
+	if _, ok := e.stopPhrases[normalizePhrase(text)]; ok {
+		return "stop phrase", false
+	}
+	return "", true
+}
+
+// normalizePhrase lowercases the text, collapses its whitespace and trims the
+// punctuation and emoji around it.
+func normalizePhrase(text string) string {
+	text = strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	return strings.TrimFunc(text, func(r rune) bool { return !isWordRune(r) })
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
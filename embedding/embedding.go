@@ -2,15 +2,23 @@ package embedding
 
 import (
 	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
 	"time"
 
-	"github.com/pgvector/pgvector-go"
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/xyenon/telemikiya/config"
 	"github.com/xyenon/telemikiya/database"
 	"github.com/xyenon/telemikiya/database/ent"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
 	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/embedding/cache"
 	"github.com/xyenon/telemikiya/embedding/provider"
+	"github.com/xyenon/telemikiya/observation"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -23,13 +31,18 @@ type Params struct {
 	Logger            *zap.Logger
 	Database          *database.Database
 	EmbeddingProvider provider.Provider
+	// NextEmbeddingProvider is the provider of the next model, if one is configured.
+	NextEmbeddingProvider provider.Provider `name:"nextEmbeddingProvider" optional:"true"`
+	Cache                 *cache.Cache
 }
 
 type Embedding struct {
-	cfg               *config.Embedding
-	logger            *zap.Logger
-	db                *database.Database
-	embeddingProvider provider.Provider
+	cfg         *config.Embedding
+	logger      *zap.Logger
+	db          *database.Database
+	current     *model
+	next        *model
+	eligibility eligibility
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -39,17 +52,33 @@ func New(params Params) *Embedding {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	embedding := &Embedding{
-		cfg:               &params.Config.Embedding,
-		logger:            params.Logger,
-		db:                params.Database,
-		embeddingProvider: params.EmbeddingProvider,
-		ctx:               ctx,
-		cancel:            cancel,
+		cfg:    &params.Config.Embedding,
+		logger: params.Logger,
+		db:     params.Database,
+		current: &model{
+			key:      params.Config.Embedding.ModelKey(),
+			provider: params.EmbeddingProvider,
+			cache:    params.Cache,
+		},
+		eligibility: newEligibility(&params.Config.Embedding.Eligibility),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	if next, ok := params.Config.Embedding.NextModel(); ok && params.NextEmbeddingProvider != nil {
+		embedding.next = &model{
+			key:      next.ModelKey(),
+			next:     true,
+			provider: params.NextEmbeddingProvider,
+			cache:    params.Cache.WithModel(next),
+		}
 	}
 
 	if params.LifeCycle != nil {
 		params.LifeCycle.Append(fx.Hook{
 			OnStart: func(ctx context.Context) error {
+				if err := embedding.db.CheckEmbeddingModel(ctx); err != nil {
+					return err
+				}
 				go embedding.Run()
 				return nil
 			},
@@ -63,7 +92,39 @@ func New(params Params) *Embedding {
 	return embedding
 }
 
+// model is an embedding model the messages are embedded with, which is the
+// current model, or the next model they are re-embedded with in the background.
+type model struct {
+	key      string
+	next     bool
+	provider provider.Provider
+	cache    embeddingCache
+}
+
+// embeddingCache keeps the embeddings of texts embedded before by a model.
+type embeddingCache interface {
+	// Get returns the cached embeddings of the texts, with nil for the texts
+	// not in the cache.
+	Get(ctx context.Context, texts []string) ([][]float32, error)
+	// Put caches the embeddings of the texts.
+	Put(ctx context.Context, texts []string, embeddings [][]float32) error
+	// Hit counts a hit of the cached embeddings of the texts that were used.
+	Hit(ctx context.Context, texts []string) error
+}
+
+// Run embeds the messages with the current model, and re-embeds them with the
+// next model alongside if one is configured.
 func (e *Embedding) Run() {
+	if e.next != nil {
+		e.logger.Info("re-embedding messages with the next model", zap.String("model", e.next.key))
+		go e.run(e.next)
+	}
+	e.run(e.current)
+}
+
+func (e *Embedding) run(m *model) {
+	// failures counts the consecutive rounds in which nothing was embedded
+	var failures int
 	for {
 		select {
 		case <-e.ctx.Done():
@@ -72,41 +133,291 @@ func (e *Embedding) Run() {
 		}
 
 		messages, err := e.db.Message.Query().
-			Select(entmessage.FieldID, entmessage.FieldText).
-			Where(entmessage.TextEmbeddingIsNil()).
+			Select(
+				entmessage.FieldID,
+				entmessage.FieldMsgID,
+				entmessage.FieldDialogID,
+				entmessage.FieldSenderID,
+				entmessage.FieldReplyToMsgID,
+				entmessage.FieldTopicID,
+				entmessage.FieldText,
+				entmessage.FieldDerivedText,
+				entmessage.FieldEmbeddingAttempts,
+			).
+			Where(
+				e.pending(m),
+				entmessage.EmbeddingSkipped(false),
+				observation.EmbeddingEnabled(),
+			).
 			Limit(int(e.cfg.BatchSize)).
+			WithDialog(func(q *ent.DialogQuery) { q.Select(entdialog.FieldType) }).
+			WithSender(func(q *ent.SenderQuery) { q.Select(entsender.FieldName) }).
 			All(e.ctx)
 		if err != nil {
+			failures++
 			e.logger.Error("failed to query messages", zap.Error(err))
+			e.sleep(e.backoff(failures))
 			continue
 		}
-		e.logger.Info("fetched messages", zap.Int("count", len(messages)))
+		e.logger.Info("fetched messages", zap.String("model", m.key), zap.Int("count", len(messages)))
 		if len(messages) == 0 {
-			time.Sleep(5 * time.Second)
+			e.sleep(5 * time.Second)
 			continue
 		}
 
-		messageTexts := lo.Map(messages, func(msg *ent.Message, _ int) string { return msg.Text })
-		embeddings, err := e.embeddingProvider.Embed(e.ctx, messageTexts)
+		if messages, err = e.skipIneligible(messages); err != nil {
+			failures++
+			e.logger.Error("failed to skip messages", zap.Error(err))
+			e.sleep(e.backoff(failures))
+			continue
+		}
+		if len(messages) == 0 {
+			failures = 0
+			continue
+		}
+
+		docs, err := e.documents(m, messages)
 		if err != nil {
-			e.logger.Error("failed to embed messages", zap.Error(err))
+			failures++
+			e.logger.Error("failed to build documents", zap.Error(err))
+			e.sleep(e.backoff(failures))
 			continue
 		}
 
-		for i, message := range messages {
-			e.logger.Info("saving embedding", zap.String("text", message.Text))
-			_, err = message.Update().SetTextEmbedding(pgvector.NewVector(embeddings[i])).Save(e.ctx)
-			if err != nil {
-				e.logger.Error("failed to save embedding", zap.Error(err))
-			}
+		embedded, err := e.embed(m, docs)
+		if embedded > 0 {
+			failures = 0
+		} else {
+			failures++
 		}
+		if embedded == 0 || err != nil {
+			// a throttled provider is only retried once it allows it
+			delay := max(e.backoff(max(failures, 1)), provider.RetryAfter(err))
+			e.logger.Warn("embedding provider is failing, backing off",
+				zap.String("model", m.key), zap.Int("embedded", embedded), zap.Duration("delay", delay), zap.Error(err))
+			e.sleep(delay)
+		}
+	}
+}
+
+// pending selects the messages waiting to be embedded with the model.
+func (e *Embedding) pending(m *model) predicate.Message {
+	if m.next {
+		return e.pendingNext(m)
 	}
+	return entmessage.And(
+		entmessage.TextEmbeddingIsNil(),
+		entmessage.EmbeddingFailedAtIsNil(),
+		entmessage.Or(entmessage.EmbeddingRetryAtIsNil(), entmessage.EmbeddingRetryAtLTE(time.Now())),
+	)
+}
+
+// skipIneligible marks the messages not worth embedding as skipped, and
+// returns the others.
+func (e *Embedding) skipIneligible(messages []*ent.Message) ([]*ent.Message, error) {
+	eligible, ineligible := lo.FilterReject(messages, func(msg *ent.Message, _ int) bool {
+		reason, ok := e.eligibility.check(embeddingInput(msg))
+		if !ok {
+			e.logger.Debug("skipping message", zap.Stringer("id", msg.ID), zap.String("reason", reason))
+		}
+		return ok
+	})
+	if len(ineligible) == 0 {
+		return eligible, nil
+	}
+
+	err := e.db.Message.Update().
+		Where(entmessage.IDIn(lo.Map(ineligible, func(msg *ent.Message, _ int) uuid.UUID { return msg.ID })...)).
+		SetEmbeddingSkipped(true).
+		Exec(e.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark messages as skipped: %w", err)
+	}
+	return eligible, nil
+}
+
+// embed embeds the documents and saves their embeddings, returning how many
+// were saved. If the provider fails, the documents are split in halves and
+// retried, so that the messages it fails to embed are found and their failed
+// attempts recorded without holding back the rest. An unavailable or
+// throttled provider stops the embedding early without recording attempts,
+// as the messages are not at fault, and its error is returned for the caller
+// to back off from.
+func (e *Embedding) embed(m *model, docs []document) (int, error) {
+	embedded, failures, err := e.embedSplitting(m, docs)
+	for _, f := range failures {
+		e.recordFailure(m, f.doc.message, f.err)
+	}
+	return embedded, err
+}
+
+// docFailure is a document the provider failed to embed on its own.
+type docFailure struct {
+	doc document
+	err error
+}
+
+// embedSplitting embeds the documents, splitting them in halves when the
+// provider fails, and returns how many were saved along with the documents
+// that failed on their own. It stops at the first error of an unavailable or
+// throttled provider, which is returned.
+func (e *Embedding) embedSplitting(m *model, docs []document) (embedded int, failures []docFailure, err error) {
+	inputs := lo.FlatMap(docs, func(doc document, _ int) []string { return doc.texts })
+	embeddings, cached, err := e.embedInputs(m, inputs)
+	if err != nil {
+		if e.ctx.Err() != nil {
+			return 0, nil, nil
+		}
+		// splitting the batch is pointless when the provider is unavailable
+		if provider.IsTransient(err) {
+			return 0, nil, err
+		}
+		if len(docs) == 1 {
+			return 0, []docFailure{{doc: docs[0], err: err}}, nil
+		}
+		e.logger.Warn("failed to embed messages, splitting batch", zap.Int("count", len(docs)), zap.Error(err))
+		half := len(docs) / 2
+		embedded, failures, err = e.embedSplitting(m, docs[:half])
+		if err != nil {
+			return embedded, failures, err
+		}
+		embeddedTail, failuresTail, err := e.embedSplitting(m, docs[half:])
+		return embedded + embeddedTail, append(failures, failuresTail...), err
+	}
+
+	var used []string
+	for _, doc := range docs {
+		e.logger.Info("saving embedding",
+			zap.String("model", m.key),
+			zap.String("text", doc.message.Text),
+			zap.String("strategy", string(doc.strategy)),
+			zap.Int("chunks", len(doc.texts)))
+		if m.next {
+			err = e.saveNext(m, doc, embeddings[:len(doc.texts)])
+		} else {
+			err = e.save(m, doc, embeddings[:len(doc.texts)])
+		}
+		embeddings = embeddings[len(doc.texts):]
+		if err != nil {
+			e.logger.Error("failed to save embedding", zap.Error(err))
+			continue
+		}
+		used = append(used, doc.texts...)
+		embedded++
+	}
+
+	hits := lo.Intersect(lo.Uniq(used), cached)
+	if err = m.cache.Hit(e.ctx, hits); err != nil {
+		e.logger.Warn("failed to count embedding cache hits", zap.Error(err))
+	}
+	return embedded, nil, nil
+}
+
+// embedInputs embeds the inputs, taking the embeddings of the texts embedded
+// before from the cache, and returns the inputs taken from the cache.
+func (e *Embedding) embedInputs(m *model, inputs []string) (embeddings [][]float32, cached []string, err error) {
+	embeddings, err = m.cache.Get(e.ctx, inputs)
+	if err != nil {
+		e.logger.Warn("failed to get cached embeddings", zap.Error(err))
+		embeddings = make([][]float32, len(inputs))
+	}
+	cached = lo.Uniq(lo.FilterMap(inputs, func(input string, i int) (string, bool) {
+		return input, embeddings[i] != nil
+	}))
+	missing := lo.Uniq(lo.FilterMap(inputs, func(input string, i int) (string, bool) {
+		return input, embeddings[i] == nil
+	}))
+	batches := e.batches(m, missing)
+	e.logger.Debug("looked up embedding cache",
+		zap.Int("hits", len(cached)),
+		zap.Int("misses", len(missing)),
+		zap.Int("requests", len(batches)))
+
+	fresh := make([][]float32, 0, len(missing))
+	for _, batch := range batches {
+		batchEmbeddings, err := m.provider.EmbedDocuments(e.ctx, batch)
+		if err == nil && len(batchEmbeddings) != len(batch) {
+			err = fmt.Errorf("expected %d embeddings, got %d", len(batch), len(batchEmbeddings))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		// cached right away, so that the batches embedded before a failure
+		// are not embedded again when it is retried
+		if err = m.cache.Put(e.ctx, batch, batchEmbeddings); err != nil {
+			e.logger.Warn("failed to cache embeddings", zap.Error(err))
+		}
+		fresh = append(fresh, batchEmbeddings...)
+	}
+
+	freshByInput := lo.SliceToMap(lo.Zip2(missing, fresh), func(t lo.Tuple2[string, []float32]) (string, []float32) {
+		return t.Unpack()
+	})
+	for i, input := range inputs {
+		if embeddings[i] == nil {
+			embeddings[i] = freshByInput[input]
+		}
+	}
+	return embeddings, cached, nil
+}
+
+// recordFailure records a failed attempt to embed the message, and schedules
+// its retry or marks it as failed once it runs out of attempts.
+func (e *Embedding) recordFailure(m *model, message *ent.Message, cause error) {
+	if m.next {
+		e.recordNextFailure(m, message, cause)
+		return
+	}
+
+	attempts := message.EmbeddingAttempts + 1
+	update := message.Update().
+		SetEmbeddingAttempts(attempts).
+		SetEmbeddingError(cause.Error())
+	if attempts >= int(e.cfg.MaxAttempts) {
+		e.logger.Error("failed to embed message, giving up",
+			zap.Stringer("id", message.ID), zap.Int("attempts", attempts), zap.Error(cause))
+		update = update.SetEmbeddingFailedAt(time.Now())
+	} else {
+		e.logger.Warn("failed to embed message",
+			zap.Stringer("id", message.ID), zap.Int("attempts", attempts), zap.Error(cause))
+		update = update.SetEmbeddingRetryAt(time.Now().Add(e.backoff(attempts)))
+	}
+	if err := update.Exec(e.ctx); err != nil {
+		e.logger.Error("failed to record embedding failure", zap.Error(err))
+	}
+}
+
+// backoff returns the delay before the given consecutive retry, which doubles
+// from the minimum up to the maximum backoff, with jitter to spread retries.
+func (e *Embedding) backoff(retry int) time.Duration {
+	d := e.cfg.MinBackoff
+	for i := 1; i < retry && d > 0 && d < e.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, e.cfg.MaxBackoff)
+	return d/2 + rand.N(d/2+1)
+}
+
+// sleep waits for the duration unless the service is stopped.
+func (e *Embedding) sleep(d time.Duration) {
+	select {
+	case <-e.ctx.Done():
+	case <-time.After(d):
+	}
+}
+
+// embeddingInput returns the text to embed for the message,
+// which is its text followed by the text derived from its media.
+func embeddingInput(msg *ent.Message) string {
+	return strings.Join(lo.Compact([]string{msg.Text, msg.DerivedText}), "\n")
 }
 
 func (e *Embedding) Stop() {
 	e.cancel()
-	if err := e.embeddingProvider.Close(); err != nil {
-		e.logger.Error("failed to close embedding provider", zap.Error(err))
+	for _, m := range lo.Compact([]*model{e.current, e.next}) {
+		if err := m.provider.Close(); err != nil {
+			e.logger.Error("failed to close embedding provider", zap.String("model", m.key), zap.Error(err))
+		}
 	}
 	e.logger.Info("embedding service has been stopped")
 }
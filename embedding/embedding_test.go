@@ -0,0 +1,184 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database/ent"
+	"github.com/xyenon/telemikiya/embedding/provider"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
+	"go.uber.org/zap"
+)
+
+// fakeProvider embeds each input as its length, or fails with err if set.
+type fakeProvider struct {
+	err error
+	// requests are the inputs of the requests made.
+	requests [][]string
+}
+
+func (p *fakeProvider) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return p.EmbedDocuments(ctx, inputs)
+}
+
+func (p *fakeProvider) EmbedDocuments(_ context.Context, inputs []string) ([][]float32, error) {
+	p.requests = append(p.requests, inputs)
+	if p.err != nil {
+		return nil, p.err
+	}
+	embeddings := make([][]float32, len(inputs))
+	for i, input := range inputs {
+		embeddings[i] = []float32{float32(len(input))}
+	}
+	return embeddings, nil
+}
+
+func (p *fakeProvider) Tokenizer() tokenizer.Tokenizer {
+	return tokenizer.Default
+}
+
+func (p *fakeProvider) Close() error {
+	return nil
+}
+
+// noCache is a cache that never has an embedding.
+type noCache struct{}
+
+func (noCache) Get(_ context.Context, texts []string) ([][]float32, error) {
+	return make([][]float32, len(texts)), nil
+}
+
+func (noCache) Put(context.Context, []string, [][]float32) error {
+	return nil
+}
+
+func (noCache) Hit(context.Context, []string) error {
+	return nil
+}
+
+func newTestEmbedding(cfg config.Embedding) *Embedding {
+	return &Embedding{
+		cfg:    &cfg,
+		logger: zap.NewNop(),
+		ctx:    context.Background(),
+	}
+}
+
+// testDocuments returns documents of messages without a database, so that
+// recording a failed attempt panics.
+func testDocuments(n int) []document {
+	docs := make([]document, n)
+	for i := range docs {
+		text := fmt.Sprintf("message %d", i)
+		docs[i] = document{message: &ent.Message{ID: uuid.New(), Text: text}, texts: []string{text}}
+	}
+	return docs
+}
+
+// TestEmbedUnavailableProvider checks that an unavailable or throttled
+// provider is backed off from without splitting the batch, and without
+// recording failed attempts.
+func TestEmbedUnavailableProvider(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		// requests is how many requests are made for 4 documents.
+		requests   int
+		retryAfter time.Duration
+	}{
+		{
+			name:     "unavailable",
+			err:      fmt.Errorf("failed to embed: %w", &provider.UnavailableError{Err: errors.New("503 Service Unavailable")}),
+			requests: 1,
+		},
+		{
+			name: "throttled",
+			err: &provider.ThrottledError{
+				RetryAfter: time.Minute,
+				Err:        &provider.RateLimitError{RetryAfter: time.Minute, Err: errors.New("429 Too Many Requests")},
+			},
+			requests:   1,
+			retryAfter: time.Minute,
+		},
+		{
+			name:     "rate limited",
+			err:      &provider.RateLimitError{Err: errors.New("429 Too Many Requests")},
+			requests: 1,
+		},
+		{
+			name:     "timeout",
+			err:      fmt.Errorf("failed to embed: %w", context.DeadlineExceeded),
+			requests: 1,
+		},
+		{
+			name: "failover",
+			err: errors.Join(
+				fmt.Errorf("primary: %w", context.DeadlineExceeded),
+				fmt.Errorf("backup: %w", &provider.UnavailableError{Err: errors.New("502 Bad Gateway")}),
+			),
+			requests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &fakeProvider{err: tt.err}
+			e := newTestEmbedding(config.Embedding{})
+			m := &model{key: "fake", provider: p, cache: noCache{}}
+
+			embedded, err := e.embed(m, testDocuments(4))
+			if embedded != 0 {
+				t.Errorf("embedded = %d, want 0", embedded)
+			}
+			if len(p.requests) != tt.requests {
+				t.Errorf("got %d requests, want %d", len(p.requests), tt.requests)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+			if retryAfter := provider.RetryAfter(err); retryAfter != tt.retryAfter {
+				t.Errorf("retry after = %s, want %s", retryAfter, tt.retryAfter)
+			}
+		})
+	}
+}
+
+func TestEmbedSplittingFailures(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "inputs rejected", err: &provider.InputError{Err: errors.New("400 Bad Request")}},
+		{name: "unclassified", err: errors.New("500 input length exceeds context length")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &fakeProvider{err: tt.err}
+			e := newTestEmbedding(config.Embedding{})
+			m := &model{key: "fake", provider: p, cache: noCache{}}
+
+			// the batch is split down to single messages, which all fail on
+			// their own and are charged an attempt
+			docs := testDocuments(4)
+			embedded, failures, err := e.embedSplitting(m, docs)
+			if embedded != 0 || err != nil {
+				t.Errorf("embedded = %d, %v, want 0, nil", embedded, err)
+			}
+			if len(p.requests) != 1+2+4 {
+				t.Errorf("got %d requests, want %d", len(p.requests), 1+2+4)
+			}
+			if len(failures) != len(docs) {
+				t.Fatalf("got %d failures, want %d", len(failures), len(docs))
+			}
+			for i, f := range failures {
+				if f.doc.message != docs[i].message || !errors.Is(f.err, tt.err) {
+					t.Errorf("failure %d = %v, %v, want message %d, %v", i, f.doc.message.ID, f.err, i, tt.err)
+				}
+			}
+		})
+	}
+}
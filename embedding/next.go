@@ -0,0 +1,109 @@
+package embedding
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entnextembedding "github.com/xyenon/telemikiya/database/ent/nextembedding"
+	entnextembeddingfailure "github.com/xyenon/telemikiya/database/ent/nextembeddingfailure"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"go.uber.org/zap"
+)
+
+// pendingNext selects the messages not re-embedded with the next model yet,
+// except for the ones waiting to be retried or that failed too many times.
+func (e *Embedding) pendingNext(m *model) predicate.Message {
+	return entmessage.And(
+		entmessage.Or(entmessage.NextEmbeddingModelIsNil(), entmessage.NextEmbeddingModelNEQ(m.key)),
+		entmessage.Not(entmessage.HasNextEmbeddingFailuresWith(
+			entnextembeddingfailure.Model(m.key),
+			entnextembeddingfailure.Or(
+				entnextembeddingfailure.FailedAtNotNil(),
+				entnextembeddingfailure.RetryAtGT(time.Now()),
+			),
+		)),
+	)
+}
+
+// recordNextFailure records a failed attempt to re-embed the message with the
+// next model, and schedules its retry or marks it as failed once it runs out
+// of attempts.
+func (e *Embedding) recordNextFailure(m *model, message *ent.Message, cause error) {
+	attempts := 1
+	failure, err := e.db.NextEmbeddingFailure.Query().
+		Where(entnextembeddingfailure.MessageID(message.ID), entnextembeddingfailure.Model(m.key)).
+		Only(e.ctx)
+	switch {
+	case err == nil:
+		attempts = failure.Attempts + 1
+	case !ent.IsNotFound(err):
+		e.logger.Error("failed to query next embedding failure", zap.Error(err))
+		return
+	}
+
+	create := e.db.NextEmbeddingFailure.Create().
+		SetMessageID(message.ID).
+		SetModel(m.key).
+		SetAttempts(attempts).
+		SetError(cause.Error())
+	if attempts >= int(e.cfg.MaxAttempts) {
+		e.logger.Error("failed to re-embed message with the next model, giving up",
+			zap.Stringer("id", message.ID), zap.Int("attempts", attempts), zap.Error(cause))
+		create = create.SetFailedAt(time.Now())
+	} else {
+		e.logger.Warn("failed to re-embed message with the next model",
+			zap.Stringer("id", message.ID), zap.Int("attempts", attempts), zap.Error(cause))
+		create = create.SetRetryAt(time.Now().Add(e.backoff(attempts)))
+	}
+	err = create.
+		OnConflictColumns(entnextembeddingfailure.FieldMessageID, entnextembeddingfailure.FieldModel).
+		UpdateNewValues().
+		Exec(e.ctx)
+	if err != nil {
+		e.logger.Error("failed to record next embedding failure", zap.Error(err))
+	}
+}
+
+// saveNext saves the embeddings of the document by the next model, replacing
+// the ones of the previous text of its message.
+func (e *Embedding) saveNext(m *model, doc document, embeddings [][]float32) error {
+	message := doc.message
+	tx, err := e.db.Tx(e.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.NextEmbedding.Delete().Where(entnextembedding.MessageID(message.ID)).Exec(e.ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete next embeddings: %w", err))
+	}
+	creates := make([]*ent.NextEmbeddingCreate, len(doc.texts))
+	for i, text := range doc.texts {
+		creates[i] = tx.NextEmbedding.Create().
+			SetMessageID(message.ID).
+			SetIndex(i).
+			SetText(text).
+			SetEmbedding(pgvector.NewVector(embeddings[i])).
+			SetStrategy(doc.strategy).
+			SetModel(m.key)
+	}
+	if err = tx.NextEmbedding.CreateBulk(creates...).Exec(e.ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to save next embeddings: %w", err))
+	}
+
+	if err = tx.Message.UpdateOne(message).SetNextEmbeddingModel(m.key).Exec(e.ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to save next embedding model: %w", err))
+	}
+
+	_, err = tx.NextEmbeddingFailure.Delete().
+		Where(entnextembeddingfailure.MessageID(message.ID), entnextembeddingfailure.Model(m.key)).
+		Exec(e.ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete next embedding failure: %w", err))
+	}
+
+	return tx.Commit()
+}
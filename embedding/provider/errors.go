@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// InputError is returned by a provider when it rejects the inputs, such as
+// texts it cannot embed, so that retrying them is pointless.
+type InputError struct {
+	Err error
+}
+
+func (e *InputError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// UnavailableError is returned by a provider when its service is down or
+// overloaded.
+type UnavailableError struct {
+	Err error
+}
+
+func (e *UnavailableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// IsTransient reports whether the error is caused by the provider rather
+// than the inputs, like an unreachable, unavailable or throttled service or
+// a timeout, so that it goes away on its own.
+func IsTransient(err error) bool {
+	var unavailableErr *UnavailableError
+	var throttledErr *ThrottledError
+	var rateLimitErr *RateLimitError
+	var netErr net.Error
+	return errors.As(err, &unavailableErr) ||
+		errors.As(err, &throttledErr) ||
+		errors.As(err, &rateLimitErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// statusError returns the typed error for the HTTP status of a failed request.
+func statusError(err error, status int, retryAfter time.Duration) error {
+	switch status {
+	case http.StatusTooManyRequests:
+		return &RateLimitError{RetryAfter: retryAfter, Err: err}
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return &InputError{Err: err}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &UnavailableError{Err: err}
+	default:
+		return err
+	}
+}
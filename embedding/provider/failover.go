@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+// PrimaryName is the name of the configured provider among the failover providers.
+const PrimaryName = "primary"
+
+// Failover embeds with the first healthy provider routed for the task, and
+// falls back to the next one when it fails. A failed provider is only tried
+// as a last resort until its cooldown is over.
+type Failover struct {
+	members  []*member
+	query    []*member
+	document []*member
+	cooldown time.Duration
+	logger   *zap.Logger
+}
+
+var _ Provider = (*Failover)(nil)
+
+// member is a provider of the failover, along with its health.
+type member struct {
+	name     string
+	provider Provider
+
+	mu        sync.Mutex
+	downUntil time.Time
+	failures  int
+}
+
+func NewFailover(cfg *config.Embedding, db *database.Database, logger *zap.Logger) (*Failover, error) {
+	f := &Failover{
+		cooldown: cfg.Failover.Cooldown,
+		logger:   logger,
+	}
+	cfgs := append([]*config.Embedding{cfg}, lo.Map(cfg.Failover.Providers,
+		func(p config.FailoverProvider, _ int) *config.Embedding { return failoverConfig(cfg, p) })...)
+	names := append([]string{PrimaryName}, lo.Map(cfg.Failover.Providers,
+		func(p config.FailoverProvider, _ int) string { return p.Name })...)
+	for i, cfg := range cfgs {
+		if lo.IsEmpty(names[i]) {
+			return nil, f.closeWith(fmt.Errorf("failover provider %d has no name", i))
+		}
+		if lo.ContainsBy(f.members, func(m *member) bool { return m.name == names[i] }) {
+			return nil, f.closeWith(fmt.Errorf("duplicate failover provider: %s", names[i]))
+		}
+		p, err := create(cfg, db, logger)
+		if err != nil {
+			return nil, f.closeWith(fmt.Errorf("failed to create provider %s: %w", names[i], err))
+		}
+		f.members = append(f.members, &member{name: names[i], provider: p})
+	}
+
+	var err error
+	if f.query, err = f.route(cfg.Failover.Query); err != nil {
+		return nil, f.closeWith(err)
+	}
+	if f.document, err = f.route(cfg.Failover.Document); err != nil {
+		return nil, f.closeWith(err)
+	}
+	return f, nil
+}
+
+// failoverConfig returns the configuration of the failover provider, taking
+// the settings not set for it from the primary provider.
+func failoverConfig(primary *config.Embedding, p config.FailoverProvider) *config.Embedding {
+	cfg := *primary
+	cfg.Failover = config.Failover{}
+	if lo.IsNotEmpty(p.Provider) && p.Provider != primary.Provider {
+		cfg.Provider, cfg.BaseURL = p.Provider, ""
+	}
+	cfg.BaseURL = lo.CoalesceOrEmpty(p.BaseURL, cfg.BaseURL)
+	cfg.Model = lo.CoalesceOrEmpty(p.Model, cfg.Model)
+	cfg.Timeout = lo.CoalesceOrEmpty(p.Timeout, cfg.Timeout)
+	cfg.RateLimit = p.RateLimit
+	if lo.IsNotEmpty(p.APIKey) {
+		switch cfg.Provider {
+		case types.TypeOpenAI:
+			cfg.OpenAI.APIKey = p.APIKey
+		case types.TypeGoogle:
+			cfg.Google.APIKey = p.APIKey
+		}
+	}
+	return &cfg
+}
+
+// route returns the members with the names, or all members if none is given.
+func (f *Failover) route(names []string) ([]*member, error) {
+	if len(names) == 0 {
+		return f.members, nil
+	}
+	route := make([]*member, len(names))
+	for i, name := range names {
+		m, ok := lo.Find(f.members, func(m *member) bool { return m.name == name })
+		if !ok {
+			return nil, fmt.Errorf("unknown failover provider: %s", name)
+		}
+		route[i] = m
+	}
+	return route, nil
+}
+
+func (f *Failover) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return f.embed(ctx, f.query, func(p Provider) ([][]float32, error) { return p.EmbedQuery(ctx, inputs) })
+}
+
+func (f *Failover) EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error) {
+	return f.embed(ctx, f.document, func(p Provider) ([][]float32, error) { return p.EmbedDocuments(ctx, inputs) })
+}
+
+// embed tries the healthy members of the route in order, then the ones
+// cooling down, until one of them succeeds. It only falls back when a member
+// is unavailable, throttled or unreachable, and returns any other error, like
+// rejected inputs, right away.
+func (f *Failover) embed(ctx context.Context, route []*member, fn func(p Provider) ([][]float32, error)) ([][]float32, error) {
+	now := time.Now()
+	healthy, down := lo.FilterReject(route, func(m *member, _ int) bool { return m.healthy(now) })
+
+	var errs []error
+	for _, m := range append(healthy, down...) {
+		embeddings, err := fn(m.provider)
+		if err == nil {
+			if m.succeed() {
+				f.logger.Info("embedding provider recovered", zap.String("provider", m.name))
+			}
+			return embeddings, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		// the other providers would reject the inputs just as well, and the
+		// provider is not failing for rejecting them
+		if !IsTransient(err) {
+			return nil, fmt.Errorf("%s: %w", m.name, err)
+		}
+		failures := m.fail(f.cooldown)
+		f.logger.Warn("embedding provider failed, falling back",
+			zap.String("provider", m.name), zap.Int("failures", failures), zap.Error(err))
+		errs = append(errs, fmt.Errorf("%s: %w", m.name, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+// Tokenizer returns the most conservative estimate of the providers, which
+// counts the most tokens.
+func (f *Failover) Tokenizer() tokenizer.Tokenizer {
+	return lo.MinBy(lo.Map(f.members, func(m *member, _ int) tokenizer.Tokenizer { return m.provider.Tokenizer() }),
+		func(a, b tokenizer.Tokenizer) bool { return a.CharsPerToken() < b.CharsPerToken() })
+}
+
+func (f *Failover) Close() error {
+	return errors.Join(lo.Map(f.members, func(m *member, _ int) error { return m.provider.Close() })...)
+}
+
+// closeWith closes the providers created so far, and returns the error.
+func (f *Failover) closeWith(err error) error {
+	return errors.Join(err, f.Close())
+}
+
+func (m *member) healthy(now time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return !now.Before(m.downUntil)
+}
+
+// fail records a failure of the member, which is skipped for the cooldown,
+// and returns its consecutive failures.
+func (m *member) fail(cooldown time.Duration) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures++
+	m.downUntil = time.Now().Add(cooldown)
+	return m.failures
+}
+
+// succeed resets the failures of the member, and reports whether it had any.
+func (m *member) succeed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	failed := m.failures > 0
+	m.failures, m.downUntil = 0, time.Time{}
+	return failed
+}
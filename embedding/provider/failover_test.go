@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
+	"go.uber.org/zap"
+)
+
+// fakeProvider embeds each input as its length, or fails with err if set.
+type fakeProvider struct {
+	err      error
+	requests int
+}
+
+func (p *fakeProvider) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return p.EmbedDocuments(ctx, inputs)
+}
+
+func (p *fakeProvider) EmbedDocuments(_ context.Context, inputs []string) ([][]float32, error) {
+	p.requests++
+	if p.err != nil {
+		return nil, p.err
+	}
+	embeddings := make([][]float32, len(inputs))
+	for i, input := range inputs {
+		embeddings[i] = []float32{float32(len(input))}
+	}
+	return embeddings, nil
+}
+
+func (p *fakeProvider) Tokenizer() tokenizer.Tokenizer {
+	return tokenizer.Default
+}
+
+func (p *fakeProvider) Close() error {
+	return nil
+}
+
+func newTestFailover(providers ...*fakeProvider) *Failover {
+	f := &Failover{cooldown: time.Minute, logger: zap.NewNop()}
+	for i, p := range providers {
+		f.members = append(f.members, &member{name: string(rune('a' + i)), provider: p})
+	}
+	f.query, f.document = f.members, f.members
+	return f
+}
+
+func TestFailoverEmbed(t *testing.T) {
+	inputErr := &InputError{Err: errors.New("400 Bad Request")}
+	unavailableErr := &UnavailableError{Err: errors.New("503 Service Unavailable")}
+	serverErr := errors.New("500 Internal Server Error")
+	tests := []struct {
+		name      string
+		primary   error
+		backup    error
+		wantErr   error
+		transient bool
+		// requests are the requests made to the primary and the backup.
+		requests [2]int
+		// down tells which of them are cooling down afterwards.
+		down [2]bool
+	}{
+		{
+			name:     "primary succeeds",
+			requests: [2]int{1, 0},
+		},
+		{
+			name:     "primary unavailable",
+			primary:  unavailableErr,
+			requests: [2]int{1, 1},
+			down:     [2]bool{true, false},
+		},
+		{
+			name:      "both unavailable",
+			primary:   unavailableErr,
+			backup:    unavailableErr,
+			wantErr:   unavailableErr,
+			transient: true,
+			requests:  [2]int{1, 1},
+			down:      [2]bool{true, true},
+		},
+		{
+			// the rejected inputs are not sent to the backup, which is down
+			name:     "inputs rejected",
+			primary:  inputErr,
+			backup:   unavailableErr,
+			wantErr:  inputErr,
+			requests: [2]int{1, 0},
+		},
+		{
+			name:     "unclassified error",
+			primary:  serverErr,
+			backup:   unavailableErr,
+			wantErr:  serverErr,
+			requests: [2]int{1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, backup := &fakeProvider{err: tt.primary}, &fakeProvider{err: tt.backup}
+			f := newTestFailover(primary, backup)
+
+			_, err := f.EmbedDocuments(context.Background(), []string{"text"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if IsTransient(err) != tt.transient {
+				t.Errorf("IsTransient(%v) = %v, want %v", err, IsTransient(err), tt.transient)
+			}
+			if got := [2]int{primary.requests, backup.requests}; got != tt.requests {
+				t.Errorf("requests = %v, want %v", got, tt.requests)
+			}
+			now := time.Now()
+			if got := [2]bool{!f.members[0].healthy(now), !f.members[1].healthy(now)}; got != tt.down {
+				t.Errorf("down = %v, want %v", got, tt.down)
+			}
+		})
+	}
+}
@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/generative-ai-go/genai"
+	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/samber/lo"
 	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
 	"github.com/xyenon/telemikiya/types"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 )
 
@@ -46,15 +50,27 @@ func NewGoogle(cfg *config.Embedding) (Provider, error) {
 	return o, nil
 }
 
-func (g Google) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+func (g Google) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return g.embed(ctx, taskQuery, inputs)
+}
+
+func (g Google) EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error) {
+	return g.embed(ctx, taskDocument, inputs)
+}
+
+func (g Google) embed(ctx context.Context, t task, inputs []string) ([][]float32, error) {
 	em := g.client.EmbeddingModel(g.cfg.Model)
+	em.TaskType = genai.TaskTypeRetrievalDocument
+	if t == taskQuery {
+		em.TaskType = genai.TaskTypeRetrievalQuery
+	}
 	b := em.NewBatch()
-	for _, input := range inputs {
+	for _, input := range withPrefix(g.cfg, t, inputs) {
 		b = b.AddContent(genai.Text(input))
 	}
 	resp, err := em.BatchEmbedContents(ctx, b)
 	if err != nil {
-		return nil, fmt.Errorf("failed to embed: %w", err)
+		return nil, fmt.Errorf("failed to embed: %w", googleError(err))
 	}
 	embeddings := lo.Map(resp.Embeddings,
 		func(e *genai.ContentEmbedding, _ int) []float32 { return e.Values },
@@ -62,6 +78,25 @@ func (g Google) Embed(ctx context.Context, inputs []string) ([][]float32, error)
 	return embeddings, nil
 }
 
+func (g Google) Tokenizer() tokenizer.Tokenizer {
+	return estimate(g.cfg, tokenizer.Default.CharsPerToken())
+}
+
+// googleError returns the typed error for the status of a failed request.
+// Google tells how long to wait when throttled in the retry info of the
+// error, if at all.
+func googleError(err error) error {
+	var apiErr *apierror.APIError
+	if errors.As(err, &apiErr) {
+		return statusError(err, apiErr.HTTPCode(), apiErr.Details().RetryInfo.GetRetryDelay().AsDuration())
+	}
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		return statusError(err, googleErr.Code, parseRetryAfter(googleErr.Header))
+	}
+	return err
+}
+
 func (g Google) Close() error {
 	return g.client.Close()
 }
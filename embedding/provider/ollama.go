@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	ollamaapi "github.com/ollama/ollama/api"
 	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -33,20 +35,38 @@ func NewOllama(cfg *config.Embedding) (Provider, error) {
 	return o, nil
 }
 
-func (o Ollama) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+func (o Ollama) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return o.embed(ctx, taskQuery, inputs)
+}
+
+func (o Ollama) EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error) {
+	return o.embed(ctx, taskDocument, inputs)
+}
+
+func (o Ollama) embed(ctx context.Context, t task, inputs []string) ([][]float32, error) {
 	req := &ollamaapi.EmbedRequest{
 		Model:     o.cfg.Model,
-		Input:     inputs,
+		Input:     withPrefix(o.cfg, t, inputs),
 		KeepAlive: &ollamaapi.Duration{Duration: o.cfg.Ollama.KeepAlive},
 		Options:   o.cfg.Ollama.ModelParameters,
 	}
 	resp, err := o.client.Embed(ctx, req)
 	if err != nil {
+		var statusErr ollamaapi.StatusError
+		if errors.As(err, &statusErr) {
+			err = statusError(err, statusErr.StatusCode, 0)
+		}
 		return nil, fmt.Errorf("failed to embed: %w", err)
 	}
 	return resp.Embeddings, nil
 }
 
+// Tokenizer estimates the tokens of the WordPiece vocabularies of most
+// embedding models served by Ollama, which split words more than BPE.
+func (o Ollama) Tokenizer() tokenizer.Tokenizer {
+	return estimate(o.cfg, 3)
+}
+
 func (o Ollama) Close() error {
 	return nil
 }
@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 	"github.com/openai/openai-go/packages/param"
 	"github.com/samber/lo"
 	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
 	"github.com/xyenon/telemikiya/types"
 )
 
@@ -45,16 +48,24 @@ func NewOpenAI(cfg *config.Embedding) (Provider, error) {
 	return o, nil
 }
 
-func (o OpenAI) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+func (o OpenAI) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return o.embed(ctx, taskQuery, inputs)
+}
+
+func (o OpenAI) EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error) {
+	return o.embed(ctx, taskDocument, inputs)
+}
+
+func (o OpenAI) embed(ctx context.Context, t task, inputs []string) ([][]float32, error) {
 	body := openai.EmbeddingNewParams{
-		Input:          openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: inputs},
+		Input:          openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: withPrefix(o.cfg, t, inputs)},
 		Model:          o.cfg.Model,
 		Dimensions:     param.NewOpt(int64(o.cfg.Dimensions)),
 		EncodingFormat: openai.EmbeddingNewParamsEncodingFormatFloat,
 	}
 	resp, err := o.client.Embeddings.New(ctx, body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to embed: %w", err)
+		return nil, fmt.Errorf("failed to embed: %w", openAIError(err))
 	}
 	embeddings := make([][]float32, len(resp.Data))
 	for _, e := range resp.Data {
@@ -67,6 +78,23 @@ func (o OpenAI) Embed(ctx context.Context, inputs []string) ([][]float32, error)
 	return embeddings, nil
 }
 
+func (o OpenAI) Tokenizer() tokenizer.Tokenizer {
+	return estimate(o.cfg, tokenizer.Default.CharsPerToken())
+}
+
+// openAIError returns the typed error for the status of a failed request.
+func openAIError(err error) error {
+	var apiErr *openai.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	var retryAfter time.Duration
+	if apiErr.Response != nil {
+		retryAfter = parseRetryAfter(apiErr.Response.Header)
+	}
+	return statusError(err, apiErr.StatusCode, retryAfter)
+}
+
 func (o OpenAI) Close() error {
 	return nil
 }
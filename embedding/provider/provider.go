@@ -4,35 +4,92 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/samber/lo"
 	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
 	"github.com/xyenon/telemikiya/types"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
+// Provider embeds texts. Asymmetric models embed search queries and the
+// documents being searched differently, by prefixes or task types.
 type Provider interface {
-	Embed(ctx context.Context, inputs []string) ([][]float32, error)
+	// EmbedQuery embeds search queries.
+	EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error)
+	// EmbedDocuments embeds the texts being searched, such as messages.
+	EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error)
+	// Tokenizer estimates the tokens of texts for the model.
+	Tokenizer() tokenizer.Tokenizer
 	Close() error
 }
 
+// task is what texts are embedded for.
+type task int
+
+const (
+	taskQuery task = iota
+	taskDocument
+)
+
+// estimate returns the tokenizer estimate of the provider, unless it is
+// overridden by the configuration.
+func estimate(cfg *config.Embedding, charsPerToken float64) tokenizer.Tokenizer {
+	return tokenizer.New(lo.CoalesceOrEmpty(cfg.CharsPerToken, charsPerToken))
+}
+
+// withPrefix prepends the configured prefix of the task to the inputs.
+func withPrefix(cfg *config.Embedding, t task, inputs []string) []string {
+	prefix := cfg.DocumentPrefix
+	if t == taskQuery {
+		prefix = cfg.QueryPrefix
+	}
+	if lo.IsEmpty(prefix) {
+		return inputs
+	}
+	return lo.Map(inputs, func(input string, _ int) string { return prefix + input })
+}
+
 type Params struct {
 	fx.In
 
 	LifeCycle fx.Lifecycle
 	Config    *config.Config
+	Logger    *zap.Logger
+	Database  *database.Database
 }
 
+// New returns the configured provider, which falls back to the failover
+// providers if any are configured.
 func New(params Params) (Provider, error) {
-	newProvider, ok := availableProviders[params.Config.Embedding.Provider]
+	return newFromConfig(params.LifeCycle, params.Logger, params.Database, &params.Config.Embedding)
+}
+
+// NewNext returns the provider of the next embedding model, or nil if no next
+// model is configured.
+func NewNext(params Params) (Provider, error) {
+	cfg, ok := params.Config.Embedding.NextModel()
 	if !ok {
-		return nil, fmt.Errorf("unknown provider: %s", params.Config.Embedding.Provider)
+		return nil, nil
+	}
+	return newFromConfig(params.LifeCycle, params.Logger, params.Database, cfg)
+}
+
+func newFromConfig(lifeCycle fx.Lifecycle, logger *zap.Logger, db *database.Database, cfg *config.Embedding) (Provider, error) {
+	var p Provider
+	var err error
+	if len(cfg.Failover.Providers) > 0 {
+		p, err = NewFailover(cfg, db, logger)
+	} else {
+		p, err = create(cfg, db, logger)
 	}
-	p, err := newProvider(&params.Config.Embedding)
 	if err != nil {
 		return nil, err
 	}
 
-	if params.LifeCycle != nil {
-		params.LifeCycle.Append(fx.Hook{
+	if lifeCycle != nil {
+		lifeCycle.Append(fx.Hook{
 			OnStop: func(ctx context.Context) error {
 				return p.Close()
 			},
@@ -42,6 +99,28 @@ func New(params Params) (Provider, error) {
 	return p, nil
 }
 
+// create creates a provider of the configured type, limited to its rate limit.
+func create(cfg *config.Embedding, db *database.Database, logger *zap.Logger) (Provider, error) {
+	newProvider, ok := availableProviders[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown provider: %s", cfg.Provider)
+	}
+	p, err := newProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.RateLimit == (config.RateLimit{}) {
+		return p, nil
+	}
+	// the spending is counted for each model and endpoint, which are usually
+	// billed and capped separately
+	name := fmt.Sprintf("%s:%s", cfg.Provider, cfg.Model)
+	if lo.IsNotEmpty(cfg.BaseURL) {
+		name += "@" + cfg.BaseURL
+	}
+	return NewLimited(p, &cfg.RateLimit, db, name, logger.With(zap.String("provider", name))), nil
+}
+
 type newProviderFunc func(cfg *config.Embedding) (Provider, error)
 
 var availableProviders = map[types.ProviderType]newProviderFunc{}
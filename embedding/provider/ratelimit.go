@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/embedding/tokenizer"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxThrottledRetries is how many times a throttled request is retried.
+	maxThrottledRetries = 3
+	// defaultRetryAfter is how long to wait when throttled without being
+	// told how long.
+	defaultRetryAfter = 10 * time.Second
+)
+
+// RateLimitError is returned by a provider when its requests are throttled.
+type RateLimitError struct {
+	// RetryAfter is how long to wait before retrying, if the provider told.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// ThrottledError is returned by Limited when the provider is still throttled
+// after retrying, or right away for search queries, so that the caller backs
+// off for at least RetryAfter or falls back to another provider instead of
+// retrying right away.
+type ThrottledError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("embedding provider is throttled: %s", e.Err)
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter returns how long to wait before retrying after the error, if the
+// provider is throttled and told how long.
+func RetryAfter(err error) time.Duration {
+	var throttledErr *ThrottledError
+	if errors.As(err, &throttledErr) {
+		return throttledErr.RetryAfter
+	}
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter
+	}
+	return 0
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// Limited limits the use of a provider to the configured rates and daily
+// spend, and waits as long as the provider tells when it is throttled.
+type Limited struct {
+	provider Provider
+	cfg      *config.RateLimit
+	requests *rate.Limiter
+	tokens   *rate.Limiter
+	logger   *zap.Logger
+
+	// db keeps the spending of each day, so that the daily spend cap holds
+	// across restarts.
+	db *database.Database
+	// name identifies the model and endpoint the spending is counted for.
+	name string
+
+	mu             sync.Mutex
+	throttledUntil time.Time
+}
+
+var _ Provider = (*Limited)(nil)
+
+func NewLimited(provider Provider, cfg *config.RateLimit, db *database.Database, name string, logger *zap.Logger) *Limited {
+	l := &Limited{
+		provider: provider,
+		cfg:      cfg,
+		logger:   logger,
+		db:       db,
+		name:     name,
+	}
+	if cfg.RequestsPerMinute > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(float64(cfg.RequestsPerMinute)/60), int(cfg.RequestsPerMinute))
+	}
+	if cfg.TokensPerMinute > 0 {
+		l.tokens = rate.NewLimiter(rate.Limit(float64(cfg.TokensPerMinute)/60), int(cfg.TokensPerMinute))
+	}
+	return l
+}
+
+// EmbedQuery embeds search queries without waiting for a throttled provider,
+// as they are interactive and better served by another provider meanwhile.
+func (l *Limited) EmbedQuery(ctx context.Context, inputs []string) ([][]float32, error) {
+	return l.embed(ctx, inputs, 0, l.provider.EmbedQuery)
+}
+
+// EmbedDocuments embeds the documents, waiting for the next day if the daily
+// spend cap is reached.
+func (l *Limited) EmbedDocuments(ctx context.Context, inputs []string) ([][]float32, error) {
+	if err := l.waitForBudget(ctx); err != nil {
+		return nil, err
+	}
+	return l.embed(ctx, inputs, maxThrottledRetries, l.provider.EmbedDocuments)
+}
+
+// embed embeds the inputs, retrying up to retries times when throttled.
+// Without retries, it does not wait for the provider to be no longer
+// throttled either.
+func (l *Limited) embed(
+	ctx context.Context,
+	inputs []string,
+	retries int,
+	embed func(ctx context.Context, inputs []string) ([][]float32, error),
+) ([][]float32, error) {
+	tokens := lo.SumBy(inputs, l.provider.Tokenizer().Count)
+	for retry := 0; ; retry++ {
+		if retries == 0 {
+			l.mu.Lock()
+			throttledUntil := l.throttledUntil
+			l.mu.Unlock()
+			if delay := time.Until(throttledUntil); delay > 0 {
+				return nil, &ThrottledError{
+					RetryAfter: delay,
+					Err:        fmt.Errorf("throttled until %s", throttledUntil.Format(time.RFC3339)),
+				}
+			}
+		}
+		if err := l.wait(ctx, tokens); err != nil {
+			return nil, err
+		}
+		embeddings, err := embed(ctx, inputs)
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			if err == nil {
+				l.spend(ctx, tokens)
+			}
+			return embeddings, err
+		}
+
+		delay := lo.CoalesceOrEmpty(rateLimitErr.RetryAfter, defaultRetryAfter)
+		l.mu.Lock()
+		l.throttledUntil = lo.Latest(l.throttledUntil, time.Now().Add(delay))
+		l.mu.Unlock()
+		if retry >= retries {
+			return nil, &ThrottledError{RetryAfter: delay, Err: err}
+		}
+		l.logger.Warn("embedding provider is throttled, waiting", zap.Duration("delay", delay), zap.Error(err))
+	}
+}
+
+// wait waits until the provider is no longer throttled, and the request with
+// the tokens is within the rate limits.
+func (l *Limited) wait(ctx context.Context, tokens int) error {
+	l.mu.Lock()
+	throttledUntil := l.throttledUntil
+	l.mu.Unlock()
+	if err := sleepUntil(ctx, throttledUntil); err != nil {
+		return err
+	}
+
+	if l.requests != nil {
+		if err := l.requests.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if l.tokens != nil {
+		// a request larger than the burst can never be allowed otherwise
+		if err := l.tokens.WaitN(ctx, min(tokens, l.tokens.Burst())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForBudget waits for the next day if the daily spend cap is reached.
+func (l *Limited) waitForBudget(ctx context.Context) error {
+	if l.cfg.DailySpendCap <= 0 {
+		return nil
+	}
+	for {
+		day := today()
+		spent, err := l.db.EmbeddingSpend(ctx, day, l.name)
+		if err != nil {
+			return err
+		}
+		if spent < l.cfg.DailySpendCap {
+			return nil
+		}
+
+		next := day.AddDate(0, 0, 1)
+		l.logger.Warn("daily spend cap reached, pausing indexing",
+			zap.Float64("spent", spent), zap.Time("until", next))
+		if err := sleepUntil(ctx, next); err != nil {
+			return err
+		}
+	}
+}
+
+// spend counts the tokens towards the spending of the day.
+func (l *Limited) spend(ctx context.Context, tokens int) {
+	if l.cfg.PricePerMillionTokens <= 0 {
+		return
+	}
+	amount := float64(tokens) * l.cfg.PricePerMillionTokens / 1_000_000
+	if err := l.db.AddEmbeddingSpend(ctx, today(), l.name, amount); err != nil {
+		l.logger.Error("failed to count spending", zap.Float64("amount", amount), zap.Error(err))
+	}
+}
+
+// today returns the UTC day the spending is counted for.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func (l *Limited) Tokenizer() tokenizer.Tokenizer {
+	return l.provider.Tokenizer()
+}
+
+func (l *Limited) Close() error {
+	return l.provider.Close()
+}
+
+// sleepUntil waits until the time unless the context is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xyenon/telemikiya/config"
+	"go.uber.org/zap"
+)
+
+func TestLimitedEmbedQueryThrottled(t *testing.T) {
+	p := &fakeProvider{err: &RateLimitError{RetryAfter: time.Minute, Err: errors.New("429 Too Many Requests")}}
+	l := NewLimited(p, &config.RateLimit{}, nil, "fake", zap.NewNop())
+
+	// a throttled query is not retried
+	_, err := l.EmbedQuery(context.Background(), []string{"query"})
+	var throttledErr *ThrottledError
+	if !errors.As(err, &throttledErr) || throttledErr.RetryAfter != time.Minute {
+		t.Fatalf("error = %v, want throttled for a minute", err)
+	}
+	if p.requests != 1 {
+		t.Errorf("got %d requests, want 1", p.requests)
+	}
+
+	// nor sent while the provider is throttled
+	_, err = l.EmbedQuery(context.Background(), []string{"query"})
+	if !errors.As(err, &throttledErr) || throttledErr.RetryAfter <= 0 || throttledErr.RetryAfter > time.Minute {
+		t.Fatalf("error = %v, want throttled for up to a minute", err)
+	}
+	if p.requests != 1 {
+		t.Errorf("got %d requests, want 1", p.requests)
+	}
+}
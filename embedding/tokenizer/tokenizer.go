@@ -0,0 +1,177 @@
+// Package tokenizer estimates how embedding models split text into tokens,
+// without depending on the vocabulary of a specific model.
+package tokenizer
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokenizer estimates the tokens of a model by the average number of
+// characters of its tokens within a word.
+type Tokenizer struct {
+	charsPerToken float64
+}
+
+// Default is the estimate for common BPE vocabularies, whose tokens are about
+// 4 characters long in English.
+var Default = Tokenizer{charsPerToken: 4}
+
+func New(charsPerToken float64) Tokenizer {
+	if charsPerToken <= 0 {
+		return Default
+	}
+	return Tokenizer{charsPerToken: charsPerToken}
+}
+
+// CharsPerToken is the average number of characters of a token within a word.
+func (t Tokenizer) CharsPerToken() float64 {
+	return t.charsPerToken
+}
+
+// segment is a piece of text that is never split, which is a word, a single
+// CJK character or punctuation, along with the whitespace following it.
+type segment struct {
+	text   string
+	tokens int
+}
+
+// boundary reports whether the segment ends a sentence or a line,
+// which is where chunks are preferably split.
+func (s segment) boundary() bool {
+	if strings.ContainsRune(s.text, '\n') {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(strings.TrimRightFunc(s.text, unicode.IsSpace))
+	return strings.ContainsRune(".!?。！？", r)
+}
+
+func (t Tokenizer) segments(text string) (segs []segment) {
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		end, tokens := size, 1
+		if isWordRune(r) && !isCJK(r) {
+			for end < len(text) {
+				r, size := utf8.DecodeRuneInString(text[end:])
+				if !isWordRune(r) || isCJK(r) {
+					break
+				}
+				end += size
+			}
+			tokens = int(math.Ceil(float64(utf8.RuneCountInString(text[:end])) / t.charsPerToken))
+		} else if unicode.IsSpace(r) {
+			tokens = 0
+		}
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			end += size
+		}
+		segs = append(segs, segment{text: text[:end], tokens: tokens})
+		text = text[end:]
+	}
+	return segs
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// isCJK reports whether the rune is written without spaces between words,
+// so that it is usually a token on its own.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Thai)
+}
+
+// Count estimates the number of tokens of the text.
+func (t Tokenizer) Count(text string) (tokens int) {
+	for _, seg := range t.segments(text) {
+		tokens += seg.tokens
+	}
+	return tokens
+}
+
+// Split splits the text into chunks of at most maxTokens tokens, preferably at
+// the end of a sentence or line. Each chunk after the first starts with about
+// overlap tokens from the end of the previous one, so that text around the
+// split is kept together in at least one chunk.
+func (t Tokenizer) Split(text string, maxTokens, overlap int) []string {
+	maxTokens = max(maxTokens, 1)
+	overlap = min(max(overlap, 0), maxTokens/2)
+	segs := t.splitLongSegments(t.segments(text), maxTokens)
+
+	var chunks []string
+	for start := 0; start < len(segs); {
+		end, tokens := start, 0
+		for end < len(segs) && (end == start || tokens+segs[end].tokens <= maxTokens) {
+			tokens += segs[end].tokens
+			end++
+		}
+		if end < len(segs) {
+			// prefer splitting at a boundary in the second half of the chunk
+			for i, t := end, tokens; i > start+1 && t > maxTokens/2; i-- {
+				if segs[i-1].boundary() {
+					end = i
+					break
+				}
+				t -= segs[i-1].tokens
+			}
+		}
+		chunks = append(chunks, join(segs[start:end]))
+		if end == len(segs) {
+			break
+		}
+
+		next, tokens := end, 0
+		for next > start+1 && tokens+segs[next-1].tokens <= overlap {
+			next--
+			tokens += segs[next].tokens
+		}
+		start = next
+	}
+	return chunks
+}
+
+// Truncate returns the beginning of the text with at most maxTokens tokens,
+// and reports whether the text was truncated.
+func (t Tokenizer) Truncate(text string, maxTokens int) (string, bool) {
+	maxTokens = max(maxTokens, 1)
+	segs := t.splitLongSegments(t.segments(text), maxTokens)
+	end, tokens := 0, 0
+	for end < len(segs) && tokens+segs[end].tokens <= maxTokens {
+		tokens += segs[end].tokens
+		end++
+	}
+	if end == len(segs) {
+		return text, false
+	}
+	return join(segs[:end]), true
+}
+
+// splitLongSegments splits the words longer than maxTokens, such as long URLs
+// or encoded data, so that no chunk exceeds maxTokens.
+func (t Tokenizer) splitLongSegments(segs []segment, maxTokens int) []segment {
+	result := make([]segment, 0, len(segs))
+	for _, seg := range segs {
+		for seg.tokens > maxTokens {
+			runes := []rune(seg.text)
+			head := string(runes[:max(int(float64(maxTokens)*t.charsPerToken), 1)])
+			result = append(result, segment{text: head, tokens: maxTokens})
+			seg = segment{text: seg.text[len(head):], tokens: seg.tokens - maxTokens}
+		}
+		result = append(result, seg)
+	}
+	return result
+}
+
+func join(segs []segment) string {
+	var b strings.Builder
+	for _, seg := range segs {
+		b.WriteString(seg.text)
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,192 @@
+package tokenizer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "whitespace", text: " \n\t ", want: 0},
+		{name: "words", text: "hello world", want: 2 + 2},
+		{name: "short words", text: "one two six", want: 3},
+		{name: "punctuation", text: "a, b.", want: 4},
+		{name: "accented", text: "naïve café", want: 2 + 1},
+		{name: "number", text: "3.14159", want: 1 + 1 + 2},
+		{name: "url", text: "https://example.com", want: 2 + 1 + 1 + 1 + 2 + 1 + 1},
+		{name: "cjk", text: "東京タワー", want: 5},
+		{name: "mixed", text: "東京 tower", want: 2 + 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Default.Count(tt.text); got != tt.want {
+				t.Errorf("Count(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountCharsPerToken(t *testing.T) {
+	if got := New(2).Count("hello world"); got != 3+3 {
+		t.Errorf("Count = %d, want 6", got)
+	}
+	if New(0) != Default {
+		t.Error("New(0) is not Default")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	const words = "one two three four five six"
+	tests := []struct {
+		name      string
+		text      string
+		maxTokens int
+		overlap   int
+		want      []string
+	}{
+		{
+			name:      "fits",
+			text:      "short",
+			maxTokens: 10,
+			overlap:   2,
+			want:      []string{"short"},
+		},
+		{
+			name:      "empty",
+			text:      "",
+			maxTokens: 3,
+			want:      nil,
+		},
+		{
+			name:      "no overlap",
+			text:      words,
+			maxTokens: 3,
+			want:      []string{"one two", "three four", "five six"},
+		},
+		{
+			// "three" has 2 tokens, more than the overlap allows
+			name:      "overlap",
+			text:      words,
+			maxTokens: 3,
+			overlap:   1,
+			want:      []string{"one two", "two three", "four five six"},
+		},
+		{
+			// the overlap is at most half of a chunk, so that chunks advance
+			name:      "overlap equal to max tokens",
+			text:      words,
+			maxTokens: 3,
+			overlap:   3,
+			want:      []string{"one two", "two three", "four five six"},
+		},
+		{
+			name:      "overlap above max tokens",
+			text:      words,
+			maxTokens: 3,
+			overlap:   10,
+			want:      []string{"one two", "two three", "four five six"},
+		},
+		{
+			name:      "sentence boundary",
+			text:      "First sentence here. Second one is longer than that.",
+			maxTokens: 6,
+			want:      []string{"First sentence here.", "Second one is longer", "than that."},
+		},
+		{
+			name:      "line boundary",
+			text:      "line one\nline two\nline three",
+			maxTokens: 4,
+			want:      []string{"line one\nline two", "line three"},
+		},
+		{
+			// a word longer than a chunk is split within the word
+			name:      "long word",
+			text:      strings.Repeat("a", 20),
+			maxTokens: 2,
+			want:      []string{"aaaaaaaa", "aaaaaaaa", "aaaa"},
+		},
+		{
+			name:      "long word with overlap",
+			text:      strings.Repeat("a", 20),
+			maxTokens: 2,
+			overlap:   5,
+			want:      []string{"aaaaaaaa", "aaaaaaaa", "aaaa"},
+		},
+		{
+			name:      "single token chunks",
+			text:      words,
+			maxTokens: 1,
+			overlap:   1,
+			want:      []string{"one", "two", "thre", "e", "four", "five", "six"},
+		},
+		{
+			name:      "zero max tokens",
+			text:      "a b c",
+			maxTokens: 0,
+			want:      []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Default.Split(tt.text, tt.maxTokens, tt.overlap)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q, %d, %d) = %q, want %q", tt.text, tt.maxTokens, tt.overlap, got, tt.want)
+			}
+			for _, chunk := range got {
+				if tokens := Default.Count(chunk); tokens > max(tt.maxTokens, 1) {
+					t.Errorf("chunk %q has %d tokens, more than %d", chunk, tokens, tt.maxTokens)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitOverlap(t *testing.T) {
+	text := "alpha beta gamma delta epsilon zeta eta theta iota kappa lambda mu"
+	chunks := Default.Split(text, 6, 2)
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want at least 2", len(chunks))
+	}
+	for i := 1; i < len(chunks); i++ {
+		prev, next := strings.Fields(chunks[i-1]), strings.Fields(chunks[i])
+		if prev[len(prev)-1] != next[0] {
+			t.Errorf("chunk %q does not start with the end of %q", chunks[i], chunks[i-1])
+		}
+	}
+	if last := strings.Fields(chunks[len(chunks)-1]); last[len(last)-1] != "mu" {
+		t.Errorf("last chunk %q does not end the text", chunks[len(chunks)-1])
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		maxTokens     int
+		want          string
+		wantTruncated bool
+	}{
+		{name: "empty", text: "", maxTokens: 1, want: ""},
+		{name: "fits", text: "one two", maxTokens: 3, want: "one two"},
+		{name: "exactly fits", text: "one two", maxTokens: 2, want: "one two"},
+		{name: "words", text: "one two three four", maxTokens: 3, want: "one two", wantTruncated: true},
+		{name: "long word", text: strings.Repeat("a", 20), maxTokens: 2, want: "aaaaaaaa", wantTruncated: true},
+		{name: "cjk", text: "東京タワー", maxTokens: 2, want: "東京", wantTruncated: true},
+		{name: "zero max tokens", text: "hello", maxTokens: 0, want: "hell", wantTruncated: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated := Default.Truncate(tt.text, tt.maxTokens)
+			if got != tt.want || truncated != tt.wantTruncated {
+				t.Errorf("Truncate(%q, %d) = %q, %v, want %q, %v",
+					tt.text, tt.maxTokens, got, truncated, tt.want, tt.wantTruncated)
+			}
+		})
+	}
+}
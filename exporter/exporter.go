@@ -0,0 +1,207 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/searcher"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/fx"
+)
+
+// pageSize is the number of messages fetched per query when exporting.
+const pageSize = 1000
+
+type Params struct {
+	fx.In
+
+	Database *database.Database
+	Searcher *searcher.Searcher
+	Config   *config.Config
+}
+
+// Exporter writes stored messages out in a portable format.
+type Exporter struct {
+	db       *database.Database
+	searcher *searcher.Searcher
+	cfg      *config.Config
+}
+
+func New(params Params) *Exporter {
+	return &Exporter{
+		db:       params.Database,
+		searcher: params.Searcher,
+		cfg:      params.Config,
+	}
+}
+
+type ExportParams struct {
+	Format         Format
+	DialogID       int64
+	SenderID       int64
+	SenderName     string
+	StartTime      time.Time
+	EndTime        time.Time
+	IncludeDeleted bool
+	// WithEmbeddings includes the embedding of each message, only supported by JSONL.
+	WithEmbeddings bool
+	// Query exports the results of searching for it instead of all matching messages.
+	Query string
+	// Count is the maximum number of search results to export.
+	Count uint
+}
+
+// Export writes the messages matching the params to w and returns how many
+// were written. Messages are ordered by dialog and message ID, except for
+// search results which are ordered by rank.
+func (e Exporter) Export(ctx context.Context, w io.Writer, params ExportParams) (int, error) {
+	if params.WithEmbeddings && params.Format != FormatJSONL {
+		return 0, fmt.Errorf("embeddings can only be exported as %s", FormatJSONL)
+	}
+	mw, err := newWriter(w, params)
+	if err != nil {
+		return 0, err
+	}
+
+	var written int
+	write := func(messages []*ent.Message) error {
+		for _, message := range messages {
+			if err := mw.write(message); err != nil {
+				return fmt.Errorf("failed to write message: %w", err)
+			}
+			written++
+		}
+		return nil
+	}
+	if lo.IsNotEmpty(params.Query) {
+		err = e.exportSearch(ctx, params, write)
+	} else {
+		err = e.exportAll(ctx, params, write)
+	}
+	if err != nil {
+		return written, err
+	}
+
+	if err = mw.flush(); err != nil {
+		return written, fmt.Errorf("failed to write messages: %w", err)
+	}
+	return written, nil
+}
+
+func (e Exporter) exportSearch(ctx context.Context, params ExportParams, write func([]*ent.Message) error) error {
+	messages, err := e.searcher.Search(ctx, searcher.SearchParams{
+		Input:          params.Query,
+		Count:          params.Count,
+		StartTime:      params.StartTime,
+		EndTime:        params.EndTime,
+		DialogID:       params.DialogID,
+		IncludeDeleted: params.IncludeDeleted,
+		SenderID:       params.SenderID,
+		SenderName:     params.SenderName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.WithEmbeddings {
+		// search results are not loaded with their embeddings
+		embedded, err := e.db.Message.Query().
+			Where(entmessage.IDIn(lo.Map(messages, func(m *ent.Message, _ int) uuid.UUID { return m.ID })...)).
+			Select(entmessage.FieldID, entmessage.FieldTextEmbedding).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query embeddings: %w", err)
+		}
+		embeddings := lo.SliceToMap(embedded, func(m *ent.Message) (uuid.UUID, *ent.Message) { return m.ID, m })
+		for _, message := range messages {
+			if m, ok := embeddings[message.ID]; ok {
+				message.TextEmbedding = m.TextEmbedding
+			}
+		}
+	}
+
+	return write(messages)
+}
+
+// exportAll pages through the matching messages ordered by dialog and message
+// ID, continuing after the last message of the previous page, so that memory
+// use does not depend on the number of messages.
+func (e Exporter) exportAll(ctx context.Context, params ExportParams, write func([]*ent.Message) error) error {
+	predicates, err := e.predicates(ctx, params)
+	if err != nil {
+		return err
+	}
+	fields := lo.Without(entmessage.Columns, entmessage.FieldTextEmbedding)
+	if params.WithEmbeddings {
+		fields = entmessage.Columns
+	}
+
+	var last *ent.Message
+	for {
+		query := e.db.Message.Query().
+			Where(predicates...).
+			Select(fields...).
+			Order(entmessage.ByDialogID(), entmessage.ByMsgID()).
+			Limit(pageSize).
+			WithDialog().
+			WithSender()
+		if last != nil {
+			// compared as a row, so that the (dialog_id, msg_id) index is used
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sql.CompositeGT(
+					[]string{s.C(entmessage.FieldDialogID), s.C(entmessage.FieldMsgID)},
+					last.DialogID, last.MsgID,
+				))
+			})
+		}
+		messages, err := query.All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query messages: %w", err)
+		}
+		if err = write(messages); err != nil {
+			return err
+		}
+		if len(messages) < pageSize {
+			return nil
+		}
+		last = messages[len(messages)-1]
+	}
+}
+
+func (e Exporter) predicates(ctx context.Context, params ExportParams) ([]predicate.Message, error) {
+	var predicates []predicate.Message
+	if lo.IsNotEmpty(params.DialogID) {
+		dialogIDs, err := e.searcher.ConversationDialogIDs(ctx, params.DialogID)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, entmessage.DialogIDIn(dialogIDs...))
+	}
+	if lo.IsNotEmpty(params.SenderID) {
+		predicates = append(predicates, entmessage.SenderID(params.SenderID))
+	}
+	if lo.IsNotEmpty(params.SenderName) {
+		predicates = append(predicates, entmessage.HasSenderWith(entsender.NameContainsFold(params.SenderName)))
+	}
+	if !params.StartTime.IsZero() {
+		predicates = append(predicates, entmessage.SentAtGTE(params.StartTime))
+	}
+	if !params.EndTime.IsZero() {
+		predicates = append(predicates, entmessage.SentAtLTE(params.EndTime))
+	}
+	if !params.IncludeDeleted && e.cfg.Telegram.DeletedMessagePolicy != types.DeletedMessageKeep {
+		predicates = append(predicates, entmessage.DeletedAtIsNil())
+	}
+	return predicates, nil
+}
@@ -0,0 +1,204 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	"github.com/xyenon/telemikiya/libs"
+	"github.com/xyenon/telemikiya/types"
+)
+
+type Format string
+
+const (
+	FormatJSONL    Format = "jsonl"
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "markdown"
+)
+
+// Values provides list valid values for Format.
+func (Format) Values() (kinds []string) {
+	for _, s := range []Format{FormatJSONL, FormatCSV, FormatMarkdown} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type writer interface {
+	write(message *ent.Message) error
+	flush() error
+}
+
+func newWriter(w io.Writer, params ExportParams) (writer, error) {
+	switch params.Format {
+	case FormatJSONL:
+		bw := bufio.NewWriter(w)
+		return &jsonlWriter{w: bw, enc: json.NewEncoder(bw), withEmbeddings: params.WithEmbeddings}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatMarkdown:
+		return &markdownWriter{w: bufio.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format: %s", params.Format)
+	}
+}
+
+// record is a message as it is exported.
+type record struct {
+	DialogID      int64            `json:"dialog_id"`
+	DialogTitle   string           `json:"dialog_title"`
+	MsgID         int              `json:"msg_id"`
+	Link          string           `json:"link"`
+	SenderID      *int64           `json:"sender_id,omitempty"`
+	SenderName    string           `json:"sender_name,omitempty"`
+	SentAt        time.Time        `json:"sent_at"`
+	EditedAt      *time.Time       `json:"edited_at,omitempty"`
+	DeletedAt     *time.Time       `json:"deleted_at,omitempty"`
+	ReplyToMsgID  *int             `json:"reply_to_msg_id,omitempty"`
+	TopicID       *int             `json:"topic_id,omitempty"`
+	ForwardedFrom string           `json:"forwarded_from,omitempty"`
+	Text          string           `json:"text"`
+	DerivedText   string           `json:"derived_text,omitempty"`
+	MediaInfo     *types.MediaInfo `json:"media_info,omitempty"`
+	Embedding     []float32        `json:"embedding,omitempty"`
+}
+
+func newRecord(message *ent.Message) record {
+	r := record{
+		DialogID:     message.DialogID,
+		MsgID:        message.MsgID,
+		Link:         libs.DeepLink(message),
+		SenderID:     message.SenderID,
+		SentAt:       message.SentAt,
+		EditedAt:     message.EditedAt,
+		DeletedAt:    message.DeletedAt,
+		ReplyToMsgID: message.ReplyToMsgID,
+		TopicID:      message.TopicID,
+		Text:         message.Text,
+		DerivedText:  message.DerivedText,
+	}
+	if dialog := message.Edges.Dialog; dialog != nil {
+		r.DialogTitle = dialog.Title
+	}
+	if sender := message.Edges.Sender; sender != nil {
+		r.SenderName = sender.Name
+	}
+	r.ForwardedFrom, _ = libs.ForwardOrigin(message)
+	if message.HasMedia {
+		r.MediaInfo = message.MediaInfo
+	}
+	return r
+}
+
+type jsonlWriter struct {
+	w              *bufio.Writer
+	enc            *json.Encoder
+	withEmbeddings bool
+}
+
+func (w *jsonlWriter) write(message *ent.Message) error {
+	r := newRecord(message)
+	if w.withEmbeddings {
+		r.Embedding = message.TextEmbedding.Slice()
+	}
+	return w.enc.Encode(r)
+}
+
+func (w *jsonlWriter) flush() error {
+	return w.w.Flush()
+}
+
+var csvHeader = []string{
+	"dialog_id", "dialog_title", "msg_id", "link", "sender_id", "sender_name",
+	"sent_at", "edited_at", "reply_to_msg_id", "forwarded_from", "text", "derived_text", "media_type",
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) write(message *ent.Message) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	r := newRecord(message)
+	var mediaType string
+	if r.MediaInfo != nil {
+		mediaType = r.MediaInfo.Type
+	}
+	return w.w.Write([]string{
+		strconv.FormatInt(r.DialogID, 10),
+		r.DialogTitle,
+		strconv.Itoa(r.MsgID),
+		r.Link,
+		formatOptional(r.SenderID, func(id int64) string { return strconv.FormatInt(id, 10) }),
+		r.SenderName,
+		r.SentAt.Format(time.RFC3339),
+		formatOptional(r.EditedAt, func(t time.Time) string { return t.Format(time.RFC3339) }),
+		formatOptional(r.ReplyToMsgID, strconv.Itoa),
+		r.ForwardedFrom,
+		r.Text,
+		r.DerivedText,
+		mediaType,
+	})
+}
+
+func (w *csvWriter) flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func formatOptional[T any](v *T, format func(T) string) string {
+	if v == nil {
+		return ""
+	}
+	return format(*v)
+}
+
+// markdownWriter writes a readable transcript, with a section per dialog.
+type markdownWriter struct {
+	w        *bufio.Writer
+	dialogID *int64
+}
+
+func (w *markdownWriter) write(message *ent.Message) error {
+	r := newRecord(message)
+	if w.dialogID == nil || *w.dialogID != r.DialogID {
+		fmt.Fprintf(w.w, "# %s (%d)\n\n", lo.CoalesceOrEmpty(r.DialogTitle, "unknown"), r.DialogID)
+		w.dialogID = &r.DialogID
+	}
+
+	fmt.Fprintf(w.w, "**%s** [%s](%s)", lo.CoalesceOrEmpty(r.SenderName, "unknown"), r.SentAt.Format(time.DateTime), r.Link)
+	if r.ForwardedFrom != "" {
+		fmt.Fprintf(w.w, " _forwarded from %s_", r.ForwardedFrom)
+	}
+	if r.ReplyToMsgID != nil {
+		fmt.Fprintf(w.w, " _in reply to %d_", *r.ReplyToMsgID)
+	}
+	w.w.WriteString("\n\n")
+
+	text := libs.DisplayText(message)
+	if r.MediaInfo != nil && r.MediaInfo.Type != "" {
+		text = strings.TrimSpace(fmt.Sprintf("[%s] %s", r.MediaInfo.Type, text))
+	}
+	// keep the line breaks of the message
+	_, err := fmt.Fprintf(w.w, "%s\n\n", strings.ReplaceAll(text, "\n", "  \n"))
+	return err
+}
+
+func (w *markdownWriter) flush() error {
+	return w.w.Flush()
+}
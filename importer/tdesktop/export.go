@@ -0,0 +1,412 @@
+package tdesktop
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tdconstant "github.com/gotd/td/constant"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/types"
+)
+
+type chat struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type message struct {
+	ID               int     `json:"id"`
+	Type             string  `json:"type"`
+	Date             string  `json:"date"`
+	DateUnixtime     string  `json:"date_unixtime"`
+	Edited           string  `json:"edited"`
+	EditedUnixtime   string  `json:"edited_unixtime"`
+	From             string  `json:"from"`
+	FromID           string  `json:"from_id"`
+	ReplyToMessageID int     `json:"reply_to_message_id"`
+	ForwardedFrom    string  `json:"forwarded_from"`
+	Text             text    `json:"text"`
+	Photo            string  `json:"photo"`
+	File             string  `json:"file"`
+	FileName         string  `json:"file_name"`
+	FileSize         int64   `json:"file_size"`
+	MediaType        string  `json:"media_type"`
+	MimeType         string  `json:"mime_type"`
+	DurationSeconds  float64 `json:"duration_seconds"`
+	Width            int     `json:"width"`
+	Height           int     `json:"height"`
+	Performer        string  `json:"performer"`
+	Title            string  `json:"title"`
+	StickerEmoji     string  `json:"sticker_emoji"`
+	PlaceName        string  `json:"place_name"`
+	Address          string  `json:"address"`
+
+	LocationInformation *struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"location_information"`
+	ContactInformation *struct {
+		FirstName   string `json:"first_name"`
+		LastName    string `json:"last_name"`
+		PhoneNumber string `json:"phone_number"`
+	} `json:"contact_information"`
+	Poll *struct {
+		Question string `json:"question"`
+		Answers  []struct {
+			Text string `json:"text"`
+		} `json:"answers"`
+	} `json:"poll"`
+}
+
+// text is the text of an exported message, which is either a string or an
+// array of strings and text entities.
+type text string
+
+func (t *text) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = text(s)
+		return nil
+	}
+
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return fmt.Errorf("failed to parse text: %w", err)
+	}
+	var b strings.Builder
+	for _, part := range parts {
+		var entity struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(part, &s); err == nil {
+			b.WriteString(s)
+		} else if err := json.Unmarshal(part, &entity); err == nil {
+			b.WriteString(entity.Text)
+		} else {
+			return fmt.Errorf("failed to parse text entity: %w", err)
+		}
+	}
+	*t = text(b.String())
+	return nil
+}
+
+// readExport streams the result.json at path, or in the directory at path,
+// which is either the export of a single chat or of the whole account. It calls
+// fn for each chat along with its messages, which fn reads one at a time, so
+// that exports of any size are read without holding them in memory.
+func readExport(path string, fn func(chat chat, messages *messageStream) error) error {
+	if info, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to stat export: %w", err)
+	} else if info.IsDir() {
+		path = filepath.Join(path, "result.json")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open export: %w", err)
+	}
+	defer f.Close()
+
+	// tell the errors of fn apart from the ones parsing the export
+	var fnErr error
+	r := exportReader{dec: json.NewDecoder(f), fn: func(chat chat, messages *messageStream) error {
+		fnErr = fn(chat, messages)
+		return fnErr
+	}}
+	if err = r.export(); fnErr != nil {
+		return fnErr
+	} else if err != nil {
+		return fmt.Errorf("failed to parse export: %w", err)
+	}
+	return nil
+}
+
+// exportReader walks the tokens of an export, decoding a message at a time.
+type exportReader struct {
+	dec *json.Decoder
+	fn  func(chat chat, messages *messageStream) error
+}
+
+// export reads the top-level object, which is the chat itself in a
+// single-chat export, and has the lists of chats and left chats otherwise.
+func (r exportReader) export() error {
+	var single chatReader
+	err := r.object(func(key string) error {
+		switch key {
+		case "chats", "left_chats":
+			return r.object(func(key string) error {
+				if key != "list" {
+					return r.skip()
+				}
+				return r.array(r.chat)
+			})
+		}
+		return single.field(r, key)
+	})
+	if err != nil {
+		return err
+	}
+	if single.chat.Type != "" {
+		return single.finish(r)
+	}
+	return nil
+}
+
+func (r exportReader) chat() error {
+	var c chatReader
+	if err := r.object(func(key string) error { return c.field(r, key) }); err != nil {
+		return err
+	}
+	return c.finish(r)
+}
+
+// chatReader reads the fields of a chat, which exports list before its messages.
+type chatReader struct {
+	chat chat
+	// streamed is whether the messages of the chat were passed to fn.
+	streamed bool
+}
+
+func (c *chatReader) field(r exportReader, key string) error {
+	switch key {
+	case "id":
+		return r.dec.Decode(&c.chat.ID)
+	case "name":
+		return r.dec.Decode(&c.chat.Name)
+	case "type":
+		return r.dec.Decode(&c.chat.Type)
+	case "messages":
+		if c.chat.Type == "" {
+			return errors.New("messages precede the type of the chat")
+		}
+		if err := r.delim('['); err != nil {
+			return err
+		}
+		c.streamed = true
+		messages := &messageStream{dec: r.dec}
+		if err := r.fn(c.chat, messages); err != nil {
+			return err
+		}
+		// skip the messages fn did not read
+		for r.dec.More() {
+			if err := r.skip(); err != nil {
+				return err
+			}
+		}
+		return r.delim(']')
+	}
+	return r.skip()
+}
+
+// finish passes a chat without messages to fn, so that its dialog is imported.
+func (c *chatReader) finish(r exportReader) error {
+	if c.streamed {
+		return nil
+	}
+	return r.fn(c.chat, &messageStream{done: true})
+}
+
+// object reads an object, calling field to read the value of each key.
+func (r exportReader) object(field func(key string) error) error {
+	if err := r.delim('{'); err != nil {
+		return err
+	}
+	for r.dec.More() {
+		t, err := r.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("unexpected %v", t)
+		}
+		if err = field(key); err != nil {
+			return err
+		}
+	}
+	return r.delim('}')
+}
+
+// array reads an array, calling elem to read each element.
+func (r exportReader) array(elem func() error) error {
+	if err := r.delim('['); err != nil {
+		return err
+	}
+	for r.dec.More() {
+		if err := elem(); err != nil {
+			return err
+		}
+	}
+	return r.delim(']')
+}
+
+func (r exportReader) delim(want json.Delim) error {
+	t, err := r.dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != want {
+		return fmt.Errorf("unexpected %v, want %v", t, want)
+	}
+	return nil
+}
+
+// skip skips the next value token by token, as it can be as large as the
+// contacts of the account.
+func (r exportReader) skip() error {
+	depth := 0
+	for {
+		t, err := r.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// messageStream reads the messages of a chat one at a time.
+type messageStream struct {
+	dec  *json.Decoder
+	done bool
+}
+
+// Next reads the next message, and reports false after the last one.
+func (s *messageStream) Next() (message, bool, error) {
+	if s.done || !s.dec.More() {
+		s.done = true
+		return message{}, false, nil
+	}
+	var m message
+	if err := s.dec.Decode(&m); err != nil {
+		return message{}, false, fmt.Errorf("failed to parse message: %w", err)
+	}
+	return m, true, nil
+}
+
+// dialog returns the bot API dialog ID and the type of the chat.
+// https://core.telegram.org/api/bots/ids
+func (c chat) dialog() (int64, types.DialogType, error) {
+	switch c.Type {
+	case "personal_chat", "bot_chat", "saved_messages":
+		return c.ID, types.TypeUser, nil
+	case "private_group":
+		return -c.ID, types.TypeGroup, nil
+	case "private_supergroup", "public_supergroup":
+		return tdconstant.ZeroTDLibChannelID - c.ID, types.TypeGroup, nil
+	case "private_channel", "public_channel":
+		return tdconstant.ZeroTDLibChannelID - c.ID, types.TypeChannel, nil
+	default:
+		return 0, "", fmt.Errorf("unknown chat type: %s", c.Type)
+	}
+}
+
+// sender returns the bot API dialog ID and the type of the sender of the message.
+func (m message) sender() (int64, types.DialogType, bool) {
+	if v, ok := strings.CutPrefix(m.FromID, "user"); ok {
+		id, err := strconv.ParseInt(v, 10, 64)
+		return id, types.TypeUser, err == nil
+	}
+	if v, ok := strings.CutPrefix(m.FromID, "channel"); ok {
+		id, err := strconv.ParseInt(v, 10, 64)
+		return tdconstant.ZeroTDLibChannelID - id, types.TypeChannel, err == nil
+	}
+	return 0, "", false
+}
+
+func parseTime(unixtime, local string) (time.Time, bool) {
+	if sec, err := strconv.ParseInt(unixtime, 10, 64); err == nil {
+		return time.Unix(sec, 0).UTC(), true
+	}
+	// older exports only have the time in the local time zone of the exporter
+	if t, err := time.ParseInLocation("2006-01-02T15:04:05", local, time.Local); err == nil {
+		return t.UTC(), true
+	}
+	return time.Time{}, false
+}
+
+// mediaInfo fills in the media info from the exported file fields, along with
+// the text derived from the media.
+func (m message) mediaInfo() (hasMedia bool, mediaInfo types.MediaInfo, derivedText string) {
+	var derivedTexts []string
+	switch {
+	case m.Photo != "":
+		hasMedia = true
+		mediaInfo.Type = "photo"
+	case m.File != "" || m.MediaType != "":
+		hasMedia = true
+		mediaInfo.Type = "documents"
+		document := types.Document{
+			MimeType: m.MimeType,
+			Type:     documentType(m.MediaType),
+			Filename: m.FileName,
+			Size:     m.FileSize,
+			Duration: m.DurationSeconds,
+			Width:    m.Width,
+			Height:   m.Height,
+		}
+		document.Audio.Performer, document.Audio.Title = m.Performer, m.Title
+		document.Sticker.Emoji = m.StickerEmoji
+		mediaInfo.Documents = []types.Document{document}
+	case m.PlaceName != "" || m.Address != "":
+		hasMedia = true
+		mediaInfo.Type = "venue"
+		derivedTexts = append(derivedTexts, m.PlaceName, m.Address)
+	case m.LocationInformation != nil:
+		hasMedia = true
+		mediaInfo.Type = "geo"
+		mediaInfo.GeoPoint = types.GeoPoint{
+			Long: m.LocationInformation.Longitude,
+			Lat:  m.LocationInformation.Latitude,
+		}
+	case m.ContactInformation != nil:
+		hasMedia = true
+		mediaInfo.Type = "contact"
+		mediaInfo.Contact = types.Contact{
+			PhoneNumber: m.ContactInformation.PhoneNumber,
+			FirstName:   m.ContactInformation.FirstName,
+			LastName:    m.ContactInformation.LastName,
+		}
+	case m.Poll != nil:
+		hasMedia = true
+		mediaInfo.Type = "poll"
+		derivedTexts = append(derivedTexts, m.Poll.Question)
+		for _, answer := range m.Poll.Answers {
+			derivedTexts = append(derivedTexts, answer.Text)
+		}
+	}
+
+	return hasMedia, mediaInfo, strings.Join(lo.Compact(derivedTexts), "\n")
+}
+
+// documentType maps the exported media type to the document type used by the observer.
+func documentType(mediaType string) string {
+	switch mediaType {
+	case "animation":
+		return "animated"
+	case "sticker":
+		return "sticker"
+	case "video_file", "video_message":
+		return "video"
+	case "audio_file":
+		return "audio"
+	case "voice_message":
+		return "voice"
+	default:
+		return "file"
+	}
+}
@@ -0,0 +1,318 @@
+package tdesktop
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xyenon/telemikiya/types"
+)
+
+// exported is what is imported from an exported message.
+type exported struct {
+	id          int
+	text        string
+	sentAt      time.Time
+	editedAt    time.Time
+	senderID    int64
+	senderType  types.DialogType
+	replyTo     int
+	fwdFrom     string
+	hasMedia    bool
+	mediaInfo   types.MediaInfo
+	derivedText string
+}
+
+func exportedMessage(t *testing.T, m message) exported {
+	t.Helper()
+	e := exported{
+		id:      m.ID,
+		text:    string(m.Text),
+		replyTo: m.ReplyToMessageID,
+		fwdFrom: m.ForwardedFrom,
+	}
+	var ok bool
+	if e.sentAt, ok = parseTime(m.DateUnixtime, m.Date); !ok {
+		t.Fatalf("message %d: invalid date %q", m.ID, m.Date)
+	}
+	e.editedAt, _ = parseTime(m.EditedUnixtime, m.Edited)
+	e.senderID, e.senderType, _ = m.sender()
+	e.hasMedia, e.mediaInfo, e.derivedText = m.mediaInfo()
+	return e
+}
+
+// exportedChat is a chat read from an export, along with its messages.
+type exportedChat struct {
+	chat
+	messages []message
+}
+
+func readExportedChats(t *testing.T, path string) []exportedChat {
+	t.Helper()
+	var chats []exportedChat
+	err := readExport(path, func(c chat, messages *messageStream) error {
+		e := exportedChat{chat: c}
+		for {
+			m, ok, err := messages.Next()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			e.messages = append(e.messages, m)
+		}
+		chats = append(chats, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chats
+}
+
+func TestReadExportSingleChat(t *testing.T) {
+	chats := readExportedChats(t, "testdata/single_chat")
+	if len(chats) != 1 {
+		t.Fatalf("got %d chats, want 1", len(chats))
+	}
+	chat := chats[0]
+
+	dialogID, dialogType, err := chat.dialog()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dialogID != -1001234567890 || dialogType != types.TypeGroup {
+		t.Errorf("dialog = %d, %s, want -1001234567890, group", dialogID, dialogType)
+	}
+	if chat.Name != "Release Team" {
+		t.Errorf("name = %q, want %q", chat.Name, "Release Team")
+	}
+
+	if chat.messages[0].Type != "service" {
+		t.Errorf("message 1 type = %q, want service", chat.messages[0].Type)
+	}
+	want := []exported{
+		{
+			id:         2,
+			text:       "The release is planned for Friday",
+			sentAt:     time.Unix(1709283900, 0).UTC(),
+			editedAt:   time.Unix(1709283960, 0).UTC(),
+			senderID:   111,
+			senderType: types.TypeUser,
+		},
+		{
+			id:         3,
+			text:       "See https://example.com/changelog and the notes",
+			sentAt:     time.Unix(1709284200, 0).UTC(),
+			senderID:   222,
+			senderType: types.TypeUser,
+			replyTo:    2,
+		},
+		{
+			id:         4,
+			sentAt:     time.Unix(1709284500, 0).UTC(),
+			senderID:   -1000000000333,
+			senderType: types.TypeChannel,
+			fwdFrom:    "Release News",
+			hasMedia:   true,
+			mediaInfo: types.MediaInfo{
+				Type: "documents",
+				Documents: []types.Document{{
+					MimeType: "application/pdf",
+					Type:     "file",
+					Filename: "notes.pdf",
+					Size:     2048,
+				}},
+			},
+		},
+		{
+			id:         5,
+			sentAt:     time.Unix(1709284800, 0).UTC(),
+			senderID:   111,
+			senderType: types.TypeUser,
+			hasMedia:   true,
+			mediaInfo: types.MediaInfo{
+				Type: "documents",
+				Documents: []types.Document{{
+					MimeType: "audio/ogg",
+					Type:     "voice",
+					Duration: 3,
+				}},
+			},
+		},
+		{
+			id:          6,
+			sentAt:      time.Unix(1709285100, 0).UTC(),
+			senderID:    222,
+			senderType:  types.TypeUser,
+			hasMedia:    true,
+			mediaInfo:   types.MediaInfo{Type: "poll"},
+			derivedText: "Which day?\nFriday\nMonday",
+		},
+		{
+			id:         7,
+			sentAt:     time.Unix(1709285400, 0).UTC(),
+			senderID:   111,
+			senderType: types.TypeUser,
+			hasMedia:   true,
+			mediaInfo: types.MediaInfo{
+				Type:     "geo",
+				GeoPoint: types.GeoPoint{Long: 139.7671, Lat: 35.6812},
+			},
+		},
+	}
+	if len(chat.messages) != len(want)+1 {
+		t.Fatalf("got %d messages, want %d", len(chat.messages), len(want)+1)
+	}
+	for i, w := range want {
+		if got := exportedMessage(t, chat.messages[i+1]); !reflect.DeepEqual(got, w) {
+			t.Errorf("message %d:\ngot  %+v\nwant %+v", w.id, got, w)
+		}
+	}
+}
+
+func TestReadExportFullAccount(t *testing.T) {
+	chats := readExportedChats(t, "testdata/full_account.json")
+
+	type dialog struct {
+		id         int64
+		dialogType types.DialogType
+		name       string
+	}
+	wantDialogs := []dialog{
+		{222, types.TypeUser, "Bob"},
+		{-4444, types.TypeGroup, "Family"},
+		// left chats are imported too
+		{-1000000005555, types.TypeChannel, "Announcements"},
+	}
+	if len(chats) != len(wantDialogs) {
+		t.Fatalf("got %d chats, want %d", len(chats), len(wantDialogs))
+	}
+	for i, w := range wantDialogs {
+		id, dialogType, err := chats[i].dialog()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := (dialog{id, dialogType, chats[i].Name}); got != w {
+			t.Errorf("chat %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	want := [][]exported{
+		{
+			{
+				id:   10,
+				text: "Sent before exports had unix times",
+				// older exports only have the local time of the exporter
+				sentAt:     time.Date(2019, 5, 4, 12, 30, 0, 0, time.Local).UTC(),
+				senderID:   222,
+				senderType: types.TypeUser,
+			},
+			{
+				id:         11,
+				sentAt:     time.Unix(1556973060, 0).UTC(),
+				senderID:   111,
+				senderType: types.TypeUser,
+				hasMedia:   true,
+				mediaInfo: types.MediaInfo{
+					Type: "contact",
+					Contact: types.Contact{
+						PhoneNumber: "+15550100",
+						FirstName:   "Carol",
+						LastName:    "Smith",
+					},
+				},
+			},
+		},
+		{
+			{
+				id:         5,
+				text:       "Dinner",
+				sentAt:     time.Unix(1557043200, 0).UTC(),
+				senderID:   111,
+				senderType: types.TypeUser,
+				hasMedia:   true,
+				mediaInfo:  types.MediaInfo{Type: "photo"},
+			},
+		},
+		{
+			{
+				id:          100,
+				sentAt:      time.Unix(1577836800, 0).UTC(),
+				senderID:    -1000000005555,
+				senderType:  types.TypeChannel,
+				hasMedia:    true,
+				mediaInfo:   types.MediaInfo{Type: "venue"},
+				derivedText: "Central Station\n1 Main Street",
+			},
+		},
+	}
+	for i, messages := range want {
+		if len(chats[i].messages) != len(messages) {
+			t.Fatalf("chat %d: got %d messages, want %d", i, len(chats[i].messages), len(messages))
+		}
+		for j, w := range messages {
+			if got := exportedMessage(t, chats[i].messages[j]); !reflect.DeepEqual(got, w) {
+				t.Errorf("chat %d message %d:\ngot  %+v\nwant %+v", i, w.id, got, w)
+			}
+		}
+	}
+}
+
+func TestReadExportUnreadMessages(t *testing.T) {
+	// the messages fn leaves unread are skipped
+	var ids []int64
+	err := readExport("testdata/full_account.json", func(c chat, messages *messageStream) error {
+		ids = append(ids, c.ID)
+		_, _, err := messages.Next()
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{222, 4444, 5555}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got chats %v, want %v", ids, want)
+	}
+
+	wantErr := errors.New("import failed")
+	err = readExport("testdata/full_account.json", func(chat, *messageStream) error { return wantErr })
+	if err != wantErr {
+		t.Errorf("error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestTextUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    text
+		wantErr bool
+	}{
+		{name: "string", data: `"hello"`, want: "hello"},
+		{name: "empty", data: `""`, want: ""},
+		{name: "entities", data: `["a ", {"type": "bold", "text": "b"}, " c"]`, want: "a b c"},
+		{name: "empty entities", data: `[]`, want: ""},
+		{name: "invalid", data: `42`, wantErr: true},
+		{name: "invalid entity", data: `[42]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got text
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChatDialogUnknownType(t *testing.T) {
+	if _, _, err := (chat{ID: 1, Type: "secret_chat"}).dialog(); err == nil {
+		t.Error("expected an error for an unknown chat type")
+	}
+}
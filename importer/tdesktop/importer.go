@@ -0,0 +1,216 @@
+package tdesktop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/database/ent"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// lackingColumns are the columns an export has no data for, which keep the
+// values recorded by the observer.
+var lackingColumns = []string{
+	entmessage.FieldTopMsgID,
+	entmessage.FieldTopicID,
+	entmessage.FieldFwdFromID,
+	entmessage.FieldFwdFromMsgID,
+	entmessage.FieldFwdFromDate,
+	entmessage.FieldFwdPostAuthor,
+}
+
+type Params struct {
+	fx.In
+
+	Logger   *zap.Logger
+	Database *database.Database
+}
+
+// Importer imports the chat history exported by Telegram Desktop.
+type Importer struct {
+	logger *zap.Logger
+	db     *database.Database
+}
+
+func New(params Params) *Importer {
+	return &Importer{
+		logger: params.Logger,
+		db:     params.Database,
+	}
+}
+
+// Result summarizes an import.
+type Result struct {
+	Dialogs int
+	// Saved is the number of messages inserted or updated.
+	Saved int
+	// Unchanged is the number of messages that were already stored as exported.
+	Unchanged int
+	// Skipped is the number of service messages and messages without text or media.
+	Skipped int
+}
+
+// batchSize is the number of messages imported in a transaction.
+const batchSize = 1000
+
+// Import upserts the dialogs and messages of the export at path, which is
+// either a result.json file or the directory containing it. Both single-chat
+// and full-account exports are supported.
+func (i Importer) Import(ctx context.Context, path string) (result Result, err error) {
+	err = readExport(path, func(chat chat, messages *messageStream) error {
+		if err := i.importChat(ctx, chat, messages, &result); err != nil {
+			return fmt.Errorf("failed to import chat %d: %w", chat.ID, err)
+		}
+		result.Dialogs++
+		return nil
+	})
+	return result, err
+}
+
+// importChat imports the messages of the chat in batches, each committed along
+// with the last message ID it reached, so that an interrupted import keeps the
+// batches before it.
+func (i Importer) importChat(ctx context.Context, chat chat, messages *messageStream, result *Result) error {
+	dialogID, dialogType, err := chat.dialog()
+	if err != nil {
+		return err
+	}
+	i.logger.Info("importing chat", zap.Int64("dialog_id", dialogID), zap.String("name", chat.Name))
+
+	// dialogs that are also observed keep their live title
+	err = i.db.Dialog.Create().
+		SetID(dialogID).
+		SetTitle(chat.Name).
+		SetType(dialogType).
+		OnConflictColumns(entdialog.FieldID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save dialog: %w", err)
+	}
+
+	for done := false; !done; {
+		if done, err = i.importBatch(ctx, dialogID, messages, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importBatch imports up to batchSize messages in a transaction, and reports
+// whether the messages of the chat are all read.
+func (i Importer) importBatch(ctx context.Context, dialogID int64, messages *messageStream, result *Result) (bool, error) {
+	tx, err := i.db.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	var newest int
+	done := false
+	for n := 0; n < batchSize; n++ {
+		msg, ok, err := messages.Next()
+		if err != nil {
+			return false, rollback(tx, err)
+		}
+		if !ok {
+			done = true
+			break
+		}
+		newest = max(newest, msg.ID)
+
+		if msg.Type != "message" {
+			result.Skipped++
+			continue
+		}
+		hasMedia, mediaInfo, derivedText := msg.mediaInfo()
+		if msg.Text == "" && !hasMedia {
+			result.Skipped++
+			continue
+		}
+		sentAt, ok := parseTime(msg.DateUnixtime, msg.Date)
+		if !ok {
+			return false, rollback(tx, fmt.Errorf("invalid date of message %d: %q", msg.ID, msg.Date))
+		}
+
+		senderID, err := i.saveSender(ctx, tx, msg)
+		if err != nil {
+			return false, rollback(tx, err)
+		}
+
+		create := tx.Message.Create().
+			SetMsgID(msg.ID).
+			SetDialogID(dialogID).
+			SetNillableSenderID(senderID).
+			SetText(string(msg.Text)).
+			SetDerivedText(derivedText).
+			SetHasMedia(hasMedia).
+			SetMediaInfo(&mediaInfo).
+			SetSentAt(sentAt)
+		if msg.ReplyToMessageID != 0 {
+			create = create.SetReplyToMsgID(msg.ReplyToMessageID)
+		}
+		if msg.ForwardedFrom != "" {
+			create = create.SetFwdFromName(msg.ForwardedFrom)
+		}
+		if editedAt, ok := parseTime(msg.EditedUnixtime, msg.Edited); ok {
+			create = create.SetEditedAt(editedAt)
+		}
+
+		changed, err := database.UpsertMessage(ctx, tx, create, lackingColumns...)
+		if err != nil {
+			return false, rollback(tx, fmt.Errorf("failed to save message %d: %w", msg.ID, err))
+		}
+		if changed {
+			result.Saved++
+		} else {
+			result.Unchanged++
+		}
+	}
+
+	// the observer catches up on the messages sent after the export
+	if newest > 0 {
+		if err = database.AdvanceLastMsgID(ctx, tx.Dialog, dialogID, newest); err != nil {
+			return false, rollback(tx, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return done, nil
+}
+
+// saveSender saves the sender of the message unless it is already known,
+// and returns its ID.
+func (i Importer) saveSender(ctx context.Context, tx *ent.Tx, msg message) (*int64, error) {
+	senderID, senderType, ok := msg.sender()
+	if !ok {
+		return nil, nil
+	}
+	err := tx.Sender.Create().
+		SetID(senderID).
+		SetName(msg.From).
+		SetType(senderType).
+		// let the observer refresh the sender as soon as it sees it
+		SetUpdatedAt(time.Time{}).
+		OnConflictColumns(entsender.FieldID).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save sender: %w", err)
+	}
+	return &senderID, nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %w", err, rerr)
+	}
+	return err
+}
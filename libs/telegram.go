@@ -2,6 +2,7 @@ package libs
 
 import (
 	"fmt"
+	"strconv"
 
 	tdconstant "github.com/gotd/td/constant"
 	"github.com/xyenon/telemikiya/database/ent"
@@ -30,6 +31,12 @@ func BotDialogToMTProto(botDialogID int64, dialogType types.DialogType) (int64,
 // https://core.telegram.org/api/links
 func DeepLink(msg *ent.Message) string {
 	dialog := msg.Edges.Dialog
+	if dialog.MigratedToID != nil {
+		// messages of a basic group that has been upgraded are shown in the
+		// supergroup above its first message, which announces the upgrade
+		channelID, _ := BotDialogToMTProto(*dialog.MigratedToID, types.TypeChannel)
+		return fmt.Sprintf("https://t.me/c/%d/1", channelID)
+	}
 	dialogID, dialogType := BotDialogToMTProto(dialog.ID, dialog.Type)
 	switch dialogType {
 	case types.MTProtoDialogTypeUser, types.MTProtoDialogTypeChat:
@@ -40,3 +47,34 @@ func DeepLink(msg *ent.Message) string {
 		panic(fmt.Errorf("unknown dialog type: %s", dialogType))
 	}
 }
+
+// ForwardOrigin describes where a forwarded message comes from.
+func ForwardOrigin(msg *ent.Message) (string, bool) {
+	switch {
+	case msg.FwdFromName != nil:
+		return *msg.FwdFromName, true
+	case msg.FwdFromID != nil:
+		return strconv.FormatInt(*msg.FwdFromID, 10), true
+	default:
+		return "", false
+	}
+}
+
+// DisplayText returns the text of the message, or the text derived from its
+// media if the message has no text.
+func DisplayText(msg *ent.Message) string {
+	if msg.Text == "" {
+		return msg.DerivedText
+	}
+	return msg.Text
+}
+
+// MatchedText returns the text the message was found by in search, which is
+// the matching chunk of a long message, or its display text.
+func MatchedText(msg *ent.Message) string {
+	if len(msg.Edges.Chunks) == 1 {
+		chunk := msg.Edges.Chunks[0]
+		return fmt.Sprintf("[chunk %d] %s", chunk.Index+1, chunk.Text)
+	}
+	return DisplayText(msg)
+}
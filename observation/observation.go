@@ -0,0 +1,251 @@
+package observation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/database/ent"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entobservationstate "github.com/xyenon/telemikiya/database/ent/observationstate"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	Logger   *zap.Logger
+	Database *database.Database
+}
+
+// stateRefreshInterval is how long the cached observation states are used
+// before they are read again, so that changes made by other processes, like
+// the dialogs commands, take effect without restarting.
+const stateRefreshInterval = time.Minute
+
+// Observation manages the observation state of dialogs at runtime.
+type Observation struct {
+	logger *zap.Logger
+	db     *database.Database
+	states *stateCache
+}
+
+func New(params Params) *Observation {
+	return &Observation{
+		logger: params.Logger,
+		db:     params.Database,
+		states: &stateCache{interval: stateRefreshInterval},
+	}
+}
+
+// Get returns the observation state of the dialog,
+// or the default state if it has never been changed.
+func (o Observation) Get(ctx context.Context, dialogID int64) (*ent.ObservationState, error) {
+	state, ok, err := o.states.get(ctx, o.db.Client, dialogID)
+	switch {
+	case err != nil:
+		return nil, err
+	case !ok:
+		return &ent.ObservationState{
+			ID:               dialogID,
+			Paused:           entobservationstate.DefaultPaused,
+			EmbeddingEnabled: entobservationstate.DefaultEmbeddingEnabled,
+		}, nil
+	}
+	return state, nil
+}
+
+// stateCache caches the observation states of all dialogs, reading them again
+// at most once per refresh interval, or after they are changed.
+type stateCache struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	states    map[int64]*ent.ObservationState
+	fetchedAt time.Time
+}
+
+// get returns the observation state of the dialog, and reports whether it has
+// ever been changed.
+func (c *stateCache) get(ctx context.Context, client *ent.Client, dialogID int64) (*ent.ObservationState, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) > c.interval {
+		states, err := client.ObservationState.Query().All(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to query observation states: %w", err)
+		}
+		c.states = lo.KeyBy(states, func(state *ent.ObservationState) int64 { return state.ID })
+		c.fetchedAt = time.Now()
+	}
+
+	state, ok := c.states[dialogID]
+	return state, ok, nil
+}
+
+// invalidate makes the states be read again when they are next needed.
+func (c *stateCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fetchedAt = time.Time{}
+}
+
+// Pause stops recording messages of the dialog.
+func (o Observation) Pause(ctx context.Context, dialogID int64) error {
+	return o.setPaused(ctx, o.db.Client, dialogID, true)
+}
+
+// Resume records messages of the dialog again.
+func (o Observation) Resume(ctx context.Context, dialogID int64) error {
+	return o.setPaused(ctx, o.db.Client, dialogID, false)
+}
+
+func (o Observation) setPaused(ctx context.Context, client *ent.Client, dialogID int64, paused bool) error {
+	err := client.ObservationState.Create().
+		SetID(dialogID).
+		SetPaused(paused).
+		OnConflictColumns(entobservationstate.FieldID).
+		UpdatePaused().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save observation state: %w", err)
+	}
+	o.states.invalidate()
+	o.logger.Info("observation state changed", zap.Int64("dialog_id", dialogID), zap.Bool("paused", paused))
+	return nil
+}
+
+// SetEmbeddingEnabled sets whether messages of the dialog are embedded.
+func (o Observation) SetEmbeddingEnabled(ctx context.Context, dialogID int64, enabled bool) error {
+	err := o.db.ObservationState.Create().
+		SetID(dialogID).
+		SetEmbeddingEnabled(enabled).
+		OnConflictColumns(entobservationstate.FieldID).
+		UpdateEmbeddingEnabled().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save observation state: %w", err)
+	}
+	o.states.invalidate()
+	o.logger.Info("observation state changed", zap.Int64("dialog_id", dialogID), zap.Bool("embedding_enabled", enabled))
+	return nil
+}
+
+// SetRetention sets how long messages of the dialog are kept. A zero retention
+// keeps them forever.
+func (o Observation) SetRetention(ctx context.Context, dialogID int64, retention time.Duration) error {
+	create := o.db.ObservationState.Create().SetID(dialogID)
+	if retention > 0 {
+		create = create.SetRetention(retention)
+	}
+	err := create.
+		OnConflictColumns(entobservationstate.FieldID).
+		UpdateRetention().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save observation state: %w", err)
+	}
+	o.states.invalidate()
+	o.logger.Info("observation state changed", zap.Int64("dialog_id", dialogID), zap.Duration("retention", retention))
+	return nil
+}
+
+// Purge pauses the dialog and deletes all of its messages along with their
+// embeddings. A basic group and the supergroup it was upgraded to are purged
+// together, as they make up the same conversation. The embedding cache keeps
+// its entries, which are only keyed by the hash of a text and may be shared
+// with the messages of other dialogs. It returns the number of deleted messages.
+func (o Observation) Purge(ctx context.Context, dialogID int64) (int, error) {
+	tx, err := o.db.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	dialogIDs, err := database.ConversationDialogIDs(ctx, tx.Dialog, dialogID)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	for _, id := range dialogIDs {
+		if err = o.setPaused(ctx, tx.Client(), id, true); err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+	count, err := tx.Message.Delete().Where(entmessage.DialogIDIn(dialogIDs...)).Exec(ctx)
+	if err != nil {
+		return 0, rollback(tx, fmt.Errorf("failed to delete messages: %w", err))
+	}
+	// the history has to be fetched again if the dialog is resumed
+	err = tx.Dialog.Update().
+		Where(entdialog.IDIn(dialogIDs...)).
+		ClearBackfillOffsetID().
+		ClearBackfillCompletedAt().
+		ClearLastMsgID().
+		Exec(ctx)
+	if err != nil {
+		return 0, rollback(tx, fmt.Errorf("failed to reset dialog: %w", err))
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	// the states may be read again before the pauses were committed
+	o.states.invalidate()
+
+	o.logger.Info("dialog has been purged", zap.Int64s("dialog_ids", dialogIDs), zap.Int("count", count))
+	return count, nil
+}
+
+// EnforceRetention deletes the messages that are older than the retention
+// of their dialog. It returns the number of deleted messages.
+func (o Observation) EnforceRetention(ctx context.Context) (int, error) {
+	states, err := o.db.ObservationState.Query().
+		Where(entobservationstate.RetentionNotNil()).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query observation states: %w", err)
+	}
+
+	var total int
+	for _, state := range states {
+		count, err := o.db.Message.Delete().
+			Where(
+				entmessage.DialogID(state.ID),
+				entmessage.SentAtLT(time.Now().Add(-*state.Retention)),
+			).
+			Exec(ctx)
+		if err != nil {
+			return total, fmt.Errorf("failed to delete messages of dialog %d: %w", state.ID, err)
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// EmbeddingEnabled matches the messages of dialogs whose embedding is not disabled.
+func EmbeddingEnabled() predicate.Message {
+	return func(s *sql.Selector) {
+		t := sql.Table(entobservationstate.Table)
+		s.Where(sql.NotIn(
+			s.C(entmessage.FieldDialogID),
+			sql.Select(t.C(entobservationstate.FieldID)).
+				From(t).
+				Where(sql.EQ(t.C(entobservationstate.FieldEmbeddingEnabled), false)),
+		))
+	}
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %w", err, rerr)
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package searcher
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,13 +10,20 @@ import (
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 	"github.com/samber/lo"
 	"github.com/xyenon/telemikiya/config"
 	"github.com/xyenon/telemikiya/database"
 	"github.com/xyenon/telemikiya/database/ent"
 	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	entmessagechunk "github.com/xyenon/telemikiya/database/ent/messagechunk"
+	entmessagerevision "github.com/xyenon/telemikiya/database/ent/messagerevision"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
 	"github.com/xyenon/telemikiya/embedding/provider"
+	"github.com/xyenon/telemikiya/types"
 	"go.uber.org/fx"
 )
 
@@ -46,29 +54,88 @@ func New(params Params) *Searcher {
 type SearchParams struct {
 	fx.In
 
-	Input     string    `name:"input"`
-	Count     uint      `name:"count"`
-	StartTime time.Time `name:"start_time"`
-	EndTime   time.Time `name:"end_time"`
-	DialogID  int64     `name:"dialog_id"`
+	Input          string    `name:"input"`
+	Count          uint      `name:"count"`
+	StartTime      time.Time `name:"start_time"`
+	EndTime        time.Time `name:"end_time"`
+	DialogID       int64     `name:"dialog_id"`
+	IncludeDeleted bool      `name:"include_deleted"`
+	// MediaType matches the media type, the type of any attached document
+	// (e.g. "video", "audio", "sticker") or its MIME type (e.g. "application/pdf").
+	MediaType string `name:"media_type"`
+	// Filename matches attached documents whose file name contains it, case-insensitively.
+	Filename string `name:"filename"`
+	SenderID int64  `name:"sender_id"`
+	// SenderName matches senders whose name contains it, case-insensitively.
+	SenderName string `name:"sender_name"`
+	// TopicID matches messages in a specific forum topic of the dialog.
+	TopicID int `name:"topic_id"`
+	// CollapseForwards returns forwarded copies of a message only once,
+	// as the best ranked one of the original and its copies.
+	CollapseForwards bool `name:"collapse_forwards"`
 }
 
+// CheckEmbeddingModel returns an error unless the messages are embedded with
+// the configured model, which queries are embedded with.
+func (s Searcher) CheckEmbeddingModel(ctx context.Context) error {
+	return s.db.CheckEmbeddingModel(ctx)
+}
+
+// collapseForwardsFactor is how many more messages are fetched when collapsing
+// forwarded copies, so that enough messages are left afterwards.
+const collapseForwardsFactor = 4
+
+// Search returns the messages best matching the input by both semantic
+// similarity and full-text search. Long messages are matched by their best
+// chunk, which is loaded as their only chunk. It fails if the messages are
+// embedded with another model than the configured one.
 func (s Searcher) Search(ctx context.Context, params SearchParams) ([]*ent.Message, error) {
-	embeddings, err := s.embeddingProvider.Embed(ctx, []string{params.Input})
+	if err := s.CheckEmbeddingModel(ctx); err != nil {
+		return nil, err
+	}
+	embeddings, err := s.embeddingProvider.EmbedQuery(ctx, []string{params.Input})
 	if err != nil {
-		return nil, fmt.Errorf("failed to embed messages: %w", err)
+		return nil, fmt.Errorf("failed to embed query: %w", err)
 	}
 	vector := pgvector.NewVector(embeddings[0])
 
+	var dialogIDs []int64
+	if lo.IsNotEmpty(params.DialogID) {
+		dialogIDs, err = s.ConversationDialogIDs(ctx, params.DialogID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := int(params.Count)
+	if params.CollapseForwards {
+		limit *= collapseForwardsFactor
+	}
+
 	semanticSearch, fullTextSearch := "semantic_search", "full_text_search"
+	fields := []string{
+		entmessage.FieldID,
+		entmessage.FieldMsgID,
+		entmessage.FieldDialogID,
+		entmessage.FieldSenderID,
+		entmessage.FieldText,
+		entmessage.FieldDerivedText,
+		entmessage.FieldSentAt,
+		entmessage.FieldFwdFromID,
+		entmessage.FieldFwdFromName,
+		entmessage.FieldFwdFromMsgID,
+	}
 	fieldRank := "rank"
 	dialectPostgres := sql.Dialect(dialect.Postgres)
 	messageTable := dialectPostgres.Table(entmessage.Table)
 
-	orderByEmbeddingFunc := func(b *sql.Builder) {
-		b.WriteString(messageTable.C(entmessage.FieldTextEmbedding)).
-			Pad().WriteString("<=>").Pad().
-			WriteString("$1")
+	fieldChunkIndex, fieldDistance := "chunk_index", "distance"
+	distanceFunc := func(table *sql.SelectTable) func(b *sql.Builder) {
+		return func(b *sql.Builder) {
+			b.WriteString(table.C(entmessage.FieldTextEmbedding)).
+				Pad().WriteString("<=>").Pad().
+				Arg(vector)
+		}
 	}
 	orderByPgroongaExpr := sql.Expr("pgroonga_score(tableoid, ctid)")
 	coalesceBuilder := func(ident string) func(b *sql.Builder) {
@@ -82,90 +149,184 @@ func (s Searcher) Search(ctx context.Context, params SearchParams) ([]*ent.Messa
 		}
 	}
 
+	// filter restricts the messages selected by q to the ones matching the params
+	filter := func(q *sql.Selector) *sql.Selector {
+		botIDStr := strings.Split(s.cfg.Telegram.BotToken, ":")[0]
+		botID, err := strconv.ParseInt(botIDStr, 10, 64)
+		if err == nil {
+			q = q.Where(sql.NEQ(messageTable.C(entmessage.FieldDialogID), botID))
+		}
+		if !params.StartTime.IsZero() {
+			q = q.Where(sql.GTE(messageTable.C(entmessage.FieldSentAt), params.StartTime))
+		}
+		if !params.EndTime.IsZero() {
+			q = q.Where(sql.LTE(messageTable.C(entmessage.FieldSentAt), params.EndTime))
+		}
+		if len(dialogIDs) > 0 {
+			q = q.Where(sql.In(messageTable.C(entmessage.FieldDialogID), lo.ToAnySlice(dialogIDs)...))
+		}
+		if lo.IsNotEmpty(params.MediaType) {
+			q = q.Where(mediaTypePredicate(messageTable, params.MediaType))
+		}
+		if lo.IsNotEmpty(params.Filename) {
+			q = q.Where(filenamePredicate(messageTable, params.Filename))
+		}
+		if lo.IsNotEmpty(params.TopicID) {
+			q = q.Where(sql.EQ(messageTable.C(entmessage.FieldTopicID), params.TopicID))
+		}
+		if lo.IsNotEmpty(params.SenderID) {
+			q = q.Where(sql.EQ(messageTable.C(entmessage.FieldSenderID), params.SenderID))
+		}
+		if lo.IsNotEmpty(params.SenderName) {
+			senderTable := dialectPostgres.Table(entsender.Table)
+			q = q.Where(sql.In(
+				messageTable.C(entmessage.FieldSenderID),
+				dialectPostgres.Select(senderTable.C(entsender.FieldID)).
+					From(senderTable).
+					Where(sql.P(func(b *sql.Builder) {
+						b.WriteString(senderTable.C(entsender.FieldName)).
+							WriteString(" ILIKE ").
+							Arg("%" + escapeLike(params.SenderName) + "%")
+					})),
+			))
+		}
+		if !params.IncludeDeleted && s.cfg.Telegram.DeletedMessagePolicy != types.DeletedMessageKeep {
+			q = q.Where(sql.IsNull(messageTable.C(entmessage.FieldDeletedAt)))
+		}
+
+		return q
+	}
+
 	subQueryBuilder := func(mode string) sql.TableView {
 		rankBuilder := sql.Window(func(b *sql.Builder) {
 			b.WriteString("RANK").Wrap(func(b *sql.Builder) {})
 		})
 
 		q := dialectPostgres.Select(
-			messageTable.C(entmessage.FieldID),
-			messageTable.C(entmessage.FieldMsgID),
-			messageTable.C(entmessage.FieldDialogID),
-			messageTable.C(entmessage.FieldText),
-			messageTable.C(entmessage.FieldSentAt),
+			lo.Map(fields, func(field string, _ int) string { return messageTable.C(field) })...,
 		).From(messageTable).
-			Limit(int(params.Count)).
+			Limit(limit).
 			As(mode)
 
 		switch mode {
 		case semanticSearch:
-			q = q.AppendSelectExprAs(
-				rankBuilder.OrderExpr(sql.ExprP(
-					dialectPostgres.String(orderByEmbeddingFunc),
-					vector,
-				)),
-				fieldRank,
+			// messages are ranked by the nearest of their own embedding and the
+			// embeddings of their chunks, taken from the nearest of each
+			chunkTable := dialectPostgres.Table(entmessagechunk.Table)
+			nearestMessages := filter(
+				dialectPostgres.Select(messageTable.C(entmessage.FieldID)).
+					AppendSelectExprAs(sql.Expr("NULL::bigint"), fieldChunkIndex).
+					AppendSelectExprAs(sql.ExprFunc(distanceFunc(messageTable)), fieldDistance).
+					From(messageTable).
+					Where(sql.NotNull(messageTable.C(entmessage.FieldTextEmbedding))).
+					OrderExpr(sql.ExprFunc(distanceFunc(messageTable))).
+					Limit(limit),
 			)
-			q = q.OrderExprFunc(orderByEmbeddingFunc)
+			nearestChunks := dialectPostgres.Select(chunkTable.C(entmessagechunk.FieldMessageID), chunkTable.C(entmessagechunk.FieldIndex)).
+				AppendSelectExprAs(sql.ExprFunc(distanceFunc(chunkTable)), fieldDistance).
+				From(chunkTable).
+				Where(sql.In(
+					chunkTable.C(entmessagechunk.FieldMessageID),
+					filter(
+						dialectPostgres.Select(messageTable.C(entmessage.FieldID)).
+							From(messageTable).
+							// the chunks of messages edited since are outdated
+							Where(sql.NotNull(messageTable.C(entmessage.FieldTextEmbedding))),
+					),
+				)).
+				OrderExpr(sql.ExprFunc(distanceFunc(chunkTable))).
+				Limit(limit)
+			candidates := sql.ExprFunc(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) {
+					b.Wrap(func(b *sql.Builder) { b.Join(nearestMessages) }).
+						WriteString(" UNION ALL ").
+						Wrap(func(b *sql.Builder) { b.Join(nearestChunks) })
+				}).WriteString(" AS ").Ident("candidates")
+			})
+			nearest := dialectPostgres.Select(entmessage.FieldID).
+				AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("(array_agg").Wrap(func(b *sql.Builder) {
+						b.Ident(fieldChunkIndex).WriteString(" ORDER BY ").Ident(fieldDistance)
+					}).WriteString(")[1]")
+				}), fieldChunkIndex).
+				AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("MIN").Wrap(func(b *sql.Builder) { b.Ident(fieldDistance) })
+				}), fieldDistance).
+				FromExpr(candidates).
+				GroupBy(entmessage.FieldID).
+				As("nearest")
+
+			q = q.Join(nearest).
+				On(messageTable.C(entmessage.FieldID), nearest.C(entmessage.FieldID)).
+				AppendSelectExprAs(sql.Expr(nearest.C(fieldChunkIndex)), fieldChunkIndex).
+				AppendSelectExprAs(
+					rankBuilder.OrderExpr(sql.Expr(nearest.C(fieldDistance))),
+					fieldRank,
+				).
+				OrderExpr(sql.Expr(nearest.C(fieldDistance)))
 		case fullTextSearch:
 			q = q.AppendSelectExprAs(
 				rankBuilder.OrderExpr(sql.DescExpr(orderByPgroongaExpr)),
 				fieldRank,
 			)
 			q = q.OrderExpr(sql.DescExpr(orderByPgroongaExpr))
-			q = q.Where(sql.P(func(b *sql.Builder) {
-				b.WriteString(messageTable.C(entmessage.FieldText)).
-					Pad().WriteString("&@*").Pad().
-					Arg(params.Input)
-			}))
+			// match the current text, the text derived from media as well as
+			// any text the message had before being edited
+			revisionTable := dialectPostgres.Table(entmessagerevision.Table)
+			q = q.Where(sql.Or(
+				sql.P(func(b *sql.Builder) {
+					b.WriteString(messageTable.C(entmessage.FieldText)).
+						Pad().WriteString("&@*").Pad().
+						Arg(params.Input)
+				}),
+				sql.P(func(b *sql.Builder) {
+					b.WriteString(messageTable.C(entmessage.FieldDerivedText)).
+						Pad().WriteString("&@*").Pad().
+						Arg(params.Input)
+				}),
+				sql.In(
+					messageTable.C(entmessage.FieldID),
+					dialectPostgres.Select(revisionTable.C(entmessagerevision.FieldMessageID)).
+						From(revisionTable).
+						Where(sql.P(func(b *sql.Builder) {
+							b.WriteString(revisionTable.C(entmessagerevision.FieldText)).
+								Pad().WriteString("&@*").Pad().
+								Arg(params.Input)
+						})),
+				),
+			))
+			q = filter(q)
 		default:
 			panic(fmt.Sprintf("unknown mode: %s", mode))
 		}
 
-		botIDStr := strings.Split(s.cfg.Telegram.BotToken, ":")[0]
-		botID, err := strconv.ParseInt(botIDStr, 10, 64)
-		if err == nil {
-			q = q.Where(sql.NEQ(messageTable.C(entmessage.FieldDialogID), botID))
-		}
-		if !params.StartTime.IsZero() {
-			q = q.Where(sql.GTE(messageTable.C(entmessage.FieldSentAt), params.StartTime))
-		}
-		if !params.EndTime.IsZero() {
-			q = q.Where(sql.LTE(messageTable.C(entmessage.FieldSentAt), params.EndTime))
-		}
-		if lo.IsNotEmpty(params.DialogID) {
-			q = q.Where(sql.EQ(messageTable.C(entmessage.FieldDialogID), params.DialogID))
-		}
-
 		return q
 	}
 
+	rankExpr := dialectPostgres.Expr(func(b *sql.Builder) {
+		coalesceBuilder := func(table string) {
+			b.WriteString("COALESCE").
+				Wrap(func(b *sql.Builder) {
+					b.WriteString(dialectPostgres.Table(table).C(fieldRank)).
+						WriteOp(sql.OpDiv).
+						WriteString(fmt.Sprintf("%.1f", float64(limit))).
+						Comma().
+						WriteString("0.0")
+				})
+		}
+		coalesceBuilder(semanticSearch)
+		b.WriteOp(sql.OpAdd)
+		coalesceBuilder(fullTextSearch)
+	})
+
 	messages, err := s.db.Message.Query().
 		Modify(func(s *sql.Selector) {
-			s.Select().
-				AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(entmessage.FieldID)), entmessage.FieldID).
-				AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(entmessage.FieldMsgID)), entmessage.FieldMsgID).
-				AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(entmessage.FieldDialogID)), entmessage.FieldDialogID).
-				AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(entmessage.FieldText)), entmessage.FieldText).
-				AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(entmessage.FieldSentAt)), entmessage.FieldSentAt).
-				AppendSelectExprAs(
-					dialectPostgres.Expr(func(b *sql.Builder) {
-						coalesceBuilder := func(table string) {
-							b.WriteString("COALESCE").
-								Wrap(func(b *sql.Builder) {
-									b.WriteString(dialectPostgres.Table(table).C(fieldRank)).
-										WriteOp(sql.OpDiv).
-										WriteString(fmt.Sprintf("%.1f", float64(params.Count))).
-										Comma().
-										WriteString("0.0")
-								})
-						}
-						coalesceBuilder(semanticSearch)
-						b.WriteOp(sql.OpAdd)
-						coalesceBuilder(fullTextSearch)
-					}),
-					fieldRank,
-				).
+			s.Select()
+			for _, field := range fields {
+				s.AppendSelectExprAs(dialectPostgres.Expr(coalesceBuilder(field)), field)
+			}
+			s.AppendSelectExprAs(sql.Expr(dialectPostgres.Table(semanticSearch).C(fieldChunkIndex)), fieldChunkIndex).
+				AppendSelectExprAs(rankExpr, fieldRank).
 				From(subQueryBuilder(semanticSearch)).
 				FullJoin(subQueryBuilder(fullTextSearch)).
 				On(
@@ -176,12 +337,111 @@ func (s Searcher) Search(ctx context.Context, params SearchParams) ([]*ent.Messa
 					b.Ident(fieldRank).WriteString(" DESC")
 				})
 		}).
-		Limit(int(params.Count)).
+		Limit(limit).
 		WithDialog().
+		WithSender().
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query messages: %w", err)
 	}
+	if err = s.loadMatchedChunks(ctx, messages, fieldChunkIndex); err != nil {
+		return nil, err
+	}
+
+	if params.CollapseForwards {
+		messages = collapseForwards(messages, int(params.Count))
+	}
 
 	return messages, nil
 }
+
+// loadMatchedChunks loads the chunk that each message was found by in semantic
+// search, if any, as its only chunk.
+func (s Searcher) loadMatchedChunks(ctx context.Context, messages []*ent.Message, fieldChunkIndex string) error {
+	var predicates []predicate.MessageChunk
+	for _, message := range messages {
+		value, _ := message.Value(fieldChunkIndex)
+		if index, ok := value.(int64); ok {
+			predicates = append(predicates, entmessagechunk.And(
+				entmessagechunk.MessageID(message.ID),
+				entmessagechunk.Index(int(index)),
+			))
+		}
+	}
+	if len(predicates) == 0 {
+		return nil
+	}
+
+	chunks, err := s.db.MessageChunk.Query().
+		Where(entmessagechunk.Or(predicates...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query message chunks: %w", err)
+	}
+	chunksByMessage := lo.KeyBy(chunks, func(chunk *ent.MessageChunk) uuid.UUID { return chunk.MessageID })
+	for _, message := range messages {
+		if chunk, ok := chunksByMessage[message.ID]; ok {
+			message.Edges.Chunks = []*ent.MessageChunk{chunk}
+		}
+	}
+	return nil
+}
+
+// ConversationDialogIDs returns the IDs of the dialogs making up the same
+// conversation as the given dialog, which are a basic group and the supergroup
+// it was upgraded to.
+func (s Searcher) ConversationDialogIDs(ctx context.Context, dialogID int64) ([]int64, error) {
+	return database.ConversationDialogIDs(ctx, s.db.Dialog, dialogID)
+}
+
+// collapseForwards keeps the first of the messages sharing the same origin,
+// which is the original message for forwarded copies, and returns at most count messages.
+func collapseForwards(messages []*ent.Message, count int) []*ent.Message {
+	type origin struct {
+		dialogID int64
+		msgID    int
+	}
+	messages = lo.UniqBy(messages, func(message *ent.Message) origin {
+		if message.FwdFromID != nil && message.FwdFromMsgID != nil {
+			return origin{*message.FwdFromID, *message.FwdFromMsgID}
+		}
+		return origin{message.DialogID, message.MsgID}
+	})
+	if len(messages) > count {
+		messages = messages[:count]
+	}
+	return messages
+}
+
+func mediaTypePredicate(messageTable *sql.SelectTable, mediaType string) *sql.Predicate {
+	mediaInfo := messageTable.C(entmessage.FieldMediaInfo)
+	containsDocument := func(key string) *sql.Predicate {
+		document, _ := json.Marshal(map[string][]map[string]string{"documents": {{key: mediaType}}})
+		return sql.P(func(b *sql.Builder) {
+			b.WriteString(mediaInfo).Pad().WriteString("@>").Pad().
+				Arg(string(document)).WriteString("::jsonb")
+		})
+	}
+	return sql.Or(
+		sqljson.ValueEQ(mediaInfo, mediaType, sqljson.Path("type")),
+		containsDocument("type"),
+		containsDocument("mime_type"),
+	)
+}
+
+func filenamePredicate(messageTable *sql.SelectTable, filename string) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+			b.WriteString("SELECT 1 FROM jsonb_array_elements").
+				Wrap(func(b *sql.Builder) {
+					b.WriteString(messageTable.C(entmessage.FieldMediaInfo)).WriteString("->'documents'")
+				}).
+				WriteString(" AS document WHERE document->>'filename' ILIKE ").
+				Arg("%" + escapeLike(filename) + "%")
+		})
+	})
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
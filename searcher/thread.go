@@ -0,0 +1,91 @@
+package searcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/types"
+)
+
+const (
+	// maxThreadDepth limits how many ancestors are walked up the reply chain.
+	maxThreadDepth = 20
+	// maxThreadReplies limits how many direct replies are returned.
+	maxThreadReplies = 20
+)
+
+// Thread is the conversation around a message.
+type Thread struct {
+	// Ancestors are the messages the message replies to, oldest first.
+	Ancestors []*ent.Message
+	Message   *ent.Message
+	// Replies are the direct replies to the message, oldest first.
+	Replies []*ent.Message
+}
+
+// Thread walks the reply chain of the given message to reconstruct the discussion around it.
+// The messages are looked up in the whole conversation of the dialog, like search does, and
+// messages around it that have not been recorded, or were deleted, are skipped.
+func (s Searcher) Thread(ctx context.Context, dialogID int64, msgID int) (*Thread, error) {
+	dialogIDs, err := s.ConversationDialogIDs(ctx, dialogID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the message itself is shown even if deleted, like when search includes deleted messages
+	message, err := s.threadMessage(ctx, s.db.Message.Query().WithDialog().WithSender(), dialogIDs, dialogID, msgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+	if message == nil {
+		return nil, fmt.Errorf("message %d of dialog %d is not recorded", msgID, dialogID)
+	}
+	thread := &Thread{Message: message}
+
+	for cur := message; cur.ReplyToMsgID != nil && len(thread.Ancestors) < maxThreadDepth; {
+		if cur, err = s.threadMessage(ctx, s.threadQuery(), dialogIDs, cur.DialogID, *cur.ReplyToMsgID); err != nil {
+			return nil, fmt.Errorf("failed to query replied message: %w", err)
+		}
+		if cur == nil {
+			break
+		}
+		thread.Ancestors = append([]*ent.Message{cur}, thread.Ancestors...)
+	}
+
+	thread.Replies, err = s.threadQuery().
+		Where(entmessage.DialogID(message.DialogID), entmessage.ReplyToMsgID(message.MsgID)).
+		Order(ent.Asc(entmessage.FieldSentAt)).
+		Limit(maxThreadReplies).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query replies: %w", err)
+	}
+
+	return thread, nil
+}
+
+// threadMessage returns the message of the query with the ID in the dialogs,
+// preferring the given dialog as message IDs of a basic group and its
+// supergroup can collide, or nil if it is not recorded.
+func (s Searcher) threadMessage(ctx context.Context, q *ent.MessageQuery, dialogIDs []int64, dialogID int64, msgID int) (*ent.Message, error) {
+	messages, err := q.
+		Where(entmessage.DialogIDIn(dialogIDs...), entmessage.MsgID(msgID)).
+		All(ctx)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+	return lo.FindOrElse(messages, messages[0], func(m *ent.Message) bool { return m.DialogID == dialogID }), nil
+}
+
+// threadQuery queries the messages shown in threads, which leaves out deleted
+// messages unless they are kept.
+func (s Searcher) threadQuery() *ent.MessageQuery {
+	q := s.db.Message.Query().WithDialog().WithSender()
+	if s.cfg.Telegram.DeletedMessagePolicy != types.DeletedMessageKeep {
+		q = q.Where(entmessage.DeletedAtIsNil())
+	}
+	return q
+}
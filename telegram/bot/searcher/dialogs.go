@@ -0,0 +1,65 @@
+package searcher
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/celestix/gotgproto/ext"
+	"github.com/samber/lo"
+)
+
+func (s Searcher) pause(ctx *ext.Context, update *ext.Update) error {
+	dialogID, ok, err := s.parseAdminCommand(ctx, update, "pause")
+	if err != nil || !ok {
+		return err
+	}
+	if err = s.observation.Pause(ctx, dialogID); err != nil {
+		return err
+	}
+	_, err = ctx.Reply(update, ext.ReplyTextString(fmt.Sprintf("Dialog %d is paused.", dialogID)), nil)
+	return err
+}
+
+func (s Searcher) resume(ctx *ext.Context, update *ext.Update) error {
+	dialogID, ok, err := s.parseAdminCommand(ctx, update, "resume")
+	if err != nil || !ok {
+		return err
+	}
+	if err = s.observation.Resume(ctx, dialogID); err != nil {
+		return err
+	}
+	_, err = ctx.Reply(update, ext.ReplyTextString(fmt.Sprintf("Dialog %d is resumed.", dialogID)), nil)
+	return err
+}
+
+func (s Searcher) purge(ctx *ext.Context, update *ext.Update) error {
+	dialogID, ok, err := s.parseAdminCommand(ctx, update, "purge")
+	if err != nil || !ok {
+		return err
+	}
+	count, err := s.observation.Purge(ctx, dialogID)
+	if err != nil {
+		return err
+	}
+	_, err = ctx.Reply(update, ext.ReplyTextString(fmt.Sprintf("Dialog %d is paused and %d messages are deleted.", dialogID, count)), nil)
+	return err
+}
+
+// parseAdminCommand checks that the user is an admin and parses the dialog ID
+// of a "/<command> <dialog_id>" command. It replies with the usage if the
+// arguments are invalid, in which case ok is false.
+func (s Searcher) parseAdminCommand(ctx *ext.Context, update *ext.Update, command string) (dialogID int64, ok bool, err error) {
+	userID := update.EffectiveUser().GetID()
+	if !lo.Contains(s.cfg.BotAdminUserIDs, userID) {
+		return 0, false, fmt.Errorf("user %d is not allowed to manage dialogs", userID)
+	}
+
+	args := update.Args()
+	if len(args) == 2 {
+		if dialogID, err = strconv.ParseInt(args[1], 10, 64); err == nil {
+			return dialogID, true, nil
+		}
+	}
+	_, err = ctx.Reply(update, ext.ReplyTextString(fmt.Sprintf("Usage: /%s <dialog_id>", command)), nil)
+	return 0, false, err
+}
@@ -2,6 +2,7 @@ package searcher
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/celestix/gotgproto/ext"
@@ -20,13 +21,10 @@ func (s Searcher) search(ctx *ext.Context, update *ext.Update) error {
 	}
 
 	input := strings.TrimPrefix(update.EffectiveMessage.Text, "/search")
-	input = strings.TrimSpace(input)
-	s.logger.Info("searching messages", zap.String("text", input))
+	params := parseSearchInput(input)
+	params.Count = 10
+	s.logger.Info("searching messages", zap.String("text", params.Input))
 
-	params := searcher.SearchParams{
-		Input: input,
-		Count: 10,
-	}
 	messages, err := s.searcher.Search(ctx, params)
 	if err != nil {
 		return fmt.Errorf("failed to search messages: %w", err)
@@ -36,8 +34,16 @@ func (s Searcher) search(ctx *ext.Context, update *ext.Update) error {
 		func(message *ent.Message, i int) []styling.StyledTextOption {
 			opts := []styling.StyledTextOption{
 				styling.Bold(fmt.Sprintf("%d. ", i+1)),
-				styling.TextURL(message.Text+"\n", libs.DeepLink(message)),
 			}
+			if origin, ok := libs.ForwardOrigin(message); ok {
+				opts = append(opts, styling.Italic(fmt.Sprintf("[forwarded from %s] ", origin)))
+			}
+			opts = append(opts,
+				styling.TextURL(libs.MatchedText(message)+"\n", libs.DeepLink(message)),
+				// tapping the command copies it, to show the discussion around the message
+				styling.Code(fmt.Sprintf("/thread %d %d", message.DialogID, message.MsgID)),
+				styling.Plain("\n"),
+			)
 			if i < len(messages)-1 {
 				opts = append(opts, styling.Plain("==========\n"))
 			}
@@ -48,3 +54,28 @@ func (s Searcher) search(ctx *ext.Context, update *ext.Update) error {
 
 	return err
 }
+
+// parseSearchInput extracts filters written as "key:value" from the search input,
+// e.g. "/search type:application/pdf filename:invoice from:alice payment".
+func parseSearchInput(input string) (params searcher.SearchParams) {
+	keywords := make([]string, 0)
+	for _, field := range strings.Fields(input) {
+		if v, ok := strings.CutPrefix(field, "type:"); ok {
+			params.MediaType = v
+		} else if v, ok := strings.CutPrefix(field, "filename:"); ok {
+			params.Filename = v
+		} else if v, ok := strings.CutPrefix(field, "topic:"); ok {
+			params.TopicID, _ = strconv.Atoi(v)
+		} else if v, ok := strings.CutPrefix(field, "from:"); ok {
+			if senderID, err := strconv.ParseInt(v, 10, 64); err == nil {
+				params.SenderID = senderID
+			} else {
+				params.SenderName = v
+			}
+		} else {
+			keywords = append(keywords, field)
+		}
+	}
+	params.Input = strings.Join(keywords, " ")
+	return
+}
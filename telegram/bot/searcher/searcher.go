@@ -5,6 +5,7 @@ import (
 
 	"github.com/celestix/gotgproto/dispatcher/handlers"
 	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/observation"
 	"github.com/xyenon/telemikiya/searcher"
 	"github.com/xyenon/telemikiya/telegram"
 	"go.uber.org/fx"
@@ -14,11 +15,12 @@ import (
 type Params struct {
 	fx.In
 
-	LifeCycle fx.Lifecycle
-	Config    *config.Config
-	Logger    *zap.Logger
-	Telegram  *telegram.Telegram `name:"tgBot"`
-	Searcher  *searcher.Searcher
+	LifeCycle   fx.Lifecycle
+	Config      *config.Config
+	Logger      *zap.Logger
+	Telegram    *telegram.Telegram `name:"tgBot"`
+	Searcher    *searcher.Searcher
+	Observation *observation.Observation
 }
 
 type Searcher struct {
@@ -26,6 +28,8 @@ type Searcher struct {
 	logger   *zap.Logger
 	tg       *telegram.Telegram
 	searcher *searcher.Searcher
+
+	observation *observation.Observation
 }
 
 func New(params Params) *Searcher {
@@ -34,11 +38,16 @@ func New(params Params) *Searcher {
 		logger:   params.Logger,
 		tg:       params.Telegram,
 		searcher: params.Searcher,
+
+		observation: params.Observation,
 	}
 
 	if params.LifeCycle != nil {
 		params.LifeCycle.Append(fx.Hook{
 			OnStart: func(ctx context.Context) error {
+				if err := s.searcher.CheckEmbeddingModel(ctx); err != nil {
+					return err
+				}
 				s.Start()
 				return nil
 			},
@@ -51,4 +60,8 @@ func New(params Params) *Searcher {
 func (s Searcher) Start() {
 	dispatcher := s.tg.Dispatcher
 	dispatcher.AddHandler(handlers.NewCommand("search", s.search))
+	dispatcher.AddHandler(handlers.NewCommand("thread", s.thread))
+	dispatcher.AddHandler(handlers.NewCommand("pause", s.pause))
+	dispatcher.AddHandler(handlers.NewCommand("resume", s.resume))
+	dispatcher.AddHandler(handlers.NewCommand("purge", s.purge))
 }
@@ -0,0 +1,58 @@
+package searcher
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/celestix/gotgproto/ext"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database/ent"
+	"github.com/xyenon/telemikiya/libs"
+	"go.uber.org/zap"
+)
+
+func (s Searcher) thread(ctx *ext.Context, update *ext.Update) error {
+	userID := update.EffectiveUser().GetID()
+	if !lo.Contains(s.cfg.BotAllowedUserIDs, userID) {
+		return fmt.Errorf("user %d is not allowed to use this bot", userID)
+	}
+
+	args := update.Args()
+	if len(args) != 3 {
+		_, err := ctx.Reply(update, ext.ReplyTextString("Usage: /thread <dialog_id> <msg_id>"), nil)
+		return err
+	}
+	dialogID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse dialog id: %w", err)
+	}
+	msgID, err := strconv.Atoi(args[2])
+	if err != nil {
+		return fmt.Errorf("failed to parse message id: %w", err)
+	}
+	s.logger.Info("showing thread", zap.Int64("dialog_id", dialogID), zap.Int("msg_id", msgID))
+
+	thread, err := s.searcher.Thread(ctx, dialogID, msgID)
+	if err != nil {
+		return fmt.Errorf("failed to get thread: %w", err)
+	}
+
+	formatMessage := func(message *ent.Message) string {
+		name := "unknown"
+		if sender := message.Edges.Sender; sender != nil {
+			name = sender.Name
+		}
+		return fmt.Sprintf("%s: %s\n", name, libs.DisplayText(message))
+	}
+	opts := lo.Map(thread.Ancestors, func(message *ent.Message, _ int) styling.StyledTextOption {
+		return styling.Plain(formatMessage(message))
+	})
+	opts = append(opts, styling.TextURL(formatMessage(thread.Message), libs.DeepLink(thread.Message)))
+	for _, message := range thread.Replies {
+		opts = append(opts, styling.Italic("↳ "+formatMessage(message)))
+	}
+	_, err = ctx.Reply(update, ext.ReplyTextStyledTextArray(opts), nil)
+
+	return err
+}
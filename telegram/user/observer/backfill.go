@@ -0,0 +1,193 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celestix/gotgproto/ext"
+	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/telegram/query"
+	"github.com/gotd/td/telegram/query/dialogs"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+// Backfill pages through the history of every observed dialog and records
+// messages sent before the observer was started. Progress is stored per dialog,
+// so an interrupted backfill resumes where it stopped.
+func (r Observer) Backfill(ctx context.Context) error {
+	tgCtx := r.tg.CreateContext()
+	tgCtx.Context = ctx
+
+	var elems []dialogs.Elem
+	err := r.withFloodWait(ctx, func() (err error) {
+		elems, err = query.GetDialogs(tgCtx.Raw).BatchSize(100).Collect(ctx)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get dialogs: %w", err)
+	}
+	r.logger.Info("fetched dialogs", zap.Int("count", len(elems)))
+
+	for _, elem := range elems {
+		chat, ok := effectiveChat(elem.Dialog.GetPeer(), elem.Entities)
+		if !ok {
+			r.logger.Warn("dialog entity not found", zap.Any("peer", elem.Dialog.GetPeer()))
+			continue
+		}
+		dialogID, err := types.FromEffectiveChat(chat).ID()
+		if err != nil {
+			return fmt.Errorf("failed to get dialog id: %w", err)
+		}
+		observed, err := r.isObserved(ctx, chat)
+		if err != nil {
+			return fmt.Errorf("failed to check if dialog %d is observed: %w", dialogID, err)
+		}
+		if !observed {
+			r.logger.Debug("dialog is not observed", zap.Int64("dialog_id", dialogID))
+			continue
+		}
+
+		if err = r.backfillDialog(tgCtx, dialogID, chat, elem.Peer); err != nil {
+			return fmt.Errorf("failed to backfill dialog %d: %w", dialogID, err)
+		}
+	}
+
+	return nil
+}
+
+func (r Observer) backfillDialog(ctx *ext.Context, dialogID int64, chat tgtypes.EffectiveChat, inputPeer tg.InputPeerClass) error {
+	err := r.saveDialog(ctx, dialogID, chat)
+	if err != nil {
+		return fmt.Errorf("failed to save dialog: %w", err)
+	}
+
+	dialog, err := r.db.Dialog.Get(ctx, dialogID)
+	if err != nil {
+		return fmt.Errorf("failed to query dialog: %w", err)
+	}
+	if dialog.BackfillCompletedAt != nil {
+		r.logger.Debug("dialog is already backfilled", zap.Int64("dialog_id", dialogID))
+		return nil
+	}
+
+	var offsetID, saved int
+	// a fresh backfill starts from the newest message
+	fresh := dialog.BackfillOffsetID == nil
+	if !fresh {
+		offsetID = *dialog.BackfillOffsetID
+	}
+	r.logger.Info("backfilling dialog", zap.Int64("dialog_id", dialogID), zap.Int("offset_id", offsetID))
+
+	for {
+		var history tg.MessagesMessagesClass
+		err = r.withFloodWait(ctx, func() (err error) {
+			history, err = ctx.Raw.MessagesGetHistory(ctx, &tg.MessagesGetHistoryRequest{
+				Peer:     inputPeer,
+				OffsetID: offsetID,
+				Limit:    r.cfg.BackfillBatchSize,
+			})
+			return
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get history: %w", err)
+		}
+		modified, ok := history.AsModified()
+		if !ok {
+			return fmt.Errorf("unexpected history type: %T", history)
+		}
+
+		entities := peer.NewEntities(
+			tg.UserClassArray(modified.GetUsers()).UserToMap(),
+			tg.ChatClassArray(modified.GetChats()).ChatToMap(),
+			tg.ChatClassArray(modified.GetChats()).ChannelToMap(),
+		)
+		msgs := modified.GetMessages()
+		if len(msgs) == 0 {
+			break
+		}
+		for _, m := range msgs {
+			if offsetID == 0 || m.GetID() < offsetID {
+				offsetID = m.GetID()
+			}
+			switch msg := m.(type) {
+			case *tg.MessageService:
+				if err = r.linkMigration(ctx, dialogID, msg.GetAction()); err != nil {
+					return err
+				}
+			case *tg.Message:
+				if !isRecordable(tgtypes.ConstructMessage(msg)) {
+					continue
+				}
+				if err = r.saveMessage(ctx, dialogID, tgtypes.ConstructMessage(msg), entities); err != nil {
+					return err
+				}
+				saved++
+			}
+		}
+
+		_, err = r.db.Dialog.UpdateOneID(dialogID).SetBackfillOffsetID(offsetID).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save backfill progress: %w", err)
+		}
+		if fresh {
+			// the messages after the newest one are left to catching up, as
+			// the backfill records everything before it
+			newest := lo.Max(lo.Map(msgs, func(m tg.MessageClass, _ int) int { return m.GetID() }))
+			if err = database.AdvanceLastMsgID(ctx, r.db.Dialog, dialogID, newest); err != nil {
+				return err
+			}
+			fresh = false
+		}
+	}
+
+	_, err = r.db.Dialog.UpdateOneID(dialogID).SetBackfillCompletedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save backfill progress: %w", err)
+	}
+	r.logger.Info("dialog has been backfilled", zap.Int64("dialog_id", dialogID), zap.Int("saved", saved))
+
+	return nil
+}
+
+// withFloodWait calls f until it succeeds or fails with an error other than
+// FLOOD_WAIT, sleeping for the duration requested by Telegram in between.
+func (r Observer) withFloodWait(ctx context.Context, f func() error) error {
+	return withFloodWait(ctx, r.logger, f)
+}
+
+func withFloodWait(ctx context.Context, logger *zap.Logger, f func() error) error {
+	for {
+		err := f()
+		if d, ok := tgerr.AsFloodWait(err); ok {
+			logger.Warn("flood wait", zap.Duration("duration", d))
+		}
+		if retry, err := tgerr.FloodWait(ctx, err); !retry {
+			return err
+		}
+	}
+}
+
+func effectiveChat(p tg.PeerClass, entities peer.Entities) (tgtypes.EffectiveChat, bool) {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		if u, ok := entities.User(p.GetUserID()); ok {
+			return (*tgtypes.User)(u), true
+		}
+	case *tg.PeerChat:
+		if c, ok := entities.Chat(p.GetChatID()); ok {
+			return (*tgtypes.Chat)(c), true
+		}
+	case *tg.PeerChannel:
+		if c, ok := entities.Channel(p.GetChannelID()); ok {
+			return (*tgtypes.Channel)(c), true
+		}
+	}
+	return nil, false
+}
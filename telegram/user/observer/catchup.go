@@ -0,0 +1,190 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/celestix/gotgproto/ext"
+	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/telegram/query"
+	"github.com/gotd/td/telegram/query/dialogs"
+	"github.com/gotd/td/tg"
+	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/database/ent"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+// CatchUp records the messages sent to observed dialogs while the observer was
+// down, starting after the high-water mark of each dialog. Until it finishes,
+// live messages do not advance the high-water marks, so an interrupted catch-up
+// is resumed on the next start. Edits and deletions made while the observer
+// was down are not recovered, as Telegram does not list them.
+func (r Observer) CatchUp(ctx context.Context) error {
+	r.catchingUp.Store(true)
+	defer r.catchingUp.Store(false)
+
+	tgCtx := r.tg.CreateContext()
+	tgCtx.Context = ctx
+
+	marks, err := r.catchUpMarks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(marks) == 0 {
+		r.logger.Info("no dialog to catch up")
+		return nil
+	}
+
+	var elems []dialogs.Elem
+	err = r.withFloodWait(ctx, func() (err error) {
+		elems, err = query.GetDialogs(tgCtx.Raw).BatchSize(100).Collect(ctx)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get dialogs: %w", err)
+	}
+
+	var caughtUp, recovered int
+	for _, elem := range elems {
+		dlg, ok := elem.Dialog.(*tg.Dialog)
+		if !ok {
+			continue
+		}
+		chat, ok := effectiveChat(dlg.GetPeer(), elem.Entities)
+		if !ok {
+			r.logger.Warn("dialog entity not found", zap.Any("peer", dlg.GetPeer()))
+			continue
+		}
+		dialogID, err := types.FromEffectiveChat(chat).ID()
+		if err != nil {
+			return fmt.Errorf("failed to get dialog id: %w", err)
+		}
+		mark, ok := marks[dialogID]
+		if !ok || dlg.GetTopMessage() <= mark {
+			continue
+		}
+		observed, err := r.isObserved(ctx, chat)
+		if err != nil {
+			return fmt.Errorf("failed to check if dialog %d is observed: %w", dialogID, err)
+		}
+		if !observed {
+			continue
+		}
+
+		saved, err := r.catchUpDialog(tgCtx, dialogID, elem.Peer, mark)
+		if err != nil {
+			return fmt.Errorf("failed to catch up dialog %d: %w", dialogID, err)
+		}
+		caughtUp++
+		recovered += saved
+	}
+
+	r.logger.Info("caught up on missed messages", zap.Int("dialogs", caughtUp), zap.Int("recovered", recovered))
+	return nil
+}
+
+// catchUpDialog pages through the messages newer than mark, oldest first,
+// advancing the high-water mark after each page.
+func (r Observer) catchUpDialog(ctx *ext.Context, dialogID int64, inputPeer tg.InputPeerClass, mark int) (int, error) {
+	r.logger.Info("catching up dialog", zap.Int64("dialog_id", dialogID), zap.Int("last_msg_id", mark))
+
+	var saved int
+	for {
+		var history tg.MessagesMessagesClass
+		err := r.withFloodWait(ctx, func() (err error) {
+			history, err = ctx.Raw.MessagesGetHistory(ctx, &tg.MessagesGetHistoryRequest{
+				Peer:      inputPeer,
+				OffsetID:  mark + 1,
+				AddOffset: -r.cfg.BackfillBatchSize,
+				Limit:     r.cfg.BackfillBatchSize,
+				MinID:     mark,
+			})
+			return
+		})
+		if err != nil {
+			return saved, fmt.Errorf("failed to get history: %w", err)
+		}
+		modified, ok := history.AsModified()
+		if !ok {
+			return saved, fmt.Errorf("unexpected history type: %T", history)
+		}
+
+		entities := peer.NewEntities(
+			tg.UserClassArray(modified.GetUsers()).UserToMap(),
+			tg.ChatClassArray(modified.GetChats()).ChatToMap(),
+			tg.ChatClassArray(modified.GetChats()).ChannelToMap(),
+		)
+		msgs := slices.DeleteFunc(modified.GetMessages(), func(m tg.MessageClass) bool {
+			return m.GetID() <= mark
+		})
+		if len(msgs) == 0 {
+			break
+		}
+		slices.SortFunc(msgs, func(a, b tg.MessageClass) int {
+			return a.GetID() - b.GetID()
+		})
+		for _, m := range msgs {
+			switch msg := m.(type) {
+			case *tg.MessageService:
+				if err = r.linkMigration(ctx, dialogID, msg.GetAction()); err != nil {
+					return saved, err
+				}
+			case *tg.Message:
+				if !isRecordable(tgtypes.ConstructMessage(msg)) {
+					continue
+				}
+				if err = r.saveMessage(ctx, dialogID, tgtypes.ConstructMessage(msg), entities); err != nil {
+					return saved, err
+				}
+				saved++
+			}
+		}
+
+		mark = msgs[len(msgs)-1].GetID()
+		if err = database.AdvanceLastMsgID(ctx, r.db.Dialog, dialogID, mark); err != nil {
+			return saved, err
+		}
+	}
+
+	r.logger.Info("dialog has been caught up", zap.Int64("dialog_id", dialogID), zap.Int("recovered", saved))
+	return saved, nil
+}
+
+// catchUpMarks returns the high-water marks of the dialogs. Dialogs without
+// one, like those recorded before marks were kept, start after their newest
+// stored message.
+func (r Observer) catchUpMarks(ctx context.Context) (map[int64]int, error) {
+	marks := map[int64]int{}
+	stored, err := r.db.Dialog.Query().
+		Where(entdialog.LastMsgIDNotNil()).
+		Select(entdialog.FieldID, entdialog.FieldLastMsgID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dialogs: %w", err)
+	}
+	for _, dialog := range stored {
+		marks[dialog.ID] = *dialog.LastMsgID
+	}
+
+	var newest []struct {
+		DialogID int64 `json:"dialog_id"`
+		Max      int   `json:"max"`
+	}
+	err = r.db.Message.Query().
+		Where(entmessage.HasDialogWith(entdialog.LastMsgIDIsNil())).
+		GroupBy(entmessage.FieldDialogID).
+		Aggregate(ent.Max(entmessage.FieldMsgID)).
+		Scan(ctx, &newest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query newest messages: %w", err)
+	}
+	for _, n := range newest {
+		marks[n.DialogID] = n.Max
+	}
+	return marks, nil
+}
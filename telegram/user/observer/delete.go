@@ -0,0 +1,76 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/celestix/gotgproto/ext"
+	tdconstant "github.com/gotd/td/constant"
+	"github.com/gotd/td/tg"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
+	"github.com/xyenon/telemikiya/database/ent/predicate"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+func (r Observer) delete(ctx *ext.Context, update *ext.Update) error {
+	var predicates []predicate.Message
+	switch u := update.UpdateClass.(type) {
+	case *tg.UpdateDeleteMessages:
+		// message IDs of private chats and basic groups are unique per account,
+		// so the update does not tell which dialog they belong to
+		predicates = []predicate.Message{
+			entmessage.MsgIDIn(u.GetMessages()...),
+			predicate.Message(sql.FieldGT(entmessage.FieldDialogID, tdconstant.ZeroTDLibChannelID)),
+		}
+	case *tg.UpdateDeleteChannelMessages:
+		dialogID, err := types.FromPeerClass(&tg.PeerChannel{ChannelID: u.GetChannelID()}).ID()
+		if err != nil {
+			return fmt.Errorf("failed to get dialog id: %w", err)
+		}
+		predicates = []predicate.Message{
+			entmessage.MsgIDIn(u.GetMessages()...),
+			entmessage.DialogID(dialogID),
+		}
+	default:
+		return nil
+	}
+
+	count, err := r.db.Message.Update().
+		Where(predicates...).
+		Where(entmessage.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages as deleted: %w", err)
+	}
+	r.logger.Info("marked messages as deleted", zap.Int("count", count))
+
+	return nil
+}
+
+// purgeDeleted periodically removes messages that have been deleted for longer
+// than the configured retention period.
+func (r Observer) purgeDeleted(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		count, err := r.db.Message.Delete().
+			Where(entmessage.DeletedAtLT(time.Now().Add(-r.cfg.DeletedMessageRetention))).
+			Exec(ctx)
+		if err != nil {
+			r.logger.Error("failed to purge deleted messages", zap.Error(err))
+		} else if count > 0 {
+			r.logger.Info("purged deleted messages", zap.Int("count", count))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestix/gotgproto/ext"
+	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/tg"
+	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+// isMigration reports whether the message announces the upgrade of a basic
+// group to a supergroup.
+func isMigration(msg *tgtypes.Message) bool {
+	switch msg.Action.(type) {
+	case *tg.MessageActionChatMigrateTo, *tg.MessageActionChannelMigrateFrom:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r Observer) migrate(ctx *ext.Context, update *ext.Update) error {
+	dialogID, err := types.FromEffectiveChat(update.EffectiveChat()).ID()
+	if err != nil {
+		return fmt.Errorf("failed to get dialog id: %w", err)
+	}
+	return r.linkMigration(ctx, dialogID, update.EffectiveMessage.Action)
+}
+
+// linkMigration records the link between a basic group and the supergroup it
+// was upgraded to, as announced by a service message in either of them.
+func (r Observer) linkMigration(ctx context.Context, dialogID int64, action tg.MessageActionClass) error {
+	var fromID, toID int64
+	var err error
+	switch action := action.(type) {
+	case *tg.MessageActionChatMigrateTo:
+		fromID = dialogID
+		toID, err = types.FromPeerClass(&tg.PeerChannel{ChannelID: action.GetChannelID()}).ID()
+	case *tg.MessageActionChannelMigrateFrom:
+		fromID, err = types.FromPeerClass(&tg.PeerChat{ChatID: action.GetChatID()}).ID()
+		toID = dialogID
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get dialog id: %w", err)
+	}
+
+	count, err := r.db.Dialog.Update().
+		Where(entdialog.ID(fromID), entdialog.MigratedToIDIsNil()).
+		SetMigratedToID(toID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save dialog migration: %w", err)
+	}
+	if count > 0 {
+		r.logger.Info("dialog has been migrated", zap.Int64("from_dialog_id", fromID), zap.Int64("to_dialog_id", toID))
+	}
+	return nil
+}
+
+// migratedTo returns the dialog ID of the supergroup a basic group was upgraded to.
+func migratedTo(chat tgtypes.EffectiveChat) *int64 {
+	c, ok := chat.(*tgtypes.Chat)
+	if !ok {
+		return nil
+	}
+	channel, ok := c.MigratedTo.(*tg.InputChannel)
+	if !ok {
+		return nil
+	}
+	dialogID, err := types.FromPeerClass(&tg.PeerChannel{ChannelID: channel.GetChannelID()}).ID()
+	if err != nil {
+		return nil
+	}
+	return &dialogID
+}
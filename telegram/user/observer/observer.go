@@ -3,12 +3,14 @@ package observer
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/celestix/gotgproto/dispatcher/handlers"
-	"github.com/celestix/gotgproto/dispatcher/handlers/filters"
 	"github.com/xyenon/telemikiya/config"
 	"github.com/xyenon/telemikiya/database"
+	"github.com/xyenon/telemikiya/observation"
 	"github.com/xyenon/telemikiya/telegram"
+	"github.com/xyenon/telemikiya/types"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -16,11 +18,12 @@ import (
 type Params struct {
 	fx.In
 
-	LifeCycle fx.Lifecycle
-	Config    *config.Config
-	Logger    *zap.Logger
-	Telegram  *telegram.Telegram `name:"tgUser"`
-	DataBase  *database.Database
+	Config      *config.Config
+	Logger      *zap.Logger
+	Telegram    *telegram.Telegram `name:"tgUser"`
+	DataBase    *database.Database
+	Observation *observation.Observation
+	Evaluator   *Evaluator
 }
 
 type Observer struct {
@@ -29,31 +32,68 @@ type Observer struct {
 	tg     *telegram.Telegram
 	db     *database.Database
 
+	observation *observation.Observation
+
 	dialogLock *sync.Map
+	senderLock *sync.Map
+	catchingUp *atomic.Bool
+
+	evaluator *Evaluator
 }
 
 func New(params Params) *Observer {
 	r := &Observer{
-		cfg:        &params.Config.Telegram,
-		logger:     params.Logger,
-		tg:         params.Telegram,
-		db:         params.DataBase,
-		dialogLock: &sync.Map{},
-	}
+		cfg:    &params.Config.Telegram,
+		logger: params.Logger,
+		tg:     params.Telegram,
+		db:     params.DataBase,
 
-	if params.LifeCycle != nil {
-		params.LifeCycle.Append(fx.Hook{
-			OnStart: func(ctx context.Context) error {
-				r.Start()
-				return nil
-			},
-		})
+		observation: params.Observation,
+		dialogLock:  &sync.Map{},
+		senderLock:  &sync.Map{},
+		catchingUp:  &atomic.Bool{},
+		evaluator:   params.Evaluator,
 	}
 
 	return r
 }
 
+// Observe starts observing when the app starts. It is only registered by the
+// run command, so that one-shot commands using the observer do not start the
+// live handlers and the background jobs along with it.
+func Observe(lifeCycle fx.Lifecycle, r *Observer) {
+	lifeCycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			r.Start()
+			return nil
+		},
+	})
+}
+
 func (r Observer) Start() {
+	// live messages must not advance the high-water marks before catching up
+	r.catchingUp.Store(true)
+	go func() {
+		if err := r.CatchUp(r.tg.CreateContext()); err != nil {
+			r.logger.Error("failed to catch up on missed messages", zap.Error(err))
+		}
+	}()
+
 	dispatcher := r.tg.Dispatcher
-	dispatcher.AddHandler(handlers.NewMessage(filters.Message.Text, r.record))
+	dispatcher.AddHandler(handlers.NewMessage(isMessage, r.record))
+	dispatcher.AddHandler(handlers.NewAnyUpdate(r.delete))
+	dispatcher.AddHandler(handlers.NewMessage(isMigration, r.migrate))
+
+	go r.enforceRetention(r.tg.CreateContext())
+	if r.cfg.DeletedMessagePolicy == types.DeletedMessagePurge {
+		go r.purgeDeleted(r.tg.CreateContext())
+	}
+
+	if r.cfg.BackfillOnStart {
+		go func() {
+			if err := r.Backfill(r.tg.CreateContext()); err != nil {
+				r.logger.Error("failed to backfill history", zap.Error(err))
+			}
+		}()
+	}
 }
@@ -4,26 +4,44 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/celestix/gotgproto/ext"
 	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/telegram/message/peer"
 	"github.com/gotd/td/tg"
 	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/database"
 	"github.com/xyenon/telemikiya/database/ent"
 	entdialog "github.com/xyenon/telemikiya/database/ent/dialog"
+	entmessage "github.com/xyenon/telemikiya/database/ent/message"
 	"github.com/xyenon/telemikiya/types"
 	"go.uber.org/zap"
 )
 
 func (r Observer) record(ctx *ext.Context, update *ext.Update) error {
+	var edited bool
+	switch update.UpdateClass.(type) {
+	case *tg.UpdateEditMessage, *tg.UpdateEditChannelMessage:
+		edited = true
+	}
+	// edits are applied even if nothing worth recording is left, so that the
+	// previous text of a stored message is no longer searchable
+	if !edited && !isRecordable(update.EffectiveMessage) {
+		return nil
+	}
+
 	dialog := types.FromEffectiveChat(update.EffectiveChat())
 	dialogID, err := dialog.ID()
 	if err != nil {
 		return fmt.Errorf("failed to get dialog id: %w", err)
 	}
-	if len(r.cfg.ObservedDialogIDs) > 0 &&
-		!lo.Contains(r.cfg.ObservedDialogIDs, dialogID) {
+	observed, err := r.isObserved(ctx, update.EffectiveChat())
+	if err != nil {
+		return fmt.Errorf("failed to check if dialog is observed: %w", err)
+	}
+	if !observed {
 		r.logger.Info("dialog is not observed", zap.Int64("dialog_id", dialogID))
 		return nil
 	}
@@ -33,14 +51,38 @@ func (r Observer) record(ctx *ext.Context, update *ext.Update) error {
 		return fmt.Errorf("failed to save dialog: %w", err)
 	}
 
-	err = r.saveMessage(ctx, dialogID, update.EffectiveMessage)
-	if err != nil {
-		return fmt.Errorf("failed to save message: %w", err)
+	if edited {
+		err = r.editMessage(ctx, dialogID, update.EffectiveMessage, peer.EntitiesFromUpdate(*update.Entities))
+		if err != nil {
+			return fmt.Errorf("failed to edit message: %w", err)
+		}
+	} else {
+		err = r.saveMessage(ctx, dialogID, update.EffectiveMessage, peer.EntitiesFromUpdate(*update.Entities))
+		if err != nil {
+			return fmt.Errorf("failed to save message: %w", err)
+		}
+		if !r.catchingUp.Load() {
+			err = database.AdvanceLastMsgID(ctx, r.db.Dialog, dialogID, update.EffectiveMessage.GetID())
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// isMessage reports whether the message is not a service message. Unlike
+// isRecordable, it lets through edits that remove the text of a message.
+func isMessage(msg *tgtypes.Message) bool {
+	return !msg.IsService
+}
+
+// isRecordable reports whether the message carries text or media worth recording.
+func isRecordable(msg *tgtypes.Message) bool {
+	return !msg.IsService && (msg.Text != "" || msg.Media != nil)
+}
+
 func (r Observer) saveDialog(ctx *ext.Context, dialogID int64, chat tgtypes.EffectiveChat) error {
 	dialog := types.FromEffectiveChat(chat)
 
@@ -96,10 +138,10 @@ func (r Observer) saveDialog(ctx *ext.Context, dialogID int64, chat tgtypes.Effe
 
 	if exist {
 		r.logger.Info("updating dialog", zap.Int64("dialog_id", dialogID), zap.String("title", title))
-		_, err = r.db.Dialog.UpdateOneID(dialogID).SetTitle(title).Save(ctx)
+		_, err = r.db.Dialog.UpdateOneID(dialogID).SetTitle(title).SetNillableMigratedToID(migratedTo(chat)).Save(ctx)
 	} else {
 		r.logger.Info("creating dialog", zap.Int64("dialog_id", dialogID), zap.String("title", title))
-		_, err = r.db.Dialog.Create().SetID(dialogID).SetTitle(title).SetType(dialogType).Save(ctx)
+		_, err = r.db.Dialog.Create().SetID(dialogID).SetTitle(title).SetType(dialogType).SetNillableMigratedToID(migratedTo(chat)).Save(ctx)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to save dialog: %w", err)
@@ -107,9 +149,198 @@ func (r Observer) saveDialog(ctx *ext.Context, dialogID int64, chat tgtypes.Effe
 	return nil
 }
 
-func (r Observer) saveMessage(ctx context.Context, dialogID int64, msg *tgtypes.Message) (err error) {
-	msgID, hasMedia, mediaInfo := msg.GetID(), false, types.MediaInfo{}
+func (r Observer) saveMessage(ctx context.Context, dialogID int64, msg *tgtypes.Message, entities peer.Entities) error {
+	msgID := msg.GetID()
 	sentAt := time.Unix(int64(msg.GetDate()), 0).UTC()
+	hasMedia, mediaInfo, derivedText := r.parseMedia(ctx, msg)
+
+	senderID, err := r.saveSender(ctx, msg, entities)
+	if err != nil {
+		return fmt.Errorf("failed to save sender: %w", err)
+	}
+	replyToMsgID, topMsgID, topicID := replyInfo(msg)
+	fwd := parseForward(msg, entities)
+
+	r.logger.Info("saving message", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	create := tx.Message.Create().
+		SetMsgID(msgID).
+		SetDialogID(dialogID).
+		SetNillableSenderID(senderID).
+		SetNillableReplyToMsgID(replyToMsgID).
+		SetNillableTopMsgID(topMsgID).
+		SetNillableTopicID(topicID).
+		SetNillableFwdFromID(fwd.fromID).
+		SetNillableFwdFromName(fwd.fromName).
+		SetNillableFwdFromMsgID(fwd.fromMsgID).
+		SetNillableFwdFromDate(fwd.fromDate).
+		SetNillableFwdPostAuthor(fwd.postAuthor).
+		SetText(msg.GetMessage()).
+		SetDerivedText(derivedText).
+		SetHasMedia(hasMedia).
+		SetMediaInfo(&mediaInfo).
+		SetSentAt(sentAt)
+	changed, err := database.UpsertMessage(ctx, tx, create)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to save message: %w", err))
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	if !changed {
+		r.logger.Debug("message is unchanged", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+	}
+	return nil
+}
+
+// editMessage applies an edit to a stored message. If the text changed, the
+// previous text is kept as a revision and the embedding is cleared so that the
+// embedding service embeds the new text.
+func (r Observer) editMessage(ctx context.Context, dialogID int64, msg *tgtypes.Message, entities peer.Entities) error {
+	msgID := msg.GetID()
+	oldMessage, err := r.db.Message.Query().
+		Where(entmessage.MsgID(msgID), entmessage.DialogID(dialogID)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		if !isRecordable(msg) {
+			r.logger.Debug("edited message not found and not recordable", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+			return nil
+		}
+		r.logger.Info("edited message not found, saving as new", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+		return r.saveMessage(ctx, dialogID, msg, entities)
+	case err != nil:
+		return fmt.Errorf("failed to query message: %w", err)
+	}
+
+	hasMedia, mediaInfo, derivedText := r.parseMedia(ctx, msg)
+	editDate, ok := msg.GetEditDate()
+	if !ok {
+		editDate = int(time.Now().Unix())
+	}
+	editedAt := time.Unix(int64(editDate), 0).UTC()
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	update := tx.Message.UpdateOne(oldMessage).
+		SetHasMedia(hasMedia).
+		SetMediaInfo(&mediaInfo).
+		SetEditedAt(editedAt)
+	if oldMessage.Text != msg.GetMessage() {
+		r.logger.Info("saving message revision", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+		_, err = tx.MessageRevision.Create().
+			SetMessageID(oldMessage.ID).
+			SetText(oldMessage.Text).
+			SetEditedAt(editedAt).
+			Save(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to save message revision: %w", err))
+		}
+		update = update.SetText(msg.GetMessage())
+		database.ResetEmbedding(update.Mutation())
+	}
+	if oldMessage.DerivedText != derivedText {
+		update = update.SetDerivedText(derivedText)
+		database.ResetEmbedding(update.Mutation())
+	}
+
+	r.logger.Info("updating message", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
+	if _, err = update.Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to update message: %w", err))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// replyInfo returns the message the given message replies to, the top message
+// of the thread it belongs to and, in forums, its topic ID.
+// Replies to messages in other dialogs are ignored.
+func replyInfo(msg *tgtypes.Message) (replyToMsgID, topMsgID, topicID *int) {
+	g, ok := msg.GetReplyTo()
+	if !ok {
+		return
+	}
+	header, ok := g.(*tg.MessageReplyHeader)
+	if !ok {
+		return
+	}
+	if _, ok := header.GetReplyToPeerID(); ok {
+		return
+	}
+
+	if v, ok := header.GetReplyToMsgID(); ok {
+		replyToMsgID = &v
+	}
+	if v, ok := header.GetReplyToTopID(); ok {
+		topMsgID = &v
+	}
+	if header.GetForumTopic() {
+		// the top message ID is the topic ID, or the replied message
+		// itself is the topic creation message
+		topicID = lo.CoalesceOrEmpty(topMsgID, replyToMsgID)
+	}
+	return
+}
+
+type forwardInfo struct {
+	fromID     *int64
+	fromName   *string
+	fromMsgID  *int
+	fromDate   *time.Time
+	postAuthor *string
+}
+
+// parseForward returns the origin of a forwarded message.
+// The original message ID is only known for messages forwarded from channels.
+func parseForward(msg *tgtypes.Message, entities peer.Entities) (info forwardInfo) {
+	header, ok := msg.GetFwdFrom()
+	if !ok {
+		return
+	}
+
+	fromDate := time.Unix(int64(header.GetDate()), 0).UTC()
+	info.fromDate = &fromDate
+	if fromID, ok := header.GetFromID(); ok {
+		if id, err := types.FromPeerClass(fromID).ID(); err == nil {
+			info.fromID = &id
+		}
+		if chat, ok := effectiveChat(fromID, entities); ok {
+			if title, err := types.FromEffectiveChat(chat).Title(); err == nil {
+				info.fromName = &title
+			}
+		}
+	}
+	if fromName, ok := header.GetFromName(); ok {
+		// the original sender hides their account, only the name is known
+		info.fromName = &fromName
+	}
+	if fromMsgID, ok := header.GetChannelPost(); ok {
+		info.fromMsgID = &fromMsgID
+	}
+	if postAuthor, ok := header.GetPostAuthor(); ok {
+		info.postAuthor = &postAuthor
+	}
+	return
+}
+
+// parseMedia extracts media metadata of the message. Searchable text found in
+// link previews, polls and venues is returned as derivedText.
+func (r Observer) parseMedia(ctx context.Context, msg *tgtypes.Message) (hasMedia bool, mediaInfo types.MediaInfo, derivedText string) {
+	msgID := msg.GetID()
+	derivedTexts := make([]string, 0)
+	defer func() {
+		derivedText = strings.Join(lo.Compact(derivedTexts), "\n")
+	}()
+
 	media, ok := msg.GetMedia()
 	if ok {
 		switch v := media.(type) {
@@ -161,11 +392,24 @@ func (r Observer) saveMessage(ctx context.Context, dialogID int64, msg *tgtypes.
 			if len(documentInfos) > 0 {
 				hasMedia = true
 				mediaInfo.Type = "documents"
+				mediaInfo.Documents = documentInfos
 			}
 		case *tg.MessageMediaWebPage:
 			r.logger.Debug("webpage media", zap.Int("msg_id", msgID))
+			if w, ok := v.GetWebpage().(*tg.WebPage); ok {
+				hasMedia = true
+				mediaInfo.Type = "webpage"
+				siteName, _ := w.GetSiteName()
+				title, _ := w.GetTitle()
+				description, _ := w.GetDescription()
+				author, _ := w.GetAuthor()
+				derivedTexts = append(derivedTexts, siteName, title, description, author)
+			}
 		case *tg.MessageMediaVenue:
 			r.logger.Debug("venue media", zap.Int("msg_id", msgID))
+			hasMedia = true
+			mediaInfo.Type = "venue"
+			derivedTexts = append(derivedTexts, v.GetTitle(), v.GetAddress())
 		case *tg.MessageMediaGame:
 			r.logger.Debug("game media", zap.Int("msg_id", msgID))
 		case *tg.MessageMediaInvoice:
@@ -174,6 +418,13 @@ func (r Observer) saveMessage(ctx context.Context, dialogID int64, msg *tgtypes.
 			r.logger.Debug("geo live media", zap.Int("msg_id", msgID))
 		case *tg.MessageMediaPoll:
 			r.logger.Debug("poll media", zap.Int("msg_id", msgID))
+			hasMedia = true
+			mediaInfo.Type = "poll"
+			poll := v.GetPoll()
+			derivedTexts = append(derivedTexts, poll.Question.Text)
+			for _, answer := range poll.GetAnswers() {
+				derivedTexts = append(derivedTexts, answer.Text.Text)
+			}
 		case *tg.MessageMediaDice:
 			r.logger.Debug("dice media", zap.Int("msg_id", msgID))
 		case *tg.MessageMediaStory:
@@ -188,24 +439,15 @@ func (r Observer) saveMessage(ctx context.Context, dialogID int64, msg *tgtypes.
 			r.logger.Warn("unknown media type", zap.Int("msg_id", msgID), zap.Any("media", media))
 		}
 	}
-
-	r.logger.Info("saving message", zap.Int("msg_id", msgID), zap.Int64("dialog_id", dialogID))
-	_, err = r.db.Message.Create().
-		SetMsgID(msgID).
-		SetDialogID(dialogID).
-		SetText(msg.GetMessage()).
-		SetHasMedia(hasMedia).
-		SetMediaInfo(&mediaInfo).
-		SetSentAt(sentAt).
-		Save(ctx)
-	if err != nil {
-		err = fmt.Errorf("failed to save message: %w", err)
-	}
 	return
 }
 
+// handleDocument collects the primary document of the media and its alternative versions.
 func (r Observer) handleDocument(ctx context.Context, m *tg.MessageMediaDocument) (documentInfos []types.Document) {
 	gg, _ := m.GetAltDocuments()
+	if g, ok := m.GetDocument(); ok {
+		gg = append([]tg.DocumentClass{g}, gg...)
+	}
 	documentInfos = make([]types.Document, 0, len(gg))
 	for _, g := range gg {
 		if d, ok := g.(*tg.Document); ok {
@@ -215,21 +457,37 @@ func (r Observer) handleDocument(ctx context.Context, m *tg.MessageMediaDocument
 				FileReferenceBase64: base64.StdEncoding.EncodeToString(d.GetFileReference()),
 				MimeType:            d.GetMimeType(),
 				Type:                "file",
+				Size:                d.GetSize(),
 			}
 			for _, attr := range d.GetAttributes() {
 				switch v := attr.(type) {
+				case *tg.DocumentAttributeImageSize:
+					documentInfo.Width, documentInfo.Height = v.GetW(), v.GetH()
 				case *tg.DocumentAttributeAnimated:
 					documentInfo.Type = "animated"
 				case *tg.DocumentAttributeSticker:
 					documentInfo.Type = "sticker"
+					documentInfo.Sticker.Emoji = v.GetAlt()
 				case *tg.DocumentAttributeVideo:
-					documentInfo.Type = "video"
+					// animations also carry a video attribute, keep the more specific type
+					if documentInfo.Type == "file" {
+						documentInfo.Type = "video"
+					}
+					documentInfo.Duration = v.GetDuration()
+					documentInfo.Width, documentInfo.Height = v.GetW(), v.GetH()
 				case *tg.DocumentAttributeAudio:
 					documentInfo.Type = "audio"
+					if v.GetVoice() {
+						documentInfo.Type = "voice"
+					}
+					documentInfo.Duration = float64(v.GetDuration())
+					documentInfo.Audio.Title, _ = v.GetTitle()
+					documentInfo.Audio.Performer, _ = v.GetPerformer()
 				case *tg.DocumentAttributeFilename:
 					documentInfo.Filename = v.GetFileName()
 				case *tg.DocumentAttributeCustomEmoji:
 					documentInfo.Type = "custom_emoji"
+					documentInfo.Sticker.Emoji = v.GetAlt()
 				default:
 					r.logger.Debug("unsupported document attribute", zap.Any("attr", attr))
 				}
@@ -239,3 +497,10 @@ func (r Observer) handleDocument(ctx context.Context, m *tg.MessageMediaDocument
 	}
 	return
 }
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %w", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,30 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// enforceRetention periodically removes messages that are older than the
+// retention of their dialog.
+func (r Observer) enforceRetention(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		count, err := r.observation.EnforceRetention(ctx)
+		if err != nil {
+			r.logger.Error("failed to remove expired messages", zap.Error(err))
+		} else if count > 0 {
+			r.logger.Info("removed expired messages", zap.Int("count", count))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,377 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/telegram/query"
+	"github.com/gotd/td/telegram/query/dialogs"
+	"github.com/gotd/td/tg"
+	"github.com/samber/lo"
+	"github.com/xyenon/telemikiya/config"
+	"github.com/xyenon/telemikiya/observation"
+	"github.com/xyenon/telemikiya/telegram"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Decision tells whether a dialog is observed and why.
+type Decision struct {
+	Title    string
+	Observed bool
+	// Rule is the 1-based index of the matching observation rule, or 0 if none matched.
+	Rule   int
+	Reason string
+}
+
+type EvaluatorParams struct {
+	fx.In
+
+	Config      *config.Config
+	Logger      *zap.Logger
+	Telegram    *telegram.Telegram `name:"tgUser"`
+	Observation *observation.Observation
+}
+
+// Evaluator decides which dialogs are observed by the observation rules.
+type Evaluator struct {
+	cfg    *config.Telegram
+	logger *zap.Logger
+	tg     *telegram.Telegram
+
+	observation *observation.Observation
+
+	rules   []rule
+	folders *folderCache
+}
+
+func NewEvaluator(params EvaluatorParams) (*Evaluator, error) {
+	rules, err := newRules(params.Config.Telegram.ObservationRules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse observation rules: %w", err)
+	}
+
+	return &Evaluator{
+		cfg:         &params.Config.Telegram,
+		logger:      params.Logger,
+		tg:          params.Telegram,
+		observation: params.Observation,
+		rules:       rules,
+		folders:     &folderCache{interval: params.Config.Telegram.DialogUpdateInterval},
+	}, nil
+}
+
+type rule struct {
+	config.ObservationRule
+	titleRegex *regexp.Regexp
+}
+
+func newRules(cfgs []config.ObservationRule) ([]rule, error) {
+	rules := make([]rule, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		switch cfg.Action {
+		case types.ObservationInclude, types.ObservationExclude:
+		default:
+			return nil, fmt.Errorf("invalid action of observation rule #%d: %q", i+1, cfg.Action)
+		}
+
+		r := rule{ObservationRule: cfg}
+		if lo.IsNotEmpty(cfg.TitleRegex) {
+			var err error
+			r.titleRegex, err = regexp.Compile(cfg.TitleRegex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid title regex of observation rule #%d: %w", i+1, err)
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// dialogInfo holds the attributes of a dialog that observation rules match on.
+type dialogInfo struct {
+	id         int64
+	dialogType types.DialogType
+	title      string
+	username   string
+	bot        bool
+	contact    bool
+}
+
+func newDialogInfo(chat tgtypes.EffectiveChat) (info dialogInfo, err error) {
+	dialog := types.FromEffectiveChat(chat)
+	if info.id, err = dialog.ID(); err != nil {
+		return info, fmt.Errorf("failed to get dialog id: %w", err)
+	}
+	if info.dialogType, err = dialog.Type(); err != nil {
+		return info, fmt.Errorf("failed to get dialog type: %w", err)
+	}
+	if info.title, err = dialog.Title(); err != nil {
+		return info, fmt.Errorf("failed to get dialog title: %w", err)
+	}
+	switch chat := chat.(type) {
+	case *tgtypes.User:
+		info.username, info.bot, info.contact = chat.Username, chat.Bot, chat.Contact
+	case *tgtypes.Channel:
+		info.username = chat.Username
+	}
+	return info, nil
+}
+
+// matches reports whether the dialog matches all criteria of the rule.
+// folders is only called if the rule matches on folders.
+func (r rule) matches(info dialogInfo, folders func() ([]string, error)) (bool, error) {
+	if len(r.DialogIDs) > 0 && !lo.Contains(r.DialogIDs, info.id) {
+		return false, nil
+	}
+	if len(r.Types) > 0 && !lo.Contains(r.Types, info.dialogType) {
+		return false, nil
+	}
+	if r.titleRegex != nil && !r.titleRegex.MatchString(info.title) {
+		return false, nil
+	}
+	if len(r.Usernames) > 0 && !lo.ContainsBy(r.Usernames, func(username string) bool {
+		return lo.IsNotEmpty(info.username) && strings.EqualFold(strings.TrimPrefix(username, "@"), info.username)
+	}) {
+		return false, nil
+	}
+	if r.Bot != nil && *r.Bot != info.bot {
+		return false, nil
+	}
+	if len(r.Folders) > 0 {
+		titles, err := folders()
+		if err != nil {
+			return false, err
+		}
+		if len(lo.Intersect(r.Folders, titles)) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// String describes the rule as written in the config.
+func (r rule) String() string {
+	criteria := []string{fmt.Sprintf("action = %q", r.Action)}
+	if len(r.DialogIDs) > 0 {
+		criteria = append(criteria, fmt.Sprintf("dialog_ids = %v", r.DialogIDs))
+	}
+	if len(r.Types) > 0 {
+		criteria = append(criteria, fmt.Sprintf("types = %q", r.Types))
+	}
+	if r.titleRegex != nil {
+		criteria = append(criteria, fmt.Sprintf("title_regex = %q", r.TitleRegex))
+	}
+	if len(r.Usernames) > 0 {
+		criteria = append(criteria, fmt.Sprintf("usernames = %q", r.Usernames))
+	}
+	if r.Bot != nil {
+		criteria = append(criteria, fmt.Sprintf("bot = %t", *r.Bot))
+	}
+	if len(r.Folders) > 0 {
+		criteria = append(criteria, fmt.Sprintf("folders = %q", r.Folders))
+	}
+	return strings.Join(criteria, ", ")
+}
+
+// decide evaluates the observation rules for a dialog that is not paused.
+// Dialogs matching no rule are observed if they are listed in
+// observed_dialog_ids, or if it is empty.
+func (e *Evaluator) decide(ctx context.Context, chat tgtypes.EffectiveChat) (Decision, error) {
+	info, err := newDialogInfo(chat)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	state, err := e.observation.Get(ctx, info.id)
+	if err != nil {
+		return Decision{}, err
+	}
+	if state.Paused {
+		return Decision{Title: info.title, Observed: false, Reason: "the dialog is paused"}, nil
+	}
+
+	folders := func() ([]string, error) {
+		return e.folders.dialogFolders(ctx, e.tg.API(), e.tg.Self.GetID(), info)
+	}
+	for i, rule := range e.rules {
+		matched, err := rule.matches(info, folders)
+		if err != nil {
+			return Decision{}, fmt.Errorf("failed to evaluate observation rule #%d: %w", i+1, err)
+		}
+		if matched {
+			return Decision{
+				Title:    info.title,
+				Observed: rule.Action == types.ObservationInclude,
+				Rule:     i + 1,
+				Reason:   fmt.Sprintf("matched observation rule #%d (%s)", i+1, rule),
+			}, nil
+		}
+	}
+
+	decision := Decision{Title: info.title}
+	switch {
+	case len(e.cfg.ObservedDialogIDs) == 0:
+		decision.Observed, decision.Reason = true, "no observation rule matched and observed_dialog_ids is empty"
+	case lo.Contains(e.cfg.ObservedDialogIDs, info.id):
+		decision.Observed, decision.Reason = true, "no observation rule matched and the dialog is listed in observed_dialog_ids"
+	default:
+		decision.Observed, decision.Reason = false, "no observation rule matched and the dialog is not listed in observed_dialog_ids"
+	}
+	return decision, nil
+}
+
+// Check explains whether the dialog with the given ID is observed.
+func (e *Evaluator) Check(ctx context.Context, dialogID int64) (Decision, error) {
+	var elems []dialogs.Elem
+	err := withFloodWait(ctx, e.logger, func() (err error) {
+		elems, err = query.GetDialogs(e.tg.API()).BatchSize(100).Collect(ctx)
+		return
+	})
+	if err != nil {
+		return Decision{}, fmt.Errorf("failed to get dialogs: %w", err)
+	}
+
+	for _, elem := range elems {
+		chat, ok := effectiveChat(elem.Dialog.GetPeer(), elem.Entities)
+		if !ok {
+			continue
+		}
+		if id, err := types.FromEffectiveChat(chat).ID(); err != nil || id != dialogID {
+			continue
+		}
+		return e.decide(ctx, chat)
+	}
+	return Decision{}, fmt.Errorf("dialog %d not found", dialogID)
+}
+
+func (r Observer) isObserved(ctx context.Context, chat tgtypes.EffectiveChat) (bool, error) {
+	decision, err := r.evaluator.decide(ctx, chat)
+	if err != nil {
+		return false, err
+	}
+	return decision.Observed, nil
+}
+
+// folder is a Telegram chat folder, reduced to what is needed to tell whether
+// it contains a dialog. Muted, read and archived dialogs are not excluded,
+// since that depends on the state of the dialog rather than the dialog itself.
+type folder struct {
+	title            string
+	include, exclude []int64
+	contacts         bool
+	nonContacts      bool
+	groups           bool
+	broadcasts       bool
+	bots             bool
+}
+
+func (f folder) contains(info dialogInfo) bool {
+	switch {
+	case lo.Contains(f.exclude, info.id):
+		return false
+	case lo.Contains(f.include, info.id):
+		return true
+	}
+	switch info.dialogType {
+	case types.TypeUser:
+		switch {
+		case info.bot:
+			return f.bots
+		case info.contact:
+			return f.contacts
+		default:
+			return f.nonContacts
+		}
+	case types.TypeGroup:
+		return f.groups
+	case types.TypeChannel:
+		return f.broadcasts
+	}
+	return false
+}
+
+// folderCache caches the chat folders of the account, refreshing them at most
+// once per refresh interval.
+type folderCache struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	folders   []folder
+	fetchedAt time.Time
+}
+
+// dialogFolders returns the titles of the chat folders containing the dialog.
+func (c *folderCache) dialogFolders(ctx context.Context, api *tg.Client, selfID int64, info dialogInfo) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) > c.interval {
+		filters, err := api.MessagesGetDialogFilters(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chat folders: %w", err)
+		}
+		c.folders = lo.FilterMap(filters.GetFilters(), func(filter tg.DialogFilterClass, _ int) (folder, bool) {
+			return newFolder(filter, selfID)
+		})
+		c.fetchedAt = time.Now()
+	}
+
+	return lo.FilterMap(c.folders, func(f folder, _ int) (string, bool) {
+		return f.title, f.contains(info)
+	}), nil
+}
+
+func newFolder(filter tg.DialogFilterClass, selfID int64) (folder, bool) {
+	toDialogIDs := func(peers []tg.InputPeerClass) []int64 {
+		return lo.FilterMap(peers, func(p tg.InputPeerClass, _ int) (int64, bool) {
+			return inputPeerDialogID(p, selfID)
+		})
+	}
+
+	switch filter := filter.(type) {
+	case *tg.DialogFilter:
+		return folder{
+			title:       filter.Title.Text,
+			include:     toDialogIDs(slices.Concat(filter.IncludePeers, filter.PinnedPeers)),
+			exclude:     toDialogIDs(filter.ExcludePeers),
+			contacts:    filter.Contacts,
+			nonContacts: filter.NonContacts,
+			groups:      filter.Groups,
+			broadcasts:  filter.Broadcasts,
+			bots:        filter.Bots,
+		}, true
+	case *tg.DialogFilterChatlist:
+		return folder{
+			title:   filter.Title.Text,
+			include: toDialogIDs(slices.Concat(filter.IncludePeers, filter.PinnedPeers)),
+		}, true
+	default:
+		// the default "All chats" folder
+		return folder{}, false
+	}
+}
+
+func inputPeerDialogID(p tg.InputPeerClass, selfID int64) (int64, bool) {
+	var peer tg.PeerClass
+	switch p := p.(type) {
+	case *tg.InputPeerSelf:
+		peer = &tg.PeerUser{UserID: selfID}
+	case *tg.InputPeerUser:
+		peer = &tg.PeerUser{UserID: p.UserID}
+	case *tg.InputPeerChat:
+		peer = &tg.PeerChat{ChatID: p.ChatID}
+	case *tg.InputPeerChannel:
+		peer = &tg.PeerChannel{ChannelID: p.ChannelID}
+	default:
+		return 0, false
+	}
+	dialogID, err := types.FromPeerClass(peer).ID()
+	return dialogID, err == nil
+}
@@ -0,0 +1,86 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tgtypes "github.com/celestix/gotgproto/types"
+	"github.com/gotd/td/telegram/message/peer"
+	"github.com/gotd/td/tg"
+	"github.com/xyenon/telemikiya/database/ent"
+	entsender "github.com/xyenon/telemikiya/database/ent/sender"
+	"github.com/xyenon/telemikiya/types"
+	"go.uber.org/zap"
+)
+
+// senderPeer returns the peer who sent the message.
+// from_id is omitted for messages in private chats and for channel posts,
+// in which case the message was sent by the dialog itself or by ourselves.
+func senderPeer(self *tg.User, msg *tgtypes.Message) tg.PeerClass {
+	if fromID, ok := msg.GetFromID(); ok {
+		return fromID
+	}
+	if msg.GetOut() && self != nil {
+		return &tg.PeerUser{UserID: self.GetID()}
+	}
+	return msg.GetPeerID()
+}
+
+// saveSender creates or refreshes the sender of the message and returns its ID.
+// It returns nil if the sender entity is not available.
+func (r Observer) saveSender(ctx context.Context, msg *tgtypes.Message, entities peer.Entities) (*int64, error) {
+	p := senderPeer(r.tg.Self, msg)
+	chat, ok := effectiveChat(p, entities)
+	if !ok {
+		r.logger.Debug("sender entity not found", zap.Int("msg_id", msg.GetID()), zap.Any("peer", p))
+		return nil, nil
+	}
+	sender := types.FromEffectiveChat(chat)
+	senderID, err := sender.ID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender id: %w", err)
+	}
+
+	for {
+		if _, loaded := r.senderLock.LoadOrStore(senderID, struct{}{}); !loaded {
+			break
+		}
+		r.logger.Debug("sender is locked, waiting", zap.Int64("sender_id", senderID))
+		time.Sleep(time.Second)
+	}
+	defer r.senderLock.Delete(senderID)
+
+	oldSender, err := r.db.Sender.Query().Where(entsender.ID(senderID)).Select(entsender.FieldUpdatedAt).Only(ctx)
+	exist := err == nil
+	switch {
+	case ent.IsNotFound(err):
+		// sender does not exist, create new
+	case err != nil:
+		return nil, fmt.Errorf("failed to query sender: %w", err)
+	case oldSender.UpdatedAt.After(time.Now().Add(-r.cfg.DialogUpdateInterval)):
+		// sender exists and no need to update
+		return &senderID, nil
+	}
+
+	senderType, err := sender.Type()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender type: %w", err)
+	}
+	name, err := sender.Title()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender name: %w", err)
+	}
+
+	if exist {
+		r.logger.Info("updating sender", zap.Int64("sender_id", senderID), zap.String("name", name))
+		_, err = r.db.Sender.UpdateOneID(senderID).SetName(name).SetUpdatedAt(time.Now()).Save(ctx)
+	} else {
+		r.logger.Info("creating sender", zap.Int64("sender_id", senderID), zap.String("name", name))
+		_, err = r.db.Sender.Create().SetID(senderID).SetName(name).SetType(senderType).Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save sender: %w", err)
+	}
+	return &senderID, nil
+}
@@ -113,6 +113,14 @@ func (DialogType) Values() (kinds []string) {
 	return
 }
 
+// ObservationAction is what an observation rule does with the dialogs it matches.
+type ObservationAction string
+
+const (
+	ObservationInclude ObservationAction = "include"
+	ObservationExclude ObservationAction = "exclude"
+)
+
 type MTProtoDialogType string
 
 const (
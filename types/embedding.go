@@ -7,3 +7,25 @@ const (
 	TypeOpenAI ProviderType = "openai"
 	TypeGoogle ProviderType = "google"
 )
+
+// EmbeddingStrategy is how the text to embed is built for a message.
+type EmbeddingStrategy string
+
+const (
+	// EmbeddingStrategyMessage embeds the message on its own.
+	EmbeddingStrategyMessage EmbeddingStrategy = "message"
+	// EmbeddingStrategyPreceding embeds the message along with the messages
+	// preceding it in the dialog.
+	EmbeddingStrategyPreceding EmbeddingStrategy = "preceding"
+	// EmbeddingStrategyReply embeds the message along with the message it
+	// replies to.
+	EmbeddingStrategyReply EmbeddingStrategy = "reply"
+)
+
+// Values provides list valid values for Enum.
+func (EmbeddingStrategy) Values() (kinds []string) {
+	for _, s := range []EmbeddingStrategy{EmbeddingStrategyMessage, EmbeddingStrategyPreceding, EmbeddingStrategyReply} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
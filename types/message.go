@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 type MediaInfo struct {
 	Type string `json:"type,omitempty"`
 	Photo
@@ -8,16 +10,40 @@ type MediaInfo struct {
 	Documents []Document `json:"documents,omitempty"`
 }
 
+// mediaInfo is MediaInfo without its JSON methods.
+type mediaInfo MediaInfo
+
+// MarshalJSON stores the access hash of a geo point as the access_hash of the
+// photo, as the embedded structs cannot share the key.
+func (m MediaInfo) MarshalJSON() ([]byte, error) {
+	v := mediaInfo(m)
+	if m.Type == "geo" {
+		v.Photo.AccessHash = m.GeoPoint.AccessHash
+	}
+	return json.Marshal(v)
+}
+
+func (m *MediaInfo) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*mediaInfo)(m)); err != nil {
+		return err
+	}
+	if m.Type == "geo" {
+		m.GeoPoint.AccessHash, m.Photo.AccessHash = m.Photo.AccessHash, 0
+	}
+	return nil
+}
+
 type Photo struct {
 	ID                  int64  `json:"id,omitempty"`
 	AccessHash          int64  `json:"access_hash,omitempty"`
 	FileReferenceBase64 string `json:"file_reference_base64,omitempty"`
 }
 
+// GeoPoint is a location. Its access hash is stored as access_hash by MediaInfo.
 type GeoPoint struct {
 	Long           float64 `json:"long,omitempty"`
 	Lat            float64 `json:"lat,omitempty"`
-	AccessHash     int64   `json:"access_hash,omitempty"`
+	AccessHash     int64   `json:"-"`
 	AccuracyRadius int     `json:"accuracy_radius,omitempty"`
 }
 
@@ -36,7 +62,30 @@ type Document struct {
 	MimeType            string          `json:"mime_type,omitempty"`
 	Type                string          `json:"type,omitempty"`
 	Filename            string          `json:"filename,omitempty"`
+	Size                int64           `json:"size,omitempty"`
+	Duration            float64         `json:"duration,omitempty"`
+	Width               int             `json:"width,omitempty"`
+	Height              int             `json:"height,omitempty"`
+	Audio               DocumentAudio   `json:"audio,omitempty"`
 	Sticker             DocumentSticker `json:"sticker,omitempty"`
 }
 
-type DocumentSticker struct{}
+type DocumentAudio struct {
+	Performer string `json:"performer,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+type DocumentSticker struct {
+	Emoji string `json:"emoji,omitempty"`
+}
+
+type DeletedMessagePolicy string
+
+const (
+	// DeletedMessageKeep keeps deleted messages searchable.
+	DeletedMessageKeep DeletedMessagePolicy = "keep"
+	// DeletedMessageHide hides deleted messages from search results.
+	DeletedMessageHide DeletedMessagePolicy = "hide"
+	// DeletedMessagePurge hides deleted messages and removes them after the retention period.
+	DeletedMessagePurge DeletedMessagePolicy = "purge"
+)